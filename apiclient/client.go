@@ -0,0 +1,111 @@
+package apiclient
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/ethpandaops/dora/types/apitypes"
+)
+
+// Client is a typed client for the dora explorer api (/api/v1)
+type Client struct {
+	baseUrl    string
+	httpClient *http.Client
+}
+
+// ApiError is returned when the api responds with an error object
+type ApiError struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *ApiError) Error() string {
+	return fmt.Sprintf("api error %v: %v", e.StatusCode, e.Message)
+}
+
+// PageRequest holds the pagination arguments of paged endpoints
+type PageRequest struct {
+	Page  uint64
+	Limit uint64
+}
+
+// NewClient creates a new api client for the explorer running at baseUrl (e.g. https://dora.holesky.ethpandaops.io)
+func NewClient(baseUrl string) *Client {
+	return &Client{
+		baseUrl: strings.TrimRight(baseUrl, "/"),
+		httpClient: &http.Client{
+			Timeout: 30 * time.Second,
+		},
+	}
+}
+
+// WithHttpClient replaces the http client used for api calls
+func (c *Client) WithHttpClient(httpClient *http.Client) *Client {
+	c.httpClient = httpClient
+	return c
+}
+
+func (c *Client) get(ctx context.Context, path string, query url.Values, result interface{}) (*apitypes.Pagination, error) {
+	reqUrl := c.baseUrl + "/api/v1" + path
+	if len(query) > 0 {
+		reqUrl += "?" + query.Encode()
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqUrl, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	rsp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer rsp.Body.Close()
+
+	response := &apitypes.Response{
+		Data: result,
+	}
+	err = json.NewDecoder(rsp.Body).Decode(response)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding api response (status %v): %v", rsp.StatusCode, err)
+	}
+	if response.Status != apitypes.StatusOK {
+		apiErr := &ApiError{
+			StatusCode: rsp.StatusCode,
+		}
+		if response.Error != nil {
+			apiErr.Message = response.Error.Message
+		}
+		return nil, apiErr
+	}
+	return response.Pagination, nil
+}
+
+func (p *PageRequest) apply(query url.Values) {
+	if p == nil {
+		return
+	}
+	if p.Page > 0 {
+		query.Set("page", fmt.Sprintf("%v", p.Page))
+	}
+	if p.Limit > 0 {
+		query.Set("limit", fmt.Sprintf("%v", p.Limit))
+	}
+}
+
+func setUintArg(query url.Values, name string, value *uint64) {
+	if value != nil {
+		query.Set(name, fmt.Sprintf("%v", *value))
+	}
+}
+
+func setStringArg(query url.Values, name string, value string) {
+	if value != "" {
+		query.Set(name, value)
+	}
+}
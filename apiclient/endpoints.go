@@ -0,0 +1,501 @@
+package apiclient
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/ethpandaops/dora/types/apitypes"
+)
+
+// SlotsFilter holds the filter arguments of GetSlotsFiltered
+type SlotsFilter struct {
+	Graffiti     string
+	ExtraData    string
+	FeeRecipient string
+	Proposer     *uint64
+	ProposerName string
+	WithOrphaned *uint64
+	WithMissing  *uint64
+}
+
+// InitiatedDepositsFilter holds the filter arguments of GetInitiatedDeposits
+type InitiatedDepositsFilter struct {
+	Address       string
+	PublicKey     string
+	ValidatorName string
+	MinAmount     *uint64
+	MaxAmount     *uint64
+	WithOrphaned  *uint64
+	WithValid     *uint64
+}
+
+// IncludedDepositsFilter holds the filter arguments of GetIncludedDeposits
+type IncludedDepositsFilter struct {
+	MinIndex      *uint64
+	MaxIndex      *uint64
+	PublicKey     string
+	ValidatorName string
+	MinAmount     *uint64
+	MaxAmount     *uint64
+	WithOrphaned  *uint64
+}
+
+// VoluntaryExitsFilter holds the filter arguments of GetVoluntaryExits
+type VoluntaryExitsFilter struct {
+	MinSlot       *uint64
+	MaxSlot       *uint64
+	MinIndex      *uint64
+	MaxIndex      *uint64
+	ValidatorName string
+	WithOrphaned  *uint64
+}
+
+// SlashingsFilter holds the filter arguments of GetSlashings
+type SlashingsFilter struct {
+	MinSlot       *uint64
+	MaxSlot       *uint64
+	MinIndex      *uint64
+	MaxIndex      *uint64
+	ValidatorName string
+	SlasherName   string
+	WithReason    *uint64
+	WithOrphaned  *uint64
+}
+
+// WithdrawalsFilter holds the filter arguments of GetWithdrawals
+type WithdrawalsFilter struct {
+	MinSlot       *uint64
+	MaxSlot       *uint64
+	MinIndex      *uint64
+	MaxIndex      *uint64
+	ValidatorName string
+	Address       string
+	MinAmount     *uint64
+	MaxAmount     *uint64
+	WithType      *uint64
+	WithOrphaned  *uint64
+}
+
+// BLSChangesFilter holds the filter arguments of GetBLSChanges
+type BLSChangesFilter struct {
+	MinSlot       *uint64
+	MaxSlot       *uint64
+	MinIndex      *uint64
+	MaxIndex      *uint64
+	ValidatorName string
+	Address       string
+	WithOrphaned  *uint64
+}
+
+// DepositRequestsFilter holds the filter arguments of GetDepositRequests
+type DepositRequestsFilter struct {
+	MinSlot      *uint64
+	MaxSlot      *uint64
+	PublicKey    string
+	MinAmount    *uint64
+	MaxAmount    *uint64
+	WithOrphaned *uint64
+}
+
+// WithdrawalRequestsFilter holds the filter arguments of GetWithdrawalRequests
+type WithdrawalRequestsFilter struct {
+	MinSlot       *uint64
+	MaxSlot       *uint64
+	SourceAddress string
+	PublicKey     string
+	MinAmount     *uint64
+	MaxAmount     *uint64
+	WithOrphaned  *uint64
+}
+
+// ConsolidationRequestsFilter holds the filter arguments of GetConsolidationRequests
+type ConsolidationRequestsFilter struct {
+	MinSlot       *uint64
+	MaxSlot       *uint64
+	SourceAddress string
+	PublicKey     string
+	WithOrphaned  *uint64
+}
+
+// MevBlocksFilter holds the filter arguments of GetMevBlocks
+type MevBlocksFilter struct {
+	MinSlot       *uint64
+	MaxSlot       *uint64
+	MinIndex      *uint64
+	MaxIndex      *uint64
+	ValidatorName string
+	Relays        []uint64
+	Proposed      []uint64
+}
+
+// ReorgsFilter holds the filter arguments of GetReorgs
+type ReorgsFilter struct {
+	MinSlot  *uint64
+	MaxSlot  *uint64
+	MinDepth *uint64
+}
+
+// ClientDiversityFilter holds the epoch range of GetClientDiversity
+type ClientDiversityFilter struct {
+	MinEpoch *uint64
+	MaxEpoch *uint64
+}
+
+func joinUints(values []uint64) string {
+	strs := make([]string, len(values))
+	for i, value := range values {
+		strs[i] = fmt.Sprintf("%v", value)
+	}
+	return strings.Join(strs, ",")
+}
+
+// GetEpochs returns the most recent epochs
+func (c *Client) GetEpochs(ctx context.Context, page *PageRequest) ([]*apitypes.Epoch, *apitypes.Pagination, error) {
+	query := url.Values{}
+	page.apply(query)
+	result := []*apitypes.Epoch{}
+	pagination, err := c.get(ctx, "/epochs", query, &result)
+	return result, pagination, err
+}
+
+// GetEpoch returns the details of a single epoch
+func (c *Client) GetEpoch(ctx context.Context, epoch uint64) (*apitypes.EpochDetails, error) {
+	result := &apitypes.EpochDetails{}
+	_, err := c.get(ctx, fmt.Sprintf("/epoch/%v", epoch), nil, result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// GetSlots returns the most recent slots
+func (c *Client) GetSlots(ctx context.Context, page *PageRequest) ([]*apitypes.Slot, *apitypes.Pagination, error) {
+	query := url.Values{}
+	page.apply(query)
+	result := []*apitypes.Slot{}
+	pagination, err := c.get(ctx, "/slots", query, &result)
+	return result, pagination, err
+}
+
+// GetSlotsFiltered returns the slots matching the given filter
+func (c *Client) GetSlotsFiltered(ctx context.Context, filter *SlotsFilter, page *PageRequest) ([]*apitypes.Slot, *apitypes.Pagination, error) {
+	query := url.Values{}
+	page.apply(query)
+	if filter != nil {
+		setStringArg(query, "graffiti", filter.Graffiti)
+		setStringArg(query, "extra_data", filter.ExtraData)
+		setStringArg(query, "fee_recipient", filter.FeeRecipient)
+		setUintArg(query, "proposer", filter.Proposer)
+		setStringArg(query, "proposer_name", filter.ProposerName)
+		setUintArg(query, "with_orphaned", filter.WithOrphaned)
+		setUintArg(query, "with_missing", filter.WithMissing)
+	}
+	result := []*apitypes.Slot{}
+	pagination, err := c.get(ctx, "/slots/filtered", query, &result)
+	return result, pagination, err
+}
+
+// GetSlot returns the details of a slot by slot number or 0x prefixed block root
+func (c *Client) GetSlot(ctx context.Context, slotOrRoot string, loadDuties bool) (*apitypes.SlotDetails, error) {
+	query := url.Values{}
+	if loadDuties {
+		query.Set("duties", "1")
+	}
+	result := &apitypes.SlotDetails{}
+	_, err := c.get(ctx, "/slot/"+url.PathEscape(slotOrRoot), query, result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// GetAddress returns the deposits, validators, withdrawals and fee recipient blocks of a 0x prefixed execution layer address
+func (c *Client) GetAddress(ctx context.Context, address string) (*apitypes.AddressDetails, error) {
+	result := &apitypes.AddressDetails{}
+	_, err := c.get(ctx, "/address/"+url.PathEscape(address), nil, result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// GetValidator returns a validator by index or 0x prefixed public key
+func (c *Client) GetValidator(ctx context.Context, indexOrPubkey string) (*apitypes.Validator, error) {
+	result := &apitypes.Validator{}
+	_, err := c.get(ctx, "/validator/"+url.PathEscape(indexOrPubkey), nil, result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// GetValidatorAttestations returns the attestation history of a validator for the most recent epochs (descending, limit is optional)
+func (c *Client) GetValidatorAttestations(ctx context.Context, indexOrPubkey string, limit *uint64) ([]*apitypes.ValidatorAttestation, error) {
+	query := url.Values{}
+	setUintArg(query, "limit", limit)
+	result := []*apitypes.ValidatorAttestation{}
+	_, err := c.get(ctx, "/validator/"+url.PathEscape(indexOrPubkey)+"/attestations", query, &result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// GetValidatorQueue returns the activation / exit queue position and the estimated activation or exit epoch of a validator
+func (c *Client) GetValidatorQueue(ctx context.Context, indexOrPubkey string) (*apitypes.ValidatorQueueEstimate, error) {
+	result := &apitypes.ValidatorQueueEstimate{}
+	_, err := c.get(ctx, "/validator/"+url.PathEscape(indexOrPubkey)+"/queue", nil, result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// GetInitiatedDeposits returns the deposit contract transactions matching the given filter
+func (c *Client) GetInitiatedDeposits(ctx context.Context, filter *InitiatedDepositsFilter, page *PageRequest) ([]*apitypes.InitiatedDeposit, *apitypes.Pagination, error) {
+	query := url.Values{}
+	page.apply(query)
+	if filter != nil {
+		setStringArg(query, "address", filter.Address)
+		setStringArg(query, "pubkey", filter.PublicKey)
+		setStringArg(query, "validator_name", filter.ValidatorName)
+		setUintArg(query, "min_amount", filter.MinAmount)
+		setUintArg(query, "max_amount", filter.MaxAmount)
+		setUintArg(query, "with_orphaned", filter.WithOrphaned)
+		setUintArg(query, "with_valid", filter.WithValid)
+	}
+	result := []*apitypes.InitiatedDeposit{}
+	pagination, err := c.get(ctx, "/deposits/initiated", query, &result)
+	return result, pagination, err
+}
+
+// GetIncludedDeposits returns the deposits included in the beacon chain matching the given filter
+func (c *Client) GetIncludedDeposits(ctx context.Context, filter *IncludedDepositsFilter, page *PageRequest) ([]*apitypes.IncludedDeposit, *apitypes.Pagination, error) {
+	query := url.Values{}
+	page.apply(query)
+	if filter != nil {
+		setUintArg(query, "min_index", filter.MinIndex)
+		setUintArg(query, "max_index", filter.MaxIndex)
+		setStringArg(query, "pubkey", filter.PublicKey)
+		setStringArg(query, "validator_name", filter.ValidatorName)
+		setUintArg(query, "min_amount", filter.MinAmount)
+		setUintArg(query, "max_amount", filter.MaxAmount)
+		setUintArg(query, "with_orphaned", filter.WithOrphaned)
+	}
+	result := []*apitypes.IncludedDeposit{}
+	pagination, err := c.get(ctx, "/deposits/included", query, &result)
+	return result, pagination, err
+}
+
+// GetDepositSnapshot returns the EIP-4881 deposit tree snapshot at the latest finalized eth1 data
+func (c *Client) GetDepositSnapshot(ctx context.Context) (*apitypes.DepositSnapshot, error) {
+	result := &apitypes.DepositSnapshot{}
+	_, err := c.get(ctx, "/deposits/snapshot", nil, result)
+	return result, err
+}
+
+// GetVoluntaryExits returns the voluntary exits matching the given filter
+func (c *Client) GetVoluntaryExits(ctx context.Context, filter *VoluntaryExitsFilter, page *PageRequest) ([]*apitypes.VoluntaryExit, *apitypes.Pagination, error) {
+	query := url.Values{}
+	page.apply(query)
+	if filter != nil {
+		setUintArg(query, "min_slot", filter.MinSlot)
+		setUintArg(query, "max_slot", filter.MaxSlot)
+		setUintArg(query, "min_index", filter.MinIndex)
+		setUintArg(query, "max_index", filter.MaxIndex)
+		setStringArg(query, "validator_name", filter.ValidatorName)
+		setUintArg(query, "with_orphaned", filter.WithOrphaned)
+	}
+	result := []*apitypes.VoluntaryExit{}
+	pagination, err := c.get(ctx, "/voluntary_exits", query, &result)
+	return result, pagination, err
+}
+
+// GetSlashings returns the slashings matching the given filter
+func (c *Client) GetSlashings(ctx context.Context, filter *SlashingsFilter, page *PageRequest) ([]*apitypes.Slashing, *apitypes.Pagination, error) {
+	query := url.Values{}
+	page.apply(query)
+	if filter != nil {
+		setUintArg(query, "min_slot", filter.MinSlot)
+		setUintArg(query, "max_slot", filter.MaxSlot)
+		setUintArg(query, "min_index", filter.MinIndex)
+		setUintArg(query, "max_index", filter.MaxIndex)
+		setStringArg(query, "validator_name", filter.ValidatorName)
+		setStringArg(query, "slasher_name", filter.SlasherName)
+		setUintArg(query, "with_reason", filter.WithReason)
+		setUintArg(query, "with_orphaned", filter.WithOrphaned)
+	}
+	result := []*apitypes.Slashing{}
+	pagination, err := c.get(ctx, "/slashings", query, &result)
+	return result, pagination, err
+}
+
+// GetWithdrawals returns the withdrawals matching the given filter
+func (c *Client) GetWithdrawals(ctx context.Context, filter *WithdrawalsFilter, page *PageRequest) ([]*apitypes.Withdrawal, *apitypes.Pagination, error) {
+	query := url.Values{}
+	page.apply(query)
+	if filter != nil {
+		setUintArg(query, "min_slot", filter.MinSlot)
+		setUintArg(query, "max_slot", filter.MaxSlot)
+		setUintArg(query, "min_index", filter.MinIndex)
+		setUintArg(query, "max_index", filter.MaxIndex)
+		setStringArg(query, "validator_name", filter.ValidatorName)
+		setStringArg(query, "address", filter.Address)
+		setUintArg(query, "min_amount", filter.MinAmount)
+		setUintArg(query, "max_amount", filter.MaxAmount)
+		setUintArg(query, "with_type", filter.WithType)
+		setUintArg(query, "with_orphaned", filter.WithOrphaned)
+	}
+	result := []*apitypes.Withdrawal{}
+	pagination, err := c.get(ctx, "/withdrawals", query, &result)
+	return result, pagination, err
+}
+
+// GetBLSChanges returns the bls to execution changes matching the given filter
+func (c *Client) GetBLSChanges(ctx context.Context, filter *BLSChangesFilter, page *PageRequest) ([]*apitypes.BLSChange, *apitypes.Pagination, error) {
+	query := url.Values{}
+	page.apply(query)
+	if filter != nil {
+		setUintArg(query, "min_slot", filter.MinSlot)
+		setUintArg(query, "max_slot", filter.MaxSlot)
+		setUintArg(query, "min_index", filter.MinIndex)
+		setUintArg(query, "max_index", filter.MaxIndex)
+		setStringArg(query, "validator_name", filter.ValidatorName)
+		setStringArg(query, "address", filter.Address)
+		setUintArg(query, "with_orphaned", filter.WithOrphaned)
+	}
+	result := []*apitypes.BLSChange{}
+	pagination, err := c.get(ctx, "/bls_changes", query, &result)
+	return result, pagination, err
+}
+
+// GetDepositRequests returns the execution layer deposit requests matching the given filter
+func (c *Client) GetDepositRequests(ctx context.Context, filter *DepositRequestsFilter, page *PageRequest) ([]*apitypes.DepositRequest, *apitypes.Pagination, error) {
+	query := url.Values{}
+	page.apply(query)
+	if filter != nil {
+		setUintArg(query, "min_slot", filter.MinSlot)
+		setUintArg(query, "max_slot", filter.MaxSlot)
+		setStringArg(query, "pubkey", filter.PublicKey)
+		setUintArg(query, "min_amount", filter.MinAmount)
+		setUintArg(query, "max_amount", filter.MaxAmount)
+		setUintArg(query, "with_orphaned", filter.WithOrphaned)
+	}
+	result := []*apitypes.DepositRequest{}
+	pagination, err := c.get(ctx, "/execution_requests/deposits", query, &result)
+	return result, pagination, err
+}
+
+// GetWithdrawalRequests returns the execution layer withdrawal requests matching the given filter
+func (c *Client) GetWithdrawalRequests(ctx context.Context, filter *WithdrawalRequestsFilter, page *PageRequest) ([]*apitypes.WithdrawalRequest, *apitypes.Pagination, error) {
+	query := url.Values{}
+	page.apply(query)
+	if filter != nil {
+		setUintArg(query, "min_slot", filter.MinSlot)
+		setUintArg(query, "max_slot", filter.MaxSlot)
+		setStringArg(query, "address", filter.SourceAddress)
+		setStringArg(query, "pubkey", filter.PublicKey)
+		setUintArg(query, "min_amount", filter.MinAmount)
+		setUintArg(query, "max_amount", filter.MaxAmount)
+		setUintArg(query, "with_orphaned", filter.WithOrphaned)
+	}
+	result := []*apitypes.WithdrawalRequest{}
+	pagination, err := c.get(ctx, "/execution_requests/withdrawals", query, &result)
+	return result, pagination, err
+}
+
+// GetConsolidationRequests returns the execution layer consolidation requests matching the given filter
+func (c *Client) GetConsolidationRequests(ctx context.Context, filter *ConsolidationRequestsFilter, page *PageRequest) ([]*apitypes.ConsolidationRequest, *apitypes.Pagination, error) {
+	query := url.Values{}
+	page.apply(query)
+	if filter != nil {
+		setUintArg(query, "min_slot", filter.MinSlot)
+		setUintArg(query, "max_slot", filter.MaxSlot)
+		setStringArg(query, "address", filter.SourceAddress)
+		setStringArg(query, "pubkey", filter.PublicKey)
+		setUintArg(query, "with_orphaned", filter.WithOrphaned)
+	}
+	result := []*apitypes.ConsolidationRequest{}
+	pagination, err := c.get(ctx, "/execution_requests/consolidations", query, &result)
+	return result, pagination, err
+}
+
+// GetMevBlocks returns the mev relay blocks matching the given filter
+func (c *Client) GetMevBlocks(ctx context.Context, filter *MevBlocksFilter, page *PageRequest) ([]*apitypes.MevBlock, *apitypes.Pagination, error) {
+	query := url.Values{}
+	page.apply(query)
+	if filter != nil {
+		setUintArg(query, "min_slot", filter.MinSlot)
+		setUintArg(query, "max_slot", filter.MaxSlot)
+		setUintArg(query, "min_index", filter.MinIndex)
+		setUintArg(query, "max_index", filter.MaxIndex)
+		setStringArg(query, "validator_name", filter.ValidatorName)
+		setStringArg(query, "relays", joinUints(filter.Relays))
+		setStringArg(query, "proposed", joinUints(filter.Proposed))
+	}
+	result := []*apitypes.MevBlock{}
+	pagination, err := c.get(ctx, "/mev/blocks", query, &result)
+	return result, pagination, err
+}
+
+// GetForks returns the current head forks
+func (c *Client) GetForks(ctx context.Context) ([]*apitypes.Fork, error) {
+	result := []*apitypes.Fork{}
+	_, err := c.get(ctx, "/forks", nil, &result)
+	return result, err
+}
+
+// GetForkChoiceTree returns the unfinalized block tree since the last finalized checkpoint
+func (c *Client) GetForkChoiceTree(ctx context.Context) (*apitypes.ForkChoiceTree, error) {
+	result := &apitypes.ForkChoiceTree{}
+	_, err := c.get(ctx, "/forks/tree", nil, result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// GetReorgs returns the reorgs recorded by the explorer (most recent first)
+func (c *Client) GetReorgs(ctx context.Context, filter *ReorgsFilter, page *PageRequest) ([]*apitypes.Reorg, *apitypes.Pagination, error) {
+	query := url.Values{}
+	page.apply(query)
+	if filter != nil {
+		setUintArg(query, "min_slot", filter.MinSlot)
+		setUintArg(query, "max_slot", filter.MaxSlot)
+		setUintArg(query, "min_depth", filter.MinDepth)
+	}
+	result := []*apitypes.Reorg{}
+	pagination, err := c.get(ctx, "/reorgs", query, &result)
+	return result, pagination, err
+}
+
+// GetConsensusClients returns the status of the connected consensus clients
+func (c *Client) GetConsensusClients(ctx context.Context) ([]*apitypes.Client, error) {
+	result := []*apitypes.Client{}
+	_, err := c.get(ctx, "/clients/consensus", nil, &result)
+	return result, err
+}
+
+// GetExecutionClients returns the status of the connected execution clients
+func (c *Client) GetExecutionClients(ctx context.Context) ([]*apitypes.Client, error) {
+	result := []*apitypes.Client{}
+	_, err := c.get(ctx, "/clients/execution", nil, &result)
+	return result, err
+}
+
+// GetClientDiversity returns the proposer client statistics of an epoch range (default: last 225 epochs)
+func (c *Client) GetClientDiversity(ctx context.Context, filter *ClientDiversityFilter) (*apitypes.ClientDiversity, error) {
+	query := url.Values{}
+	if filter != nil {
+		setUintArg(query, "min_epoch", filter.MinEpoch)
+		setUintArg(query, "max_epoch", filter.MaxEpoch)
+	}
+	result := &apitypes.ClientDiversity{}
+	_, err := c.get(ctx, "/clients/diversity", query, result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
@@ -0,0 +1,134 @@
+package apiclient
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ethpandaops/dora/types/apitypes"
+)
+
+// StreamEvent is a single event received from the event stream (/api/v1/events)
+type StreamEvent struct {
+	Id    uint64
+	Topic string
+	Data  json.RawMessage
+}
+
+// Decode unmarshals the event payload into the apitypes.Event* type matching the topic
+func (e *StreamEvent) Decode() (interface{}, error) {
+	var result interface{}
+	switch e.Topic {
+	case "block":
+		result = &apitypes.EventBlock{}
+	case "head":
+		result = &apitypes.EventHead{}
+	case "reorg":
+		result = &apitypes.EventReorg{}
+	case "finalized":
+		result = &apitypes.EventFinalizedCheckpoint{}
+	case "epoch":
+		result = &apitypes.EventEpochSummary{}
+	default:
+		return nil, fmt.Errorf("unknown event topic: %v", e.Topic)
+	}
+	err := json.Unmarshal(e.Data, result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// SubscribeEvents connects to the event stream and delivers the events of the given topics (all topics if empty) until ctx is cancelled.
+// Dropped connections are re-established automatically and missed events are replayed as far as the server still buffers them.
+func (c *Client) SubscribeEvents(ctx context.Context, topics []string) <-chan *StreamEvent {
+	eventChan := make(chan *StreamEvent, 16)
+	go func() {
+		defer close(eventChan)
+
+		lastEventId := uint64(0)
+		for {
+			err := c.streamEvents(ctx, topics, &lastEventId, eventChan)
+			if ctx.Err() != nil {
+				return
+			}
+			retryDelay := time.Second
+			if err != nil {
+				retryDelay = 5 * time.Second
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(retryDelay):
+			}
+		}
+	}()
+	return eventChan
+}
+
+func (c *Client) streamEvents(ctx context.Context, topics []string, lastEventId *uint64, eventChan chan<- *StreamEvent) error {
+	query := url.Values{}
+	setStringArg(query, "topics", strings.Join(topics, ","))
+	reqUrl := c.baseUrl + "/api/v1/events"
+	if len(query) > 0 {
+		reqUrl += "?" + query.Encode()
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqUrl, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "text/event-stream")
+	if *lastEventId > 0 {
+		req.Header.Set("Last-Event-ID", strconv.FormatUint(*lastEventId, 10))
+	}
+
+	// the stream is long-lived, so the client timeout must not apply
+	streamClient := &http.Client{
+		Transport: c.httpClient.Transport,
+	}
+	rsp, err := streamClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer rsp.Body.Close()
+	if rsp.StatusCode != http.StatusOK {
+		return &ApiError{
+			StatusCode: rsp.StatusCode,
+			Message:    "could not open event stream",
+		}
+	}
+
+	event := &StreamEvent{}
+	scanner := bufio.NewScanner(rsp.Body)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := scanner.Text()
+		switch {
+		case line == "":
+			if event.Topic != "" && len(event.Data) > 0 {
+				if event.Id > 0 {
+					*lastEventId = event.Id
+				}
+				select {
+				case eventChan <- event:
+				case <-ctx.Done():
+					return ctx.Err()
+				}
+			}
+			event = &StreamEvent{}
+		case strings.HasPrefix(line, "id:"):
+			event.Id, _ = strconv.ParseUint(strings.TrimSpace(line[3:]), 10, 64)
+		case strings.HasPrefix(line, "event:"):
+			event.Topic = strings.TrimSpace(line[6:])
+		case strings.HasPrefix(line, "data:"):
+			event.Data = append(event.Data, []byte(strings.TrimSpace(line[5:]))...)
+		}
+	}
+	return scanner.Err()
+}
@@ -18,20 +18,41 @@ type S3Store struct {
 	awsBucket string
 }
 
-func NewS3Store(accessKey string, secretKey string, awsRegion string, awsBucket string) (*S3Store, error) {
-	creds := credentials.NewStaticCredentialsProvider(accessKey, secretKey, "")
+// S3StoreConfig configures the connection to an S3 compatible object storage.
+// If no static keys are set, credentials are loaded from the default chain (env, shared config, IAM role).
+type S3StoreConfig struct {
+	Endpoint  string
+	Region    string
+	Bucket    string
+	AccessKey string
+	SecretKey string
+	PathStyle bool
+}
 
+func NewS3Store(storeConfig *S3StoreConfig) (*S3Store, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
 	defer cancel()
 
-	cfg, err := config.LoadDefaultConfig(ctx, config.WithCredentialsProvider(creds), config.WithRegion(awsRegion))
+	configOpts := []func(*config.LoadOptions) error{
+		config.WithRegion(storeConfig.Region),
+	}
+	if storeConfig.AccessKey != "" {
+		configOpts = append(configOpts, config.WithCredentialsProvider(credentials.NewStaticCredentialsProvider(storeConfig.AccessKey, storeConfig.SecretKey, "")))
+	}
+
+	cfg, err := config.LoadDefaultConfig(ctx, configOpts...)
 	if err != nil {
 		return nil, fmt.Errorf("could not load aws config: %w", err)
 	}
 
 	s3Store := &S3Store{
-		s3Client:  s3.NewFromConfig(cfg),
-		awsBucket: awsBucket,
+		s3Client: s3.NewFromConfig(cfg, func(o *s3.Options) {
+			if storeConfig.Endpoint != "" {
+				o.BaseEndpoint = aws.String(storeConfig.Endpoint)
+			}
+			o.UsePathStyle = storeConfig.PathStyle
+		}),
+		awsBucket: storeConfig.Bucket,
 	}
 	return s3Store, nil
 }
@@ -68,3 +89,17 @@ func (store *S3Store) Download(key string) ([]byte, error) {
 	}
 	return dataBuf.Bytes(), nil
 }
+
+func (store *S3Store) Delete(key string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+	defer cancel()
+
+	_, err := store.s3Client.DeleteObject(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(store.awsBucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return fmt.Errorf("could not delete '%v' from s3: %w", key, err)
+	}
+	return nil
+}
@@ -0,0 +1,67 @@
+package blobstore
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/ethpandaops/dora/dbtypes"
+	"github.com/ethpandaops/dora/utils"
+)
+
+var logger = logrus.StandardLogger().WithField("module", "blobstore")
+
+// ErrBlobNotFound is returned by backends that do not hold the payload of a blob.
+var ErrBlobNotFound = errors.New("blob not found")
+
+// BlobBackend persists blob payloads.
+// Blob metadata (commitment, proof, size & assignments) is always stored in the db and not handled by the backends.
+type BlobBackend interface {
+	// Name returns the backend type name.
+	Name() string
+	// Store persists the payload of a blob.
+	// Backends that keep the payload in the blobs table only set blob.Blob, the caller needs to write the blob row.
+	Store(blob *dbtypes.Blob, data []byte) error
+	// Load returns the persisted payload of a blob.
+	Load(blob *dbtypes.Blob) ([]byte, error)
+	// Delete drops the persisted payload of a blob.
+	// Backends that keep the payload in the blobs table only reset blob.Blob, the caller needs to write the blob row.
+	Delete(blob *dbtypes.Blob) error
+}
+
+// NewBlobBackend creates the blob backend for the given persistence mode from the blobstore configuration.
+// Returns nil for the "none" mode.
+func NewBlobBackend(mode string) (BlobBackend, error) {
+	switch mode {
+	case "", "none":
+		return nil, nil
+	case "db":
+		return newDbBackend(), nil
+	case "fs":
+		return newFsBackend(utils.Config.BlobStore.Fs.Path)
+	case "s3", "aws":
+		return newS3Backend()
+	case "tiered":
+		return newTieredBackend()
+	default:
+		return nil, fmt.Errorf("unknown blob persistence mode: %v", mode)
+	}
+}
+
+// GetBlobVersionedHash returns the versioned hash (EIP-4844) of a kzg commitment
+func GetBlobVersionedHash(commitment []byte) []byte {
+	versionedHash := sha256.Sum256(commitment)
+	versionedHash[0] = 0x01
+	return versionedHash[:]
+}
+
+// GetBlobName returns the object / file name of a blob payload as configured by the name template
+func GetBlobName(commitment []byte) string {
+	blobName := utils.Config.BlobStore.NameTemplate
+	blobName = strings.ReplaceAll(blobName, "{commitment}", fmt.Sprintf("%x", commitment))
+	blobName = strings.ReplaceAll(blobName, "{hash}", fmt.Sprintf("%x", GetBlobVersionedHash(commitment)))
+	return blobName
+}
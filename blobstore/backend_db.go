@@ -0,0 +1,39 @@
+package blobstore
+
+import (
+	"github.com/ethpandaops/dora/db"
+	"github.com/ethpandaops/dora/dbtypes"
+)
+
+// dbBackend keeps blob payloads in the blob column of the blobs table.
+type dbBackend struct{}
+
+func newDbBackend() *dbBackend {
+	return &dbBackend{}
+}
+
+func (backend *dbBackend) Name() string {
+	return "db"
+}
+
+func (backend *dbBackend) Store(blob *dbtypes.Blob, data []byte) error {
+	blob.Blob = &data
+	return nil
+}
+
+func (backend *dbBackend) Load(blob *dbtypes.Blob) ([]byte, error) {
+	if blob.Blob != nil {
+		return *blob.Blob, nil
+	}
+
+	dbBlob := db.GetBlob(blob.Commitment, true)
+	if dbBlob == nil || dbBlob.Blob == nil {
+		return nil, ErrBlobNotFound
+	}
+	return *dbBlob.Blob, nil
+}
+
+func (backend *dbBackend) Delete(blob *dbtypes.Blob) error {
+	blob.Blob = nil
+	return nil
+}
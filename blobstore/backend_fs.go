@@ -0,0 +1,62 @@
+package blobstore
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path"
+
+	"github.com/ethpandaops/dora/dbtypes"
+)
+
+// fsBackend keeps blob payloads as files in a local directory.
+type fsBackend struct {
+	path string
+}
+
+func newFsBackend(basePath string) (*fsBackend, error) {
+	if basePath == "" {
+		return nil, errors.New("missing path")
+	}
+	err := os.MkdirAll(basePath, 0755)
+	if err != nil {
+		return nil, fmt.Errorf("could not create blob directory '%v': %w", basePath, err)
+	}
+
+	return &fsBackend{
+		path: basePath,
+	}, nil
+}
+
+func (backend *fsBackend) Name() string {
+	return "fs"
+}
+
+func (backend *fsBackend) Store(blob *dbtypes.Blob, data []byte) error {
+	blobFile := path.Join(backend.path, GetBlobName(blob.Commitment))
+	err := os.WriteFile(blobFile, data, 0644)
+	if err != nil {
+		return fmt.Errorf("could not save blob to file '%v': %w", blobFile, err)
+	}
+	return nil
+}
+
+func (backend *fsBackend) Load(blob *dbtypes.Blob) ([]byte, error) {
+	blobFile := path.Join(backend.path, GetBlobName(blob.Commitment))
+	data, err := os.ReadFile(blobFile)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, ErrBlobNotFound
+	} else if err != nil {
+		return nil, fmt.Errorf("could not load blob from file '%v': %w", blobFile, err)
+	}
+	return data, nil
+}
+
+func (backend *fsBackend) Delete(blob *dbtypes.Blob) error {
+	blobFile := path.Join(backend.path, GetBlobName(blob.Commitment))
+	err := os.Remove(blobFile)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("could not delete blob file '%v': %w", blobFile, err)
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+package blobstore
+
+import (
+	"github.com/ethpandaops/dora/aws"
+	"github.com/ethpandaops/dora/dbtypes"
+	"github.com/ethpandaops/dora/utils"
+)
+
+// s3Backend keeps blob payloads as objects in an S3 compatible object storage.
+type s3Backend struct {
+	s3Store *aws.S3Store
+}
+
+func newS3Backend() (*s3Backend, error) {
+	awsConfig := utils.Config.BlobStore.Aws
+	s3Store, err := aws.NewS3Store(&aws.S3StoreConfig{
+		Endpoint:  awsConfig.Endpoint,
+		Region:    awsConfig.S3Region,
+		Bucket:    awsConfig.S3Bucket,
+		AccessKey: awsConfig.AccessKey,
+		SecretKey: awsConfig.SecretKey,
+		PathStyle: awsConfig.PathStyle,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &s3Backend{
+		s3Store: s3Store,
+	}, nil
+}
+
+func (backend *s3Backend) Name() string {
+	return "s3"
+}
+
+func (backend *s3Backend) Store(blob *dbtypes.Blob, data []byte) error {
+	return backend.s3Store.Upload(GetBlobName(blob.Commitment), data)
+}
+
+func (backend *s3Backend) Load(blob *dbtypes.Blob) ([]byte, error) {
+	return backend.s3Store.Download(GetBlobName(blob.Commitment))
+}
+
+func (backend *s3Backend) Delete(blob *dbtypes.Blob) error {
+	return backend.s3Store.Delete(GetBlobName(blob.Commitment))
+}
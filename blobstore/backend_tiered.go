@@ -0,0 +1,128 @@
+package blobstore
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/ethpandaops/dora/dbtypes"
+	"github.com/ethpandaops/dora/utils"
+)
+
+// TieredBackend writes blob payloads to a cold S3 compatible object storage and keeps recent payloads in a hot local directory.
+// Payloads are read from the hot tier first, payloads that are older than the configured hot epochs are dropped from the hot tier.
+type TieredBackend struct {
+	hot  *fsBackend
+	cold *s3Backend
+}
+
+func newTieredBackend() (*TieredBackend, error) {
+	hot, err := newFsBackend(utils.Config.BlobStore.Fs.Path)
+	if err != nil {
+		return nil, fmt.Errorf("could not init hot tier: %w", err)
+	}
+	cold, err := newS3Backend()
+	if err != nil {
+		return nil, fmt.Errorf("could not init cold tier: %w", err)
+	}
+
+	return &TieredBackend{
+		hot:  hot,
+		cold: cold,
+	}, nil
+}
+
+func (backend *TieredBackend) Name() string {
+	return "tiered"
+}
+
+func (backend *TieredBackend) Store(blob *dbtypes.Blob, data []byte) error {
+	err := backend.cold.Store(blob, data)
+	if err != nil {
+		return err
+	}
+
+	err = backend.hot.Store(blob, data)
+	if err != nil {
+		// the payload is persisted in the cold tier, so this is not fatal
+		logger.Warnf("could not save blob to hot tier: %v", err)
+	}
+	return nil
+}
+
+func (backend *TieredBackend) Load(blob *dbtypes.Blob) ([]byte, error) {
+	data, err := backend.hot.Load(blob)
+	if err == nil {
+		return data, nil
+	}
+	if !errors.Is(err, ErrBlobNotFound) {
+		logger.Warnf("could not load blob from hot tier: %v", err)
+	}
+
+	return backend.cold.Load(blob)
+}
+
+func (backend *TieredBackend) Delete(blob *dbtypes.Blob) error {
+	err := backend.hot.Delete(blob)
+	if err != nil {
+		return err
+	}
+	return backend.cold.Delete(blob)
+}
+
+// StartHotTierCleanup starts the background loop that drops expired payloads from the hot tier.
+func (backend *TieredBackend) StartHotTierCleanup() {
+	if utils.Config.BlobStore.Tiered.HotEpochs == 0 {
+		return
+	}
+
+	go backend.runHotTierCleanupLoop()
+}
+
+func (backend *TieredBackend) runHotTierCleanupLoop() {
+	defer utils.HandleSubroutinePanic("runHotTierCleanupLoop")
+
+	epochDuration := time.Duration(utils.Config.Chain.Config.SecondsPerSlot*utils.Config.Chain.Config.SlotsPerEpoch) * time.Second
+	hotDuration := time.Duration(utils.Config.BlobStore.Tiered.HotEpochs) * epochDuration
+
+	for {
+		time.Sleep(10 * time.Minute)
+
+		cleanupCount, err := backend.cleanupHotTier(time.Now().Add(-hotDuration))
+		if err != nil {
+			logger.Errorf("hot tier cleanup error: %v", err)
+		}
+		if cleanupCount > 0 {
+			logger.Infof("dropped %v blob payloads from hot tier", cleanupCount)
+		}
+	}
+}
+
+// cleanupHotTier removes all payload files from the hot tier that have been written before the given time.
+func (backend *TieredBackend) cleanupHotTier(before time.Time) (uint64, error) {
+	cleanupCount := uint64(0)
+	err := filepath.WalkDir(backend.hot.path, func(filePath string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !entry.Type().IsRegular() {
+			return nil
+		}
+
+		fileInfo, err := entry.Info()
+		if err != nil {
+			return err
+		}
+		if fileInfo.ModTime().Before(before) {
+			if err := os.Remove(filePath); err != nil && !errors.Is(err, os.ErrNotExist) {
+				return err
+			}
+			cleanupCount++
+		}
+		return nil
+	})
+	return cleanupCount, err
+}
@@ -0,0 +1,137 @@
+package main
+
+import (
+	"errors"
+	"flag"
+
+	"github.com/jmoiron/sqlx"
+	logger "github.com/sirupsen/logrus"
+
+	"github.com/ethpandaops/dora/blobstore"
+	"github.com/ethpandaops/dora/db"
+	"github.com/ethpandaops/dora/dbtypes"
+	"github.com/ethpandaops/dora/types"
+	"github.com/ethpandaops/dora/utils"
+)
+
+// dora-blob-migrate moves the persisted blob payloads from one blob backend to another.
+// Both backends are configured via the blobstore section of the explorer config.
+func main() {
+	configPath := flag.String("config", "", "Path to the config file, if empty string defaults will be used")
+	sourceMode := flag.String("from", "", "Blob backend to migrate from (db, fs, s3, tiered)")
+	targetMode := flag.String("to", "", "Blob backend to migrate to (db, fs, s3, tiered)")
+	deleteSource := flag.Bool("delete-source", false, "Delete the payloads from the source backend after migrating them")
+	batchSize := flag.Uint("batch-size", 100, "Number of blobs to migrate per batch")
+	flag.Parse()
+
+	cfg := &types.Config{}
+	err := utils.ReadConfig(cfg, *configPath)
+	if err != nil {
+		logger.Fatalf("error reading config file: %v", err)
+	}
+	utils.Config = cfg
+	logWriter := utils.InitLogger()
+	defer logWriter.Dispose()
+
+	if *sourceMode == "" || *targetMode == "" {
+		logger.Fatalf("source and target backend must be set")
+	}
+	if *sourceMode == *targetMode {
+		logger.Fatalf("source and target backend must differ")
+	}
+	if *deleteSource && sharesStorage(*sourceMode, *targetMode) {
+		logger.Fatalf("cannot delete source payloads, the %v and %v backends share the same storage location", *sourceMode, *targetMode)
+	}
+
+	source, err := blobstore.NewBlobBackend(*sourceMode)
+	if err != nil || source == nil {
+		logger.Fatalf("error initializing source backend: %v", err)
+	}
+	target, err := blobstore.NewBlobBackend(*targetMode)
+	if err != nil || target == nil {
+		logger.Fatalf("error initializing target backend: %v", err)
+	}
+
+	db.MustInitDB()
+	defer db.MustCloseDB()
+	err = db.ApplyEmbeddedDbSchema(-2)
+	if err != nil {
+		logger.Fatalf("error initializing db schema: %v", err)
+	}
+
+	logger.Infof("migrating blobs from %v to %v backend", source.Name(), target.Name())
+
+	migratedCount := uint64(0)
+	missingCount := uint64(0)
+	failedCount := uint64(0)
+	lastCommitment := []byte{}
+	for {
+		blobs, err := db.GetStoredBlobs(lastCommitment, uint32(*batchSize), true)
+		if err != nil {
+			logger.Fatalf("error loading blobs from db: %v", err)
+		}
+		if len(blobs) == 0 {
+			break
+		}
+		lastCommitment = blobs[len(blobs)-1].Commitment
+
+		for _, blob := range blobs {
+			err := migrateBlob(blob, source, target, *deleteSource)
+			if errors.Is(err, blobstore.ErrBlobNotFound) {
+				missingCount++
+			} else if err != nil {
+				logger.Warnf("error migrating blob 0x%x: %v", blob.Commitment, err)
+				failedCount++
+			} else {
+				migratedCount++
+			}
+		}
+
+		logger.Infof("migrated %v blobs (%v missing in source, %v failed)", migratedCount, missingCount, failedCount)
+	}
+
+	logger.Infof("blob migration complete: %v migrated, %v missing in source, %v failed", migratedCount, missingCount, failedCount)
+}
+
+// sharesStorage checks if two backends write to the same storage location (the tiered backend uses the fs & s3 config)
+func sharesStorage(mode1 string, mode2 string) bool {
+	normalizeMode := func(mode string) string {
+		if mode == "aws" {
+			return "s3"
+		}
+		return mode
+	}
+	mode1 = normalizeMode(mode1)
+	mode2 = normalizeMode(mode2)
+	if mode1 == mode2 {
+		return true
+	}
+	if mode1 == "tiered" || mode2 == "tiered" {
+		return mode1 != "db" && mode2 != "db"
+	}
+	return false
+}
+
+func migrateBlob(blob *dbtypes.Blob, source blobstore.BlobBackend, target blobstore.BlobBackend, deleteSource bool) error {
+	data, err := source.Load(blob)
+	if err != nil {
+		return err
+	}
+
+	err = target.Store(blob, data)
+	if err != nil {
+		return err
+	}
+
+	if deleteSource {
+		err = source.Delete(blob)
+		if err != nil {
+			return err
+		}
+	}
+
+	// the blob row holds the payload for the db backend, so it needs to be written after moving the payload
+	return db.RunDBTransaction(func(tx *sqlx.Tx) error {
+		return db.InsertBlob(blob, tx)
+	})
+}
@@ -0,0 +1,79 @@
+package main
+
+import (
+	"github.com/gorilla/mux"
+	logger "github.com/sirupsen/logrus"
+
+	"github.com/ethpandaops/dora/handlers"
+	"github.com/ethpandaops/dora/types/apitypes"
+	"github.com/ethpandaops/dora/utils"
+)
+
+// registerApiRoutes registers the versioned public api endpoints under /api/v1
+func registerApiRoutes(router *mux.Router) {
+	router.HandleFunc("/api/openapi.json", handlers.ApiOpenApiSpec).Methods("GET")
+	router.HandleFunc("/api/docs", handlers.ApiDocs).Methods("GET")
+
+	apiRouter := router.PathPrefix("/api/v1").Subrouter()
+
+	apiRouter.HandleFunc("/epochs", handlers.ApiEpochs).Methods("GET")
+	apiRouter.HandleFunc("/epoch/{epoch}", handlers.ApiEpoch).Methods("GET")
+	apiRouter.HandleFunc("/slots", handlers.ApiSlots).Methods("GET")
+	apiRouter.HandleFunc("/slots/filtered", handlers.ApiSlotsFiltered).Methods("GET")
+	apiRouter.HandleFunc("/slot/{slotOrHash}", handlers.ApiSlot).Methods("GET")
+	apiRouter.HandleFunc("/validator/{idxOrPubKey}", handlers.ApiValidator).Methods("GET")
+	apiRouter.HandleFunc("/validator/{idxOrPubKey}/attestations", handlers.ApiValidatorAttestations).Methods("GET")
+	apiRouter.HandleFunc("/validator/{idxOrPubKey}/queue", handlers.ApiValidatorQueue).Methods("GET")
+	apiRouter.HandleFunc("/address/{address}", handlers.ApiAddress).Methods("GET")
+	apiRouter.HandleFunc("/deposits/initiated", handlers.ApiInitiatedDeposits).Methods("GET")
+	apiRouter.HandleFunc("/deposits/included", handlers.ApiIncludedDeposits).Methods("GET")
+	apiRouter.HandleFunc("/deposits/snapshot", handlers.ApiDepositSnapshot).Methods("GET")
+	apiRouter.HandleFunc("/voluntary_exits", handlers.ApiVoluntaryExits).Methods("GET")
+	apiRouter.HandleFunc("/slashings", handlers.ApiSlashings).Methods("GET")
+	apiRouter.HandleFunc("/withdrawals", handlers.ApiWithdrawals).Methods("GET")
+	apiRouter.HandleFunc("/bls_changes", handlers.ApiBLSChanges).Methods("GET")
+	apiRouter.HandleFunc("/execution_requests/deposits", handlers.ApiDepositRequests).Methods("GET")
+	apiRouter.HandleFunc("/execution_requests/withdrawals", handlers.ApiWithdrawalRequests).Methods("GET")
+	apiRouter.HandleFunc("/execution_requests/consolidations", handlers.ApiConsolidationRequests).Methods("GET")
+	apiRouter.HandleFunc("/mev/blocks", handlers.ApiMevBlocks).Methods("GET")
+	apiRouter.HandleFunc("/forks", handlers.ApiForks).Methods("GET")
+	apiRouter.HandleFunc("/forks/tree", handlers.ApiForkTree).Methods("GET")
+	apiRouter.HandleFunc("/reorgs", handlers.ApiReorgs).Methods("GET")
+	apiRouter.HandleFunc("/clients/consensus", handlers.ApiClientsCL).Methods("GET")
+	apiRouter.HandleFunc("/clients/execution", handlers.ApiClientsEL).Methods("GET")
+	apiRouter.HandleFunc("/clients/diversity", handlers.ApiClientDiversity).Methods("GET")
+	apiRouter.HandleFunc("/events", handlers.ApiEvents).Methods("GET")
+
+	apiRouter.PathPrefix("/").HandlerFunc(handlers.ApiNotFound)
+
+	if utils.Config.Frontend.Debug {
+		checkApiSpec(apiRouter)
+	}
+}
+
+// checkApiSpec warns about /api/v1 routes that are missing in the OpenAPI document
+func checkApiSpec(apiRouter *mux.Router) {
+	specPaths, err := apitypes.GetOpenApiPaths()
+	if err != nil {
+		logger.Warnf("error parsing openapi spec: %v", err)
+		return
+	}
+	documented := map[string]bool{}
+	for _, path := range specPaths {
+		documented[path] = true
+	}
+
+	err = apiRouter.Walk(func(route *mux.Route, _ *mux.Router, _ []*mux.Route) error {
+		path, err := route.GetPathTemplate()
+		if err != nil {
+			return nil
+		}
+		if methods, _ := route.GetMethods(); len(methods) > 0 && !documented[path] {
+			logger.Warnf("api route %v is not documented in the openapi spec", path)
+		}
+		return nil
+	})
+	if err != nil {
+		logger.Warnf("error walking api routes: %v", err)
+	}
+}
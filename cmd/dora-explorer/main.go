@@ -50,10 +50,18 @@ func main() {
 	if err != nil {
 		logger.Fatalf("error starting beacon service: %v", err)
 	}
+	err = services.StartMetricsService()
+	if err != nil {
+		logger.Fatalf("error starting metrics service: %v", err)
+	}
 	err = services.StartTxSignaturesService()
 	if err != nil {
 		logger.Fatalf("error starting tx signature service: %v", err)
 	}
+	err = services.StartNotificationService()
+	if err != nil {
+		logger.Fatalf("error starting notification service: %v", err)
+	}
 
 	if cfg.RateLimit.Enabled {
 		err = services.StartCallRateLimiter(cfg.RateLimit.ProxyCount, cfg.RateLimit.Rate, cfg.RateLimit.Burst)
@@ -67,6 +75,10 @@ func main() {
 		if err != nil {
 			logger.Fatalf("error starting frontend cache service: %v", err)
 		}
+		err = services.StartEventStreamService()
+		if err != nil {
+			logger.Fatalf("error starting event stream service: %v", err)
+		}
 
 		startFrontend()
 	}
@@ -84,29 +96,49 @@ func startFrontend() {
 	router.HandleFunc("/index/data", handlers.IndexData).Methods("GET")
 	router.HandleFunc("/clients/consensus", handlers.ClientsCL).Methods("GET")
 	router.HandleFunc("/clients/execution", handlers.ClientsEl).Methods("GET")
+	router.HandleFunc("/clients/diversity", handlers.ClientDiversity).Methods("GET")
 	router.HandleFunc("/forks", handlers.Forks).Methods("GET")
+	router.HandleFunc("/forks/tree", handlers.ForkTree).Methods("GET")
+	router.HandleFunc("/forks/compare", handlers.ForkCompare).Methods("GET")
+	router.HandleFunc("/reorgs", handlers.Reorgs).Methods("GET")
 	router.HandleFunc("/epochs", handlers.Epochs).Methods("GET")
 	router.HandleFunc("/epoch/{epoch}", handlers.Epoch).Methods("GET")
 	router.HandleFunc("/slots", handlers.Slots).Methods("GET")
 	router.HandleFunc("/slots/filtered", handlers.SlotsFiltered).Methods("GET")
 	router.HandleFunc("/slot/{slotOrHash}", handlers.Slot).Methods("GET")
 	router.HandleFunc("/slot/{root}/blob/{commitment}", handlers.SlotBlob).Methods("GET")
+	router.HandleFunc("/blobs", handlers.Blobs).Methods("GET")
+	router.HandleFunc("/blob/{hash}", handlers.Blob).Methods("GET")
+	router.HandleFunc("/tx/{hash}", handlers.Transaction).Methods("GET")
+	router.HandleFunc("/address/{address}", handlers.Address).Methods("GET")
 	router.HandleFunc("/mev/blocks", handlers.MevBlocks).Methods("GET")
 
 	router.HandleFunc("/search", handlers.Search).Methods("GET")
 	router.HandleFunc("/search/{type}", handlers.SearchAhead).Methods("GET")
 	router.HandleFunc("/validators", handlers.Validators).Methods("GET")
 	router.HandleFunc("/validators/activity", handlers.ValidatorsActivity).Methods("GET")
+	router.HandleFunc("/validators/queues", handlers.ValidatorQueues).Methods("GET")
+	router.HandleFunc("/validators/sync_committees", handlers.SyncCommittees).Methods("GET")
 	router.HandleFunc("/validators/deposits", handlers.Deposits).Methods("GET")
+	router.HandleFunc("/validators/eth1_votes", handlers.Eth1Votes).Methods("GET")
 	router.HandleFunc("/validators/initiated_deposits", handlers.InitiatedDeposits).Methods("GET")
 	router.HandleFunc("/validators/included_deposits", handlers.IncludedDeposits).Methods("GET")
 	router.HandleFunc("/validators/voluntary_exits", handlers.VoluntaryExits).Methods("GET")
 	router.HandleFunc("/validators/slashings", handlers.Slashings).Methods("GET")
+	router.HandleFunc("/validators/withdrawals", handlers.Withdrawals).Methods("GET")
+	router.HandleFunc("/validators/bls_changes", handlers.BLSChanges).Methods("GET")
 	router.HandleFunc("/validator/{idxOrPubKey}", handlers.Validator).Methods("GET")
 	router.HandleFunc("/validator/{index}/slots", handlers.ValidatorSlots).Methods("GET")
 
 	router.HandleFunc("/identicon", handlers.Identicon).Methods("GET")
 
+	registerApiRoutes(router)
+
+	if metricsHandler := services.MetricsHandler(); metricsHandler != nil {
+		router.Handle("/metrics", metricsHandler).Methods("GET")
+	}
+	router.Use(services.MetricsMiddleware)
+
 	if utils.Config.Frontend.Pprof {
 		// add pprof handler
 		router.PathPrefix("/debug/pprof/").Handler(http.DefaultServeMux)
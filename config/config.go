@@ -37,6 +37,11 @@ var HoleskyChainYml string
 //go:embed gnosis.chain.yml
 var GnosisChainYml string
 
+// client diversity rules
+//
+//go:embed client-diversity.rules.yml
+var ClientDiversityRulesYml string
+
 // validator names
 //
 //go:embed *.names.yml
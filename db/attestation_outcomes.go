@@ -0,0 +1,37 @@
+package db
+
+import (
+	"fmt"
+
+	"github.com/ethpandaops/dora/dbtypes"
+	"github.com/jmoiron/sqlx"
+)
+
+func InsertAttestationOutcomes(epoch uint64, outcomes []byte, tx *sqlx.Tx) error {
+	_, err := tx.Exec(EngineQuery(map[dbtypes.DBEngineType]string{
+		dbtypes.DBEnginePgsql:  `INSERT INTO attestation_outcomes (epoch, outcomes) VALUES ($1, $2) ON CONFLICT (epoch) DO UPDATE SET outcomes = excluded.outcomes`,
+		dbtypes.DBEngineSqlite: `INSERT OR REPLACE INTO attestation_outcomes (epoch, outcomes) VALUES ($1, $2)`,
+	}), epoch, outcomes)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetValidatorAttestationOutcomes returns the single outcome byte of a validator for all persisted epochs in the given range (descending).
+// The outcome is empty for epochs where the validator index was beyond the stored outcome array.
+func GetValidatorAttestationOutcomes(validator uint64, minEpoch uint64, maxEpoch uint64, limit uint32) ([]*dbtypes.ValidatorAttestation, error) {
+	attestations := []*dbtypes.ValidatorAttestation{}
+	err := ReaderDb.Select(&attestations, fmt.Sprintf(`
+		SELECT epoch, %v AS outcome
+		FROM attestation_outcomes
+		WHERE epoch >= $2 AND epoch <= $3
+		ORDER BY epoch DESC
+		LIMIT $4`, attestationOutcomesColumn.selectValue(1),
+	), attestationOutcomesColumn.valueOffset(validator), minEpoch, maxEpoch, limit)
+	if err != nil {
+		logger.Errorf("Error while fetching validator attestation outcomes: %v", err)
+		return nil, err
+	}
+	return attestations, nil
+}
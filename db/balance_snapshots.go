@@ -0,0 +1,36 @@
+package db
+
+import (
+	"fmt"
+
+	"github.com/ethpandaops/dora/dbtypes"
+	"github.com/jmoiron/sqlx"
+)
+
+func InsertBalanceSnapshot(snapshot *dbtypes.BalanceSnapshot, tx *sqlx.Tx) error {
+	_, err := tx.Exec(EngineQuery(map[dbtypes.DBEngineType]string{
+		dbtypes.DBEnginePgsql:  `INSERT INTO balance_snapshots (epoch, balances, effective_balances) VALUES ($1, $2, $3) ON CONFLICT (epoch) DO UPDATE SET balances = excluded.balances, effective_balances = excluded.effective_balances`,
+		dbtypes.DBEngineSqlite: `INSERT OR REPLACE INTO balance_snapshots (epoch, balances, effective_balances) VALUES ($1, $2, $3)`,
+	}), snapshot.Epoch, snapshot.Balances, snapshot.EffectiveBalances)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetValidatorBalances returns the balances of a validator from all snapshots in the given epoch range (ascending).
+// The balance fields are empty for snapshots where the validator did not exist yet.
+func GetValidatorBalances(validator uint64, minEpoch uint64, maxEpoch uint64) ([]*dbtypes.ValidatorBalance, error) {
+	balances := []*dbtypes.ValidatorBalance{}
+	err := ReaderDb.Select(&balances, fmt.Sprintf(`
+		SELECT epoch, %v AS balance, %v AS effective_balance
+		FROM balance_snapshots
+		WHERE epoch >= $3 AND epoch <= $4
+		ORDER BY epoch ASC`, balancesColumn.selectValue(1), effectiveBalancesColumn.selectValue(2),
+	), balancesColumn.valueOffset(validator), effectiveBalancesColumn.valueOffset(validator), minEpoch, maxEpoch)
+	if err != nil {
+		logger.Errorf("Error while fetching validator balances: %v", err)
+		return nil, err
+	}
+	return balances, nil
+}
@@ -12,17 +12,18 @@ func InsertBlob(blob *dbtypes.Blob, tx *sqlx.Tx) error {
 	_, err := tx.Exec(EngineQuery(map[dbtypes.DBEngineType]string{
 		dbtypes.DBEnginePgsql: `
 			INSERT INTO blobs (
-				commitment, proof, size, blob
-			) VALUES ($1, $2, $3, $4)
+				commitment, proof, size, blob, pruned
+			) VALUES ($1, $2, $3, $4, $5)
 			ON CONFLICT (commitment) DO UPDATE SET
 				size = excluded.size,
-				blob = excluded.blob`,
+				blob = excluded.blob,
+				pruned = excluded.pruned`,
 		dbtypes.DBEngineSqlite: `
 			INSERT OR REPLACE INTO blobs (
-				commitment, proof, size, blob
-			) VALUES ($1, $2, $3, $4)`,
+				commitment, proof, size, blob, pruned
+			) VALUES ($1, $2, $3, $4, $5)`,
 	}),
-		blob.Commitment, blob.Proof, blob.Size, blob.Blob)
+		blob.Commitment, blob.Proof, blob.Size, blob.Blob, blob.Pruned)
 	if err != nil {
 		return err
 	}
@@ -33,15 +34,19 @@ func InsertBlobAssignment(blobAssignment *dbtypes.BlobAssignment, tx *sqlx.Tx) e
 	_, err := tx.Exec(EngineQuery(map[dbtypes.DBEngineType]string{
 		dbtypes.DBEnginePgsql: `
 			INSERT INTO blob_assignments (
-				root, commitment, slot
-			) VALUES ($1, $2, $3)
-			ON CONFLICT (root, commitment) DO NOTHING`,
+				root, commitment, slot, blob_index, versioned_hash, tx_hash, tx_sender
+			) VALUES ($1, $2, $3, $4, $5, $6, $7)
+			ON CONFLICT (root, commitment) DO UPDATE SET
+				blob_index = excluded.blob_index,
+				versioned_hash = excluded.versioned_hash,
+				tx_hash = excluded.tx_hash,
+				tx_sender = excluded.tx_sender`,
 		dbtypes.DBEngineSqlite: `
 			INSERT OR REPLACE INTO blob_assignments (
-				root, commitment, slot
-			) VALUES ($1, $2, $3)`,
+				root, commitment, slot, blob_index, versioned_hash, tx_hash, tx_sender
+			) VALUES ($1, $2, $3, $4, $5, $6, $7)`,
 	}),
-		blobAssignment.Root, blobAssignment.Commitment, blobAssignment.Slot)
+		blobAssignment.Root, blobAssignment.Commitment, blobAssignment.Slot, blobAssignment.BlobIndex, blobAssignment.VersionedHash, blobAssignment.TxHash, blobAssignment.TxSender)
 	if err != nil {
 		return err
 	}
@@ -51,7 +56,7 @@ func InsertBlobAssignment(blobAssignment *dbtypes.BlobAssignment, tx *sqlx.Tx) e
 func GetBlob(commitment []byte, withData bool) *dbtypes.Blob {
 	blob := dbtypes.Blob{}
 	var sql strings.Builder
-	fmt.Fprintf(&sql, `SELECT commitment, proof, size`)
+	fmt.Fprintf(&sql, `SELECT commitment, proof, size, pruned`)
 	if withData {
 		fmt.Fprintf(&sql, `, blob`)
 	}
@@ -65,9 +70,236 @@ func GetBlob(commitment []byte, withData bool) *dbtypes.Blob {
 
 func GetLatestBlobAssignment(commitment []byte) *dbtypes.BlobAssignment {
 	blobAssignment := dbtypes.BlobAssignment{}
-	err := ReaderDb.Get(&blobAssignment, "SELECT root, commitment, slot FROM blob_assignments WHERE commitment = $1 ORDER BY slot DESC LIMIT 1", commitment)
+	err := ReaderDb.Get(&blobAssignment, "SELECT root, commitment, slot, blob_index, versioned_hash, tx_hash, tx_sender FROM blob_assignments WHERE commitment = $1 ORDER BY slot DESC LIMIT 1", commitment)
 	if err != nil {
 		return nil
 	}
 	return &blobAssignment
 }
+
+func GetBlobAssignments(commitment []byte) []*dbtypes.BlobAssignment {
+	blobAssignments := []*dbtypes.BlobAssignment{}
+	err := ReaderDb.Select(&blobAssignments, "SELECT root, commitment, slot, blob_index, versioned_hash, tx_hash, tx_sender FROM blob_assignments WHERE commitment = $1 ORDER BY slot DESC", commitment)
+	if err != nil {
+		logger.Errorf("Error while fetching blob assignments: %v", err)
+		return nil
+	}
+	return blobAssignments
+}
+
+func GetBlobCommitmentByVersionedHash(versionedHash []byte) []byte {
+	commitment := []byte{}
+	err := ReaderDb.Get(&commitment, "SELECT commitment FROM blob_assignments WHERE versioned_hash = $1 LIMIT 1", versionedHash)
+	if err != nil {
+		return nil
+	}
+	return commitment
+}
+
+func GetBlobsFiltered(offset uint64, limit uint32, filter *dbtypes.BlobFilter) ([]*dbtypes.BlobListEntry, uint64, error) {
+	var sql strings.Builder
+	args := []any{}
+	fmt.Fprint(&sql, `
+	WITH cte AS (
+		SELECT
+			blob_assignments.root, blob_assignments.commitment, blob_assignments.slot, blob_assignments.blob_index, 
+			blob_assignments.versioned_hash, blob_assignments.tx_hash, blob_assignments.tx_sender, COALESCE(blobs.size, 0) AS size,
+			COALESCE(blobs.pruned, false) AS pruned
+		FROM blob_assignments
+		LEFT JOIN blobs ON blobs.commitment = blob_assignments.commitment
+	`)
+
+	filterOp := "WHERE"
+	if filter.MinSlot > 0 {
+		args = append(args, filter.MinSlot)
+		fmt.Fprintf(&sql, " %v blob_assignments.slot >= $%v", filterOp, len(args))
+		filterOp = "AND"
+	}
+	if filter.MaxSlot > 0 {
+		args = append(args, filter.MaxSlot)
+		fmt.Fprintf(&sql, " %v blob_assignments.slot <= $%v", filterOp, len(args))
+		filterOp = "AND"
+	}
+	if len(filter.Sender) > 0 {
+		args = append(args, filter.Sender)
+		fmt.Fprintf(&sql, " %v blob_assignments.tx_sender = $%v", filterOp, len(args))
+		filterOp = "AND"
+	}
+	if len(filter.VersionedHash) > 0 {
+		args = append(args, filter.VersionedHash)
+		fmt.Fprintf(&sql, " %v blob_assignments.versioned_hash = $%v", filterOp, len(args))
+		filterOp = "AND"
+	}
+	if len(filter.Commitment) > 0 {
+		args = append(args, filter.Commitment)
+		fmt.Fprintf(&sql, " %v blob_assignments.commitment = $%v", filterOp, len(args))
+		filterOp = "AND"
+	}
+
+	args = append(args, limit)
+	fmt.Fprintf(&sql, `) 
+	SELECT 
+		null AS root,
+		null AS commitment,
+		count(*) AS slot,
+		0 AS blob_index,
+		null AS versioned_hash,
+		null AS tx_hash,
+		null AS tx_sender,
+		0 AS size,
+		false AS pruned
+	FROM cte
+	UNION ALL SELECT * FROM (
+	SELECT * FROM cte
+	ORDER BY slot DESC, blob_index DESC
+	LIMIT $%v 
+	`, len(args))
+
+	if offset > 0 {
+		args = append(args, offset)
+		fmt.Fprintf(&sql, " OFFSET $%v ", len(args))
+	}
+	fmt.Fprintf(&sql, ") AS t1")
+
+	blobs := []*dbtypes.BlobListEntry{}
+	err := ReaderDb.Select(&blobs, sql.String(), args...)
+	if err != nil {
+		logger.Errorf("Error while fetching filtered blobs: %v", err)
+		return nil, 0, err
+	}
+
+	return blobs[1:], blobs[0].Slot, nil
+}
+
+func InsertBlobStats(stats *dbtypes.BlobStats, tx *sqlx.Tx) error {
+	_, err := tx.Exec(EngineQuery(map[dbtypes.DBEngineType]string{
+		dbtypes.DBEnginePgsql: `
+			INSERT INTO blob_stats (
+				epoch, block_count, blob_count, blob_gas_used, blob_base_fee
+			) VALUES ($1, $2, $3, $4, $5)
+			ON CONFLICT (epoch) DO UPDATE SET
+				block_count = excluded.block_count,
+				blob_count = excluded.blob_count,
+				blob_gas_used = excluded.blob_gas_used,
+				blob_base_fee = excluded.blob_base_fee`,
+		dbtypes.DBEngineSqlite: `
+			INSERT OR REPLACE INTO blob_stats (
+				epoch, block_count, blob_count, blob_gas_used, blob_base_fee
+			) VALUES ($1, $2, $3, $4, $5)`,
+	}),
+		stats.Epoch, stats.BlockCount, stats.BlobCount, stats.BlobGasUsed, stats.BlobBaseFee)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetBlobStats returns the blob usage of all persisted epochs in the given range (ascending)
+func GetBlobStats(minEpoch uint64, maxEpoch uint64) ([]*dbtypes.BlobStats, error) {
+	stats := []*dbtypes.BlobStats{}
+	err := ReaderDb.Select(&stats, `
+		SELECT epoch, block_count, blob_count, blob_gas_used, blob_base_fee
+		FROM blob_stats
+		WHERE epoch >= $1 AND epoch <= $2
+		ORDER BY epoch ASC`, minEpoch, maxEpoch)
+	if err != nil {
+		logger.Errorf("Error while fetching blob stats: %v", err)
+		return nil, err
+	}
+	return stats, nil
+}
+
+// GetBlobPruneCandidates returns stored (not pruned) blobs ordered by the slot of their latest assignment, oldest first.
+// maxSlot limits the result to blobs without assignments at or after that slot (0 = no limit),
+// keepSenders excludes blobs that were sent by one of the given addresses.
+func GetBlobPruneCandidates(maxSlot uint64, keepSenders [][]byte, limit uint32) ([]*dbtypes.BlobPruneCandidate, error) {
+	var sql strings.Builder
+	args := []any{}
+	fmt.Fprint(&sql, `
+	SELECT blobs.commitment, blobs.size, COALESCE(MAX(blob_assignments.slot), 0) AS slot
+	FROM blobs
+	LEFT JOIN blob_assignments ON blob_assignments.commitment = blobs.commitment
+	WHERE NOT blobs.pruned`)
+
+	if len(keepSenders) > 0 {
+		fmt.Fprint(&sql, ` AND NOT EXISTS (
+		SELECT 1 FROM blob_assignments AS keep_assignments
+		WHERE keep_assignments.commitment = blobs.commitment AND keep_assignments.tx_sender IN (`)
+		for i, sender := range keepSenders {
+			if i > 0 {
+				fmt.Fprint(&sql, ", ")
+			}
+			args = append(args, sender)
+			fmt.Fprintf(&sql, "$%v", len(args))
+		}
+		fmt.Fprint(&sql, "))")
+	}
+
+	fmt.Fprint(&sql, `
+	GROUP BY blobs.commitment, blobs.size`)
+	if maxSlot > 0 {
+		args = append(args, maxSlot)
+		fmt.Fprintf(&sql, " HAVING COALESCE(MAX(blob_assignments.slot), 0) < $%v", len(args))
+	}
+
+	args = append(args, limit)
+	fmt.Fprintf(&sql, `
+	ORDER BY slot ASC
+	LIMIT $%v`, len(args))
+
+	candidates := []*dbtypes.BlobPruneCandidate{}
+	err := ReaderDb.Select(&candidates, sql.String(), args...)
+	if err != nil {
+		logger.Errorf("Error while fetching blob prune candidates: %v", err)
+		return nil, err
+	}
+	return candidates, nil
+}
+
+// GetStoredBlobSize returns the total size of all blob payloads that have not been pruned.
+func GetStoredBlobSize() (uint64, error) {
+	var size uint64
+	err := ReaderDb.Get(&size, `SELECT COALESCE(SUM(size), 0) FROM blobs WHERE NOT pruned`)
+	if err != nil {
+		return 0, err
+	}
+	return size, nil
+}
+
+// SetBlobsPruned drops the payloads of the given blobs and marks them as pruned.
+func SetBlobsPruned(commitments [][]byte, tx *sqlx.Tx) error {
+	var sql strings.Builder
+	args := []any{true}
+	fmt.Fprint(&sql, `UPDATE blobs SET pruned = $1, blob = NULL WHERE commitment IN (`)
+	for i, commitment := range commitments {
+		if i > 0 {
+			fmt.Fprint(&sql, ", ")
+		}
+		args = append(args, commitment)
+		fmt.Fprintf(&sql, "$%v", len(args))
+	}
+	fmt.Fprint(&sql, ")")
+	_, err := tx.Exec(sql.String(), args...)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetStoredBlobs returns a batch of blobs that have not been pruned, ordered by commitment and starting after the given commitment.
+func GetStoredBlobs(afterCommitment []byte, limit uint32, withData bool) ([]*dbtypes.Blob, error) {
+	var sql strings.Builder
+	fmt.Fprintf(&sql, `SELECT commitment, proof, size, pruned`)
+	if withData {
+		fmt.Fprintf(&sql, `, blob`)
+	}
+	fmt.Fprintf(&sql, ` FROM blobs WHERE NOT pruned AND commitment > $1 ORDER BY commitment ASC LIMIT $2`)
+
+	blobs := []*dbtypes.Blob{}
+	err := ReaderDb.Select(&blobs, sql.String(), afterCommitment, limit)
+	if err != nil {
+		logger.Errorf("Error while fetching stored blobs: %v", err)
+		return nil, err
+	}
+	return blobs, nil
+}
@@ -0,0 +1,158 @@
+package db
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ethpandaops/dora/dbtypes"
+	"github.com/jmoiron/sqlx"
+)
+
+func InsertBLSChanges(blsChanges []*dbtypes.BLSChange, tx *sqlx.Tx) error {
+	var sql strings.Builder
+	fmt.Fprint(&sql,
+		EngineQuery(map[dbtypes.DBEngineType]string{
+			dbtypes.DBEnginePgsql:  "INSERT INTO bls_changes ",
+			dbtypes.DBEngineSqlite: "INSERT OR REPLACE INTO bls_changes ",
+		}),
+		"(slot_number, slot_index, slot_root, orphaned, validator, pubkey, address)",
+		" VALUES ",
+	)
+	argIdx := 0
+	fieldCount := 7
+
+	args := make([]any, len(blsChanges)*fieldCount)
+	for i, blsChange := range blsChanges {
+		if i > 0 {
+			fmt.Fprintf(&sql, ", ")
+		}
+		fmt.Fprintf(&sql, "(")
+		for f := 0; f < fieldCount; f++ {
+			if f > 0 {
+				fmt.Fprintf(&sql, ", ")
+			}
+			fmt.Fprintf(&sql, "$%v", argIdx+f+1)
+
+		}
+		fmt.Fprintf(&sql, ")")
+
+		args[argIdx+0] = blsChange.SlotNumber
+		args[argIdx+1] = blsChange.SlotIndex
+		args[argIdx+2] = blsChange.SlotRoot
+		args[argIdx+3] = blsChange.Orphaned
+		args[argIdx+4] = blsChange.ValidatorIndex
+		args[argIdx+5] = blsChange.BlsPubkey
+		args[argIdx+6] = blsChange.Address
+		argIdx += fieldCount
+	}
+	fmt.Fprint(&sql, EngineQuery(map[dbtypes.DBEngineType]string{
+		dbtypes.DBEnginePgsql:  " ON CONFLICT (slot_root, slot_index) DO UPDATE SET orphaned = excluded.orphaned",
+		dbtypes.DBEngineSqlite: "",
+	}))
+
+	_, err := tx.Exec(sql.String(), args...)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func GetBLSChangesFiltered(offset uint64, limit uint32, finalizedBlock uint64, filter *dbtypes.BLSChangeFilter) ([]*dbtypes.BLSChange, uint64, error) {
+	var sql strings.Builder
+	args := []any{}
+	fmt.Fprint(&sql, `
+	WITH cte AS (
+		SELECT
+			slot_number, slot_index, slot_root, orphaned, validator, pubkey, address
+		FROM bls_changes
+	`)
+
+	if filter.ValidatorName != "" {
+		fmt.Fprint(&sql, `
+		LEFT JOIN validator_names ON validator_names."index" = bls_changes.validator
+		`)
+	}
+
+	filterOp := "WHERE"
+	if filter.MinSlot > 0 {
+		args = append(args, filter.MinSlot)
+		fmt.Fprintf(&sql, " %v slot_number >= $%v", filterOp, len(args))
+		filterOp = "AND"
+	}
+	if filter.MaxSlot > 0 {
+		args = append(args, filter.MaxSlot)
+		fmt.Fprintf(&sql, " %v slot_number <= $%v", filterOp, len(args))
+		filterOp = "AND"
+	}
+	if filter.MinIndex > 0 {
+		args = append(args, filter.MinIndex)
+		fmt.Fprintf(&sql, " %v validator >= $%v", filterOp, len(args))
+		filterOp = "AND"
+	}
+	if filter.MaxIndex > 0 {
+		args = append(args, filter.MaxIndex)
+		fmt.Fprintf(&sql, " %v validator <= $%v", filterOp, len(args))
+		filterOp = "AND"
+	}
+	if filter.ValidatorIndex != nil {
+		args = append(args, *filter.ValidatorIndex)
+		fmt.Fprintf(&sql, " %v validator = $%v", filterOp, len(args))
+		filterOp = "AND"
+	}
+	if len(filter.Address) > 0 {
+		args = append(args, filter.Address)
+		fmt.Fprintf(&sql, " %v address = $%v", filterOp, len(args))
+		filterOp = "AND"
+	}
+	if filter.WithOrphaned == 0 {
+		args = append(args, finalizedBlock)
+		fmt.Fprintf(&sql, " %v (slot_number > $%v OR orphaned = false)", filterOp, len(args))
+		filterOp = "AND"
+	} else if filter.WithOrphaned == 2 {
+		args = append(args, finalizedBlock)
+		fmt.Fprintf(&sql, " %v (slot_number > $%v OR orphaned = true)", filterOp, len(args))
+		filterOp = "AND"
+	}
+	if filter.ValidatorName != "" {
+		args = append(args, "%"+filter.ValidatorName+"%")
+		fmt.Fprintf(&sql, " %v ", filterOp)
+		fmt.Fprintf(&sql, EngineQuery(map[dbtypes.DBEngineType]string{
+			dbtypes.DBEnginePgsql:  ` validator_names.name ilike $%v `,
+			dbtypes.DBEngineSqlite: ` validator_names.name LIKE $%v `,
+		}), len(args))
+
+		filterOp = "AND"
+	}
+
+	args = append(args, limit)
+	fmt.Fprintf(&sql, `)
+	SELECT
+		count(*) AS slot_number,
+		0 AS slot_index,
+		null AS slot_root,
+		false AS orphaned,
+		0 AS validator,
+		null AS pubkey,
+		null AS address
+	FROM cte
+	UNION ALL SELECT * FROM (
+	SELECT * FROM cte
+	ORDER BY slot_number DESC, slot_index DESC
+	LIMIT $%v
+	`, len(args))
+
+	if offset > 0 {
+		args = append(args, offset)
+		fmt.Fprintf(&sql, " OFFSET $%v ", len(args))
+	}
+	fmt.Fprintf(&sql, ") AS t1")
+
+	blsChanges := []*dbtypes.BLSChange{}
+	err := ReaderDb.Select(&blsChanges, sql.String(), args...)
+	if err != nil {
+		logger.Errorf("Error while fetching filtered bls changes: %v", err)
+		return nil, 0, err
+	}
+
+	return blsChanges[1:], blsChanges[0].SlotNumber, nil
+}
@@ -0,0 +1,102 @@
+package db
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ethpandaops/dora/dbtypes"
+	"github.com/jmoiron/sqlx"
+)
+
+// InsertClientDiversity replaces the client diversity stats of an epoch
+func InsertClientDiversity(epoch uint64, stats []*dbtypes.ClientDiversity, tx *sqlx.Tx) error {
+	_, err := tx.Exec(`DELETE FROM client_diversity WHERE epoch = $1`, epoch)
+	if err != nil {
+		return err
+	}
+	if len(stats) == 0 {
+		return nil
+	}
+
+	var sql strings.Builder
+	fmt.Fprint(&sql, `INSERT INTO client_diversity (epoch, cl_client, el_client, proposed_count, missed_count) VALUES `)
+	argIdx := 0
+	fieldCount := 5
+	args := make([]any, len(stats)*fieldCount)
+	for i, stat := range stats {
+		if i > 0 {
+			fmt.Fprintf(&sql, ", ")
+		}
+		fmt.Fprintf(&sql, "(")
+		for f := 0; f < fieldCount; f++ {
+			if f > 0 {
+				fmt.Fprintf(&sql, ", ")
+			}
+			fmt.Fprintf(&sql, "$%v", argIdx+f+1)
+		}
+		fmt.Fprintf(&sql, ")")
+
+		args[argIdx+0] = epoch
+		args[argIdx+1] = stat.ClClient
+		args[argIdx+2] = stat.ElClient
+		args[argIdx+3] = stat.ProposedCount
+		args[argIdx+4] = stat.MissedCount
+		argIdx += fieldCount
+	}
+	_, err = tx.Exec(sql.String(), args...)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetClientDiversity returns the client diversity stats of all epochs in the given range (ascending)
+func GetClientDiversity(minEpoch uint64, maxEpoch uint64) ([]*dbtypes.ClientDiversity, error) {
+	stats := []*dbtypes.ClientDiversity{}
+	err := ReaderDb.Select(&stats, `
+		SELECT epoch, cl_client, el_client, proposed_count, missed_count
+		FROM client_diversity
+		WHERE epoch >= $1 AND epoch <= $2
+		ORDER BY epoch ASC`, minEpoch, maxEpoch)
+	if err != nil {
+		logger.Errorf("Error while fetching client diversity: %v", err)
+		return nil, err
+	}
+	return stats, nil
+}
+
+// GetLatestProposerBlocks returns the graffiti & extra data of the latest canonical block of each of the given proposers
+func GetLatestProposerBlocks(proposers []uint64) ([]*dbtypes.Slot, error) {
+	slots := []*dbtypes.Slot{}
+	if len(proposers) == 0 {
+		return slots, nil
+	}
+
+	var sql strings.Builder
+	args := make([]any, len(proposers))
+	fmt.Fprint(&sql, `
+		SELECT slots.slot, slots.proposer, slots.graffiti, slots.eth_block_extra
+		FROM slots
+		JOIN (
+			SELECT proposer, MAX(slot) AS slot
+			FROM slots
+			WHERE status = 1 AND proposer IN (`)
+	for i, proposer := range proposers {
+		if i > 0 {
+			fmt.Fprint(&sql, ", ")
+		}
+		fmt.Fprintf(&sql, "$%v", i+1)
+		args[i] = proposer
+	}
+	fmt.Fprint(&sql, `)
+			GROUP BY proposer
+		) latest ON latest.proposer = slots.proposer AND latest.slot = slots.slot
+		WHERE slots.status = 1`)
+
+	err := ReaderDb.Select(&slots, sql.String(), args...)
+	if err != nil {
+		logger.Errorf("Error while fetching latest proposer blocks: %v", err)
+		return nil, err
+	}
+	return slots, nil
+}
@@ -16,11 +16,11 @@ func InsertDepositTxs(depositTxs []*dbtypes.DepositTx, tx *sqlx.Tx) error {
 			dbtypes.DBEnginePgsql:  "INSERT INTO deposit_txs ",
 			dbtypes.DBEngineSqlite: "INSERT OR REPLACE INTO deposit_txs ",
 		}),
-		"(deposit_index, block_number, block_time, block_root, publickey, withdrawalcredentials, amount, signature, valid_signature, orphaned, tx_hash, tx_sender, tx_target)",
+		"(deposit_index, block_number, block_time, block_root, publickey, withdrawalcredentials, amount, signature, valid_signature, orphaned, tx_hash, tx_sender, tx_target, deposit_root)",
 		" VALUES ",
 	)
 	argIdx := 0
-	fieldCount := 13
+	fieldCount := 14
 
 	args := make([]any, len(depositTxs)*fieldCount)
 	for i, depositTx := range depositTxs {
@@ -50,10 +50,11 @@ func InsertDepositTxs(depositTxs []*dbtypes.DepositTx, tx *sqlx.Tx) error {
 		args[argIdx+10] = depositTx.TxHash
 		args[argIdx+11] = depositTx.TxSender
 		args[argIdx+12] = depositTx.TxTarget
+		args[argIdx+13] = depositTx.DepositRoot
 		argIdx += fieldCount
 	}
 	fmt.Fprint(&sql, EngineQuery(map[dbtypes.DBEngineType]string{
-		dbtypes.DBEnginePgsql:  " ON CONFLICT (deposit_index, block_root) DO UPDATE SET orphaned = excluded.orphaned",
+		dbtypes.DBEnginePgsql:  " ON CONFLICT (deposit_index, block_root) DO UPDATE SET orphaned = excluded.orphaned, deposit_root = COALESCE(excluded.deposit_root, deposit_txs.deposit_root)",
 		dbtypes.DBEngineSqlite: "",
 	}))
 
@@ -141,6 +142,42 @@ func GetDepositTxs(firstIndex uint64, limit uint32) []*dbtypes.DepositTx {
 	return depositTxs
 }
 
+// GetCanonicalDepositTxs returns the canonical (not orphaned) deposit txs starting from firstIndex (ascending)
+func GetCanonicalDepositTxs(firstIndex uint64, limit uint32) ([]*dbtypes.DepositTx, error) {
+	depositTxs := []*dbtypes.DepositTx{}
+	err := ReaderDb.Select(&depositTxs, `
+	SELECT
+		deposit_index, block_number, block_time, block_root, publickey, withdrawalcredentials, amount, signature, valid_signature, orphaned, tx_hash, tx_sender, tx_target, deposit_root
+	FROM deposit_txs
+	WHERE deposit_index >= $1 AND orphaned = false
+	ORDER BY deposit_index ASC
+	LIMIT $2
+	`, firstIndex, limit)
+	if err != nil {
+		logger.Errorf("Error while fetching canonical deposit txs: %v", err)
+		return nil, err
+	}
+	return depositTxs, nil
+}
+
+// GetDepositTreeRoot returns the deposit root after the given number of deposits (nil if unknown)
+func GetDepositTreeRoot(depositCount uint64) []byte {
+	if depositCount == 0 {
+		return nil
+	}
+	depositRoot := []byte{}
+	err := ReaderDb.Get(&depositRoot, `
+	SELECT deposit_root
+	FROM deposit_txs
+	WHERE deposit_index = $1 AND orphaned = false AND deposit_root IS NOT NULL
+	LIMIT 1
+	`, depositCount-1)
+	if err != nil {
+		return nil
+	}
+	return depositRoot
+}
+
 func GetDepositTxsFiltered(offset uint64, limit uint32, finalizedBlock uint64, filter *dbtypes.DepositTxFilter) ([]*dbtypes.DepositTx, uint64, error) {
 	var sql strings.Builder
 	args := []any{}
@@ -312,3 +349,19 @@ func GetDepositsFiltered(offset uint64, limit uint32, finalizedBlock uint64, fil
 
 	return deposits[1:], deposits[0].SlotNumber, nil
 }
+
+// GetValidatorDepositSums returns the sum of all canonical deposits for a public key in the given slot range, grouped by epoch.
+func GetValidatorDepositSums(publicKey []byte, minSlot uint64, maxSlot uint64) ([]*dbtypes.EpochAmount, error) {
+	sums := []*dbtypes.EpochAmount{}
+	err := ReaderDb.Select(&sums, `
+		SELECT slot_number / $1 AS epoch, CAST(SUM(amount) AS BIGINT) AS amount
+		FROM deposits
+		WHERE publickey = $2 AND slot_number >= $3 AND slot_number <= $4 AND orphaned = false
+		GROUP BY slot_number / $1
+	`, utils.Config.Chain.Config.SlotsPerEpoch, publicKey, minSlot, maxSlot)
+	if err != nil {
+		logger.Errorf("Error while fetching validator deposit sums: %v", err)
+		return nil, err
+	}
+	return sums, nil
+}
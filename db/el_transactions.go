@@ -0,0 +1,132 @@
+package db
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ethpandaops/dora/dbtypes"
+	"github.com/jmoiron/sqlx"
+)
+
+func InsertElTransactions(transactions []*dbtypes.ElTransaction, tx *sqlx.Tx) error {
+	var sql strings.Builder
+	fmt.Fprint(&sql,
+		EngineQuery(map[dbtypes.DBEngineType]string{
+			dbtypes.DBEnginePgsql:  "INSERT INTO el_transactions ",
+			dbtypes.DBEngineSqlite: "INSERT OR REPLACE INTO el_transactions ",
+		}),
+		"(tx_hash, block_number, block_hash, block_time, tx_index, tx_from, tx_to, tx_type, nonce, value, method_sig, data_len, gas_limit, gas_used, status, contract_address)",
+		" VALUES ",
+	)
+	argIdx := 0
+	fieldCount := 16
+
+	args := make([]any, len(transactions)*fieldCount)
+	for i, transaction := range transactions {
+		if i > 0 {
+			fmt.Fprintf(&sql, ", ")
+		}
+		fmt.Fprintf(&sql, "(")
+		for f := 0; f < fieldCount; f++ {
+			if f > 0 {
+				fmt.Fprintf(&sql, ", ")
+			}
+			fmt.Fprintf(&sql, "$%v", argIdx+f+1)
+
+		}
+		fmt.Fprintf(&sql, ")")
+
+		args[argIdx+0] = transaction.TxHash
+		args[argIdx+1] = transaction.BlockNumber
+		args[argIdx+2] = transaction.BlockHash
+		args[argIdx+3] = transaction.BlockTime
+		args[argIdx+4] = transaction.TxIndex
+		args[argIdx+5] = transaction.TxFrom
+		args[argIdx+6] = transaction.TxTo
+		args[argIdx+7] = transaction.TxType
+		args[argIdx+8] = transaction.Nonce
+		args[argIdx+9] = transaction.Value
+		args[argIdx+10] = transaction.MethodSig
+		args[argIdx+11] = transaction.DataLen
+		args[argIdx+12] = transaction.GasLimit
+		args[argIdx+13] = transaction.GasUsed
+		args[argIdx+14] = transaction.Status
+		args[argIdx+15] = transaction.ContractAddress
+		argIdx += fieldCount
+	}
+	fmt.Fprint(&sql, EngineQuery(map[dbtypes.DBEngineType]string{
+		dbtypes.DBEnginePgsql:  " ON CONFLICT (tx_hash) DO UPDATE SET block_number = excluded.block_number, block_hash = excluded.block_hash, block_time = excluded.block_time, tx_index = excluded.tx_index, gas_used = excluded.gas_used, status = excluded.status, contract_address = excluded.contract_address",
+		dbtypes.DBEngineSqlite: "",
+	}))
+
+	_, err := tx.Exec(sql.String(), args...)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func GetElTransactionByHash(txHash []byte) *dbtypes.ElTransaction {
+	transaction := dbtypes.ElTransaction{}
+	err := ReaderDb.Get(&transaction, `
+	SELECT
+		tx_hash, block_number, block_hash, block_time, tx_index, tx_from, tx_to, tx_type, nonce, value, method_sig, data_len, gas_limit, gas_used, status, contract_address
+	FROM el_transactions
+	WHERE tx_hash = $1
+	`, txHash)
+	if err != nil {
+		return nil
+	}
+	return &transaction
+}
+
+// GetElTransactionsByAddress returns the transactions sent from or to the given address (most recent first) and the total number of matching transactions
+func GetElTransactionsByAddress(address []byte, offset uint64, limit uint32) ([]*dbtypes.ElTransaction, uint64, error) {
+	var sql strings.Builder
+	args := []any{address, limit}
+	fmt.Fprint(&sql, `
+	WITH cte AS (
+		SELECT
+			tx_hash, block_number, block_hash, block_time, tx_index, tx_from, tx_to, tx_type, nonce, value, method_sig, data_len, gas_limit, gas_used, status, contract_address
+		FROM el_transactions
+		WHERE tx_from = $1 OR tx_to = $1
+	)
+	SELECT
+		null AS tx_hash,
+		count(*) AS block_number,
+		null AS block_hash,
+		0 AS block_time,
+		0 AS tx_index,
+		null AS tx_from,
+		null AS tx_to,
+		0 AS tx_type,
+		0 AS nonce,
+		null AS value,
+		null AS method_sig,
+		0 AS data_len,
+		0 AS gas_limit,
+		null AS gas_used,
+		null AS status,
+		null AS contract_address
+	FROM cte
+	UNION ALL SELECT * FROM (
+	SELECT * FROM cte
+	ORDER BY block_number DESC, tx_index DESC
+	LIMIT $2
+	`)
+
+	if offset > 0 {
+		args = append(args, offset)
+		fmt.Fprintf(&sql, " OFFSET $%v ", len(args))
+	}
+	fmt.Fprintf(&sql, ") AS t1")
+
+	transactions := []*dbtypes.ElTransaction{}
+	err := ReaderDb.Select(&transactions, sql.String(), args...)
+	if err != nil {
+		logger.Errorf("Error while fetching el transactions by address: %v", err)
+		return nil, 0, err
+	}
+
+	return transactions[1:], transactions[0].BlockNumber, nil
+}
@@ -0,0 +1,72 @@
+package db
+
+import (
+	"fmt"
+
+	"github.com/ethpandaops/dora/dbtypes"
+	"github.com/jmoiron/sqlx"
+)
+
+func InsertEpochRewards(rewards *dbtypes.EpochRewards, tx *sqlx.Tx) error {
+	_, err := tx.Exec(EngineQuery(map[dbtypes.DBEngineType]string{
+		dbtypes.DBEnginePgsql: `
+			INSERT INTO epoch_rewards (
+				epoch, validator_count, ideal_rewards, attestation_rewards, attestation_penalties, inactivity_penalties,
+				sync_rewards, sync_penalties, validator_rewards
+			) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+			ON CONFLICT (epoch) DO UPDATE SET
+				validator_count = excluded.validator_count,
+				ideal_rewards = excluded.ideal_rewards,
+				attestation_rewards = excluded.attestation_rewards,
+				attestation_penalties = excluded.attestation_penalties,
+				inactivity_penalties = excluded.inactivity_penalties,
+				sync_rewards = excluded.sync_rewards,
+				sync_penalties = excluded.sync_penalties,
+				validator_rewards = excluded.validator_rewards`,
+		dbtypes.DBEngineSqlite: `
+			INSERT OR REPLACE INTO epoch_rewards (
+				epoch, validator_count, ideal_rewards, attestation_rewards, attestation_penalties, inactivity_penalties,
+				sync_rewards, sync_penalties, validator_rewards
+			) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`,
+	}),
+		rewards.Epoch, rewards.ValidatorCount, rewards.IdealRewards, rewards.AttestationRewards, rewards.AttestationPenalties, rewards.InactivityPenalties,
+		rewards.SyncRewards, rewards.SyncPenalties, rewards.ValidatorRewards)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetEpochRewards returns the reward totals of an epoch (without the per-validator rewards)
+func GetEpochRewards(epoch uint64) *dbtypes.EpochRewards {
+	rewards := dbtypes.EpochRewards{}
+	err := ReaderDb.Get(&rewards, `
+	SELECT
+		epoch, validator_count, ideal_rewards, attestation_rewards, attestation_penalties, inactivity_penalties,
+		sync_rewards, sync_penalties
+	FROM epoch_rewards
+	WHERE epoch = $1
+	`, epoch)
+	if err != nil {
+		return nil
+	}
+	return &rewards
+}
+
+// GetValidatorRewards returns the rewards of a validator for all persisted epochs in the given range (descending).
+// The rewards are empty for epochs where the validator index was beyond the stored rewards array.
+func GetValidatorRewards(validator uint64, minEpoch uint64, maxEpoch uint64, limit uint32) ([]*dbtypes.ValidatorReward, error) {
+	rewards := []*dbtypes.ValidatorReward{}
+	err := ReaderDb.Select(&rewards, fmt.Sprintf(`
+		SELECT epoch, %v AS rewards
+		FROM epoch_rewards
+		WHERE epoch >= $2 AND epoch <= $3
+		ORDER BY epoch DESC
+		LIMIT $4`, validatorRewardsColumn.selectValue(1),
+	), validatorRewardsColumn.valueOffset(validator), minEpoch, maxEpoch, limit)
+	if err != nil {
+		logger.Errorf("Error while fetching validator rewards: %v", err)
+		return nil, err
+	}
+	return rewards, nil
+}
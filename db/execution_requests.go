@@ -0,0 +1,446 @@
+package db
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ethpandaops/dora/dbtypes"
+	"github.com/ethpandaops/dora/utils"
+	"github.com/jmoiron/sqlx"
+)
+
+func InsertDepositRequests(depositRequests []*dbtypes.DepositRequest, tx *sqlx.Tx) error {
+	var sql strings.Builder
+	fmt.Fprint(&sql,
+		EngineQuery(map[dbtypes.DBEngineType]string{
+			dbtypes.DBEnginePgsql:  "INSERT INTO deposit_requests ",
+			dbtypes.DBEngineSqlite: "INSERT OR REPLACE INTO deposit_requests ",
+		}),
+		"(slot_number, slot_index, slot_root, orphaned, pubkey, withdrawal_credentials, amount, signature, deposit_index)",
+		" VALUES ",
+	)
+	argIdx := 0
+	fieldCount := 9
+
+	args := make([]any, len(depositRequests)*fieldCount)
+	for i, depositRequest := range depositRequests {
+		if i > 0 {
+			fmt.Fprintf(&sql, ", ")
+		}
+		fmt.Fprintf(&sql, "(")
+		for f := 0; f < fieldCount; f++ {
+			if f > 0 {
+				fmt.Fprintf(&sql, ", ")
+			}
+			fmt.Fprintf(&sql, "$%v", argIdx+f+1)
+
+		}
+		fmt.Fprintf(&sql, ")")
+
+		args[argIdx+0] = depositRequest.SlotNumber
+		args[argIdx+1] = depositRequest.SlotIndex
+		args[argIdx+2] = depositRequest.SlotRoot
+		args[argIdx+3] = depositRequest.Orphaned
+		args[argIdx+4] = depositRequest.PublicKey
+		args[argIdx+5] = depositRequest.WithdrawalCredentials
+		args[argIdx+6] = depositRequest.Amount
+		args[argIdx+7] = depositRequest.Signature
+		args[argIdx+8] = depositRequest.DepositIndex
+		argIdx += fieldCount
+	}
+	fmt.Fprint(&sql, EngineQuery(map[dbtypes.DBEngineType]string{
+		dbtypes.DBEnginePgsql:  " ON CONFLICT (slot_root, slot_index) DO UPDATE SET orphaned = excluded.orphaned",
+		dbtypes.DBEngineSqlite: "",
+	}))
+
+	_, err := tx.Exec(sql.String(), args...)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func InsertWithdrawalRequests(withdrawalRequests []*dbtypes.WithdrawalRequest, tx *sqlx.Tx) error {
+	var sql strings.Builder
+	fmt.Fprint(&sql,
+		EngineQuery(map[dbtypes.DBEngineType]string{
+			dbtypes.DBEnginePgsql:  "INSERT INTO withdrawal_requests ",
+			dbtypes.DBEngineSqlite: "INSERT OR REPLACE INTO withdrawal_requests ",
+		}),
+		"(slot_number, slot_index, slot_root, orphaned, source_address, validator_pubkey, amount)",
+		" VALUES ",
+	)
+	argIdx := 0
+	fieldCount := 7
+
+	args := make([]any, len(withdrawalRequests)*fieldCount)
+	for i, withdrawalRequest := range withdrawalRequests {
+		if i > 0 {
+			fmt.Fprintf(&sql, ", ")
+		}
+		fmt.Fprintf(&sql, "(")
+		for f := 0; f < fieldCount; f++ {
+			if f > 0 {
+				fmt.Fprintf(&sql, ", ")
+			}
+			fmt.Fprintf(&sql, "$%v", argIdx+f+1)
+
+		}
+		fmt.Fprintf(&sql, ")")
+
+		args[argIdx+0] = withdrawalRequest.SlotNumber
+		args[argIdx+1] = withdrawalRequest.SlotIndex
+		args[argIdx+2] = withdrawalRequest.SlotRoot
+		args[argIdx+3] = withdrawalRequest.Orphaned
+		args[argIdx+4] = withdrawalRequest.SourceAddress
+		args[argIdx+5] = withdrawalRequest.ValidatorPubkey
+		args[argIdx+6] = withdrawalRequest.Amount
+		argIdx += fieldCount
+	}
+	fmt.Fprint(&sql, EngineQuery(map[dbtypes.DBEngineType]string{
+		dbtypes.DBEnginePgsql:  " ON CONFLICT (slot_root, slot_index) DO UPDATE SET orphaned = excluded.orphaned",
+		dbtypes.DBEngineSqlite: "",
+	}))
+
+	_, err := tx.Exec(sql.String(), args...)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func InsertConsolidationRequests(consolidationRequests []*dbtypes.ConsolidationRequest, tx *sqlx.Tx) error {
+	var sql strings.Builder
+	fmt.Fprint(&sql,
+		EngineQuery(map[dbtypes.DBEngineType]string{
+			dbtypes.DBEnginePgsql:  "INSERT INTO consolidation_requests ",
+			dbtypes.DBEngineSqlite: "INSERT OR REPLACE INTO consolidation_requests ",
+		}),
+		"(slot_number, slot_index, slot_root, orphaned, source_address, source_pubkey, target_pubkey)",
+		" VALUES ",
+	)
+	argIdx := 0
+	fieldCount := 7
+
+	args := make([]any, len(consolidationRequests)*fieldCount)
+	for i, consolidationRequest := range consolidationRequests {
+		if i > 0 {
+			fmt.Fprintf(&sql, ", ")
+		}
+		fmt.Fprintf(&sql, "(")
+		for f := 0; f < fieldCount; f++ {
+			if f > 0 {
+				fmt.Fprintf(&sql, ", ")
+			}
+			fmt.Fprintf(&sql, "$%v", argIdx+f+1)
+
+		}
+		fmt.Fprintf(&sql, ")")
+
+		args[argIdx+0] = consolidationRequest.SlotNumber
+		args[argIdx+1] = consolidationRequest.SlotIndex
+		args[argIdx+2] = consolidationRequest.SlotRoot
+		args[argIdx+3] = consolidationRequest.Orphaned
+		args[argIdx+4] = consolidationRequest.SourceAddress
+		args[argIdx+5] = consolidationRequest.SourcePubkey
+		args[argIdx+6] = consolidationRequest.TargetPubkey
+		argIdx += fieldCount
+	}
+	fmt.Fprint(&sql, EngineQuery(map[dbtypes.DBEngineType]string{
+		dbtypes.DBEnginePgsql:  " ON CONFLICT (slot_root, slot_index) DO UPDATE SET orphaned = excluded.orphaned",
+		dbtypes.DBEngineSqlite: "",
+	}))
+
+	_, err := tx.Exec(sql.String(), args...)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func GetDepositRequestsBySlotRoot(slotRoot []byte) []*dbtypes.DepositRequest {
+	depositRequests := []*dbtypes.DepositRequest{}
+	err := ReaderDb.Select(&depositRequests, `
+	SELECT
+		slot_number, slot_index, slot_root, orphaned, pubkey, withdrawal_credentials, amount, signature, deposit_index
+	FROM deposit_requests
+	WHERE slot_root = $1
+	ORDER BY slot_index ASC
+	`, slotRoot)
+	if err != nil {
+		logger.Errorf("Error while fetching deposit requests for slot root 0x%x: %v", slotRoot, err)
+		return nil
+	}
+	return depositRequests
+}
+
+func GetWithdrawalRequestsBySlotRoot(slotRoot []byte) []*dbtypes.WithdrawalRequest {
+	withdrawalRequests := []*dbtypes.WithdrawalRequest{}
+	err := ReaderDb.Select(&withdrawalRequests, `
+	SELECT
+		slot_number, slot_index, slot_root, orphaned, source_address, validator_pubkey, amount
+	FROM withdrawal_requests
+	WHERE slot_root = $1
+	ORDER BY slot_index ASC
+	`, slotRoot)
+	if err != nil {
+		logger.Errorf("Error while fetching withdrawal requests for slot root 0x%x: %v", slotRoot, err)
+		return nil
+	}
+	return withdrawalRequests
+}
+
+func GetConsolidationRequestsBySlotRoot(slotRoot []byte) []*dbtypes.ConsolidationRequest {
+	consolidationRequests := []*dbtypes.ConsolidationRequest{}
+	err := ReaderDb.Select(&consolidationRequests, `
+	SELECT
+		slot_number, slot_index, slot_root, orphaned, source_address, source_pubkey, target_pubkey
+	FROM consolidation_requests
+	WHERE slot_root = $1
+	ORDER BY slot_index ASC
+	`, slotRoot)
+	if err != nil {
+		logger.Errorf("Error while fetching consolidation requests for slot root 0x%x: %v", slotRoot, err)
+		return nil
+	}
+	return consolidationRequests
+}
+
+func GetDepositRequestsFiltered(offset uint64, limit uint32, finalizedBlock uint64, filter *dbtypes.DepositRequestFilter) ([]*dbtypes.DepositRequest, uint64, error) {
+	var sql strings.Builder
+	args := []any{}
+	fmt.Fprint(&sql, `
+	WITH cte AS (
+		SELECT
+			slot_number, slot_index, slot_root, orphaned, pubkey, withdrawal_credentials, amount, signature, deposit_index
+		FROM deposit_requests
+	`)
+
+	filterOp := "WHERE"
+	if filter.MinSlot > 0 {
+		args = append(args, filter.MinSlot)
+		fmt.Fprintf(&sql, " %v slot_number >= $%v", filterOp, len(args))
+		filterOp = "AND"
+	}
+	if filter.MaxSlot > 0 {
+		args = append(args, filter.MaxSlot)
+		fmt.Fprintf(&sql, " %v slot_number <= $%v", filterOp, len(args))
+		filterOp = "AND"
+	}
+	if len(filter.PublicKey) > 0 {
+		args = append(args, filter.PublicKey)
+		fmt.Fprintf(&sql, " %v pubkey = $%v", filterOp, len(args))
+		filterOp = "AND"
+	}
+	if filter.MinAmount > 0 {
+		args = append(args, filter.MinAmount*utils.GWEI.Uint64())
+		fmt.Fprintf(&sql, " %v amount >= $%v", filterOp, len(args))
+		filterOp = "AND"
+	}
+	if filter.MaxAmount > 0 {
+		args = append(args, filter.MaxAmount*utils.GWEI.Uint64())
+		fmt.Fprintf(&sql, " %v amount <= $%v", filterOp, len(args))
+		filterOp = "AND"
+	}
+	if filter.WithOrphaned == 0 {
+		args = append(args, finalizedBlock)
+		fmt.Fprintf(&sql, " %v (slot_number > $%v OR orphaned = false)", filterOp, len(args))
+		filterOp = "AND"
+	} else if filter.WithOrphaned == 2 {
+		args = append(args, finalizedBlock)
+		fmt.Fprintf(&sql, " %v (slot_number > $%v OR orphaned = true)", filterOp, len(args))
+		filterOp = "AND"
+	}
+
+	args = append(args, limit)
+	fmt.Fprintf(&sql, `)
+	SELECT
+		count(*) AS slot_number,
+		0 AS slot_index,
+		null AS slot_root,
+		false AS orphaned,
+		null AS pubkey,
+		null AS withdrawal_credentials,
+		0 AS amount,
+		null AS signature,
+		0 AS deposit_index
+	FROM cte
+	UNION ALL SELECT * FROM (
+	SELECT * FROM cte
+	ORDER BY slot_number DESC, slot_index DESC
+	LIMIT $%v
+	`, len(args))
+
+	if offset > 0 {
+		args = append(args, offset)
+		fmt.Fprintf(&sql, " OFFSET $%v ", len(args))
+	}
+	fmt.Fprintf(&sql, ") AS t1")
+
+	depositRequests := []*dbtypes.DepositRequest{}
+	err := ReaderDb.Select(&depositRequests, sql.String(), args...)
+	if err != nil {
+		logger.Errorf("Error while fetching filtered deposit requests: %v", err)
+		return nil, 0, err
+	}
+
+	return depositRequests[1:], depositRequests[0].SlotNumber, nil
+}
+
+func GetWithdrawalRequestsFiltered(offset uint64, limit uint32, finalizedBlock uint64, filter *dbtypes.WithdrawalRequestFilter) ([]*dbtypes.WithdrawalRequest, uint64, error) {
+	var sql strings.Builder
+	args := []any{}
+	fmt.Fprint(&sql, `
+	WITH cte AS (
+		SELECT
+			slot_number, slot_index, slot_root, orphaned, source_address, validator_pubkey, amount
+		FROM withdrawal_requests
+	`)
+
+	filterOp := "WHERE"
+	if filter.MinSlot > 0 {
+		args = append(args, filter.MinSlot)
+		fmt.Fprintf(&sql, " %v slot_number >= $%v", filterOp, len(args))
+		filterOp = "AND"
+	}
+	if filter.MaxSlot > 0 {
+		args = append(args, filter.MaxSlot)
+		fmt.Fprintf(&sql, " %v slot_number <= $%v", filterOp, len(args))
+		filterOp = "AND"
+	}
+	if len(filter.SourceAddress) > 0 {
+		args = append(args, filter.SourceAddress)
+		fmt.Fprintf(&sql, " %v source_address = $%v", filterOp, len(args))
+		filterOp = "AND"
+	}
+	if len(filter.PublicKey) > 0 {
+		args = append(args, filter.PublicKey)
+		fmt.Fprintf(&sql, " %v validator_pubkey = $%v", filterOp, len(args))
+		filterOp = "AND"
+	}
+	if filter.MinAmount > 0 {
+		args = append(args, filter.MinAmount*utils.GWEI.Uint64())
+		fmt.Fprintf(&sql, " %v amount >= $%v", filterOp, len(args))
+		filterOp = "AND"
+	}
+	if filter.MaxAmount > 0 {
+		args = append(args, filter.MaxAmount*utils.GWEI.Uint64())
+		fmt.Fprintf(&sql, " %v amount <= $%v", filterOp, len(args))
+		filterOp = "AND"
+	}
+	if filter.WithOrphaned == 0 {
+		args = append(args, finalizedBlock)
+		fmt.Fprintf(&sql, " %v (slot_number > $%v OR orphaned = false)", filterOp, len(args))
+		filterOp = "AND"
+	} else if filter.WithOrphaned == 2 {
+		args = append(args, finalizedBlock)
+		fmt.Fprintf(&sql, " %v (slot_number > $%v OR orphaned = true)", filterOp, len(args))
+		filterOp = "AND"
+	}
+
+	args = append(args, limit)
+	fmt.Fprintf(&sql, `)
+	SELECT
+		count(*) AS slot_number,
+		0 AS slot_index,
+		null AS slot_root,
+		false AS orphaned,
+		null AS source_address,
+		null AS validator_pubkey,
+		0 AS amount
+	FROM cte
+	UNION ALL SELECT * FROM (
+	SELECT * FROM cte
+	ORDER BY slot_number DESC, slot_index DESC
+	LIMIT $%v
+	`, len(args))
+
+	if offset > 0 {
+		args = append(args, offset)
+		fmt.Fprintf(&sql, " OFFSET $%v ", len(args))
+	}
+	fmt.Fprintf(&sql, ") AS t1")
+
+	withdrawalRequests := []*dbtypes.WithdrawalRequest{}
+	err := ReaderDb.Select(&withdrawalRequests, sql.String(), args...)
+	if err != nil {
+		logger.Errorf("Error while fetching filtered withdrawal requests: %v", err)
+		return nil, 0, err
+	}
+
+	return withdrawalRequests[1:], withdrawalRequests[0].SlotNumber, nil
+}
+
+func GetConsolidationRequestsFiltered(offset uint64, limit uint32, finalizedBlock uint64, filter *dbtypes.ConsolidationRequestFilter) ([]*dbtypes.ConsolidationRequest, uint64, error) {
+	var sql strings.Builder
+	args := []any{}
+	fmt.Fprint(&sql, `
+	WITH cte AS (
+		SELECT
+			slot_number, slot_index, slot_root, orphaned, source_address, source_pubkey, target_pubkey
+		FROM consolidation_requests
+	`)
+
+	filterOp := "WHERE"
+	if filter.MinSlot > 0 {
+		args = append(args, filter.MinSlot)
+		fmt.Fprintf(&sql, " %v slot_number >= $%v", filterOp, len(args))
+		filterOp = "AND"
+	}
+	if filter.MaxSlot > 0 {
+		args = append(args, filter.MaxSlot)
+		fmt.Fprintf(&sql, " %v slot_number <= $%v", filterOp, len(args))
+		filterOp = "AND"
+	}
+	if len(filter.SourceAddress) > 0 {
+		args = append(args, filter.SourceAddress)
+		fmt.Fprintf(&sql, " %v source_address = $%v", filterOp, len(args))
+		filterOp = "AND"
+	}
+	if len(filter.PublicKey) > 0 {
+		args = append(args, filter.PublicKey)
+		fmt.Fprintf(&sql, " %v (source_pubkey = $%v OR target_pubkey = $%v)", filterOp, len(args), len(args))
+		filterOp = "AND"
+	}
+	if filter.WithOrphaned == 0 {
+		args = append(args, finalizedBlock)
+		fmt.Fprintf(&sql, " %v (slot_number > $%v OR orphaned = false)", filterOp, len(args))
+		filterOp = "AND"
+	} else if filter.WithOrphaned == 2 {
+		args = append(args, finalizedBlock)
+		fmt.Fprintf(&sql, " %v (slot_number > $%v OR orphaned = true)", filterOp, len(args))
+		filterOp = "AND"
+	}
+
+	args = append(args, limit)
+	fmt.Fprintf(&sql, `)
+	SELECT
+		count(*) AS slot_number,
+		0 AS slot_index,
+		null AS slot_root,
+		false AS orphaned,
+		null AS source_address,
+		null AS source_pubkey,
+		null AS target_pubkey
+	FROM cte
+	UNION ALL SELECT * FROM (
+	SELECT * FROM cte
+	ORDER BY slot_number DESC, slot_index DESC
+	LIMIT $%v
+	`, len(args))
+
+	if offset > 0 {
+		args = append(args, offset)
+		fmt.Fprintf(&sql, " OFFSET $%v ", len(args))
+	}
+	fmt.Fprintf(&sql, ") AS t1")
+
+	consolidationRequests := []*dbtypes.ConsolidationRequest{}
+	err := ReaderDb.Select(&consolidationRequests, sql.String(), args...)
+	if err != nil {
+		logger.Errorf("Error while fetching filtered consolidation requests: %v", err)
+		return nil, 0, err
+	}
+
+	return consolidationRequests[1:], consolidationRequests[0].SlotNumber, nil
+}
@@ -188,6 +188,11 @@ func GetMevBlocksFiltered(offset uint64, limit uint32, filter *dbtypes.MevBlockF
 		fmt.Fprintf(&sql, " %v builder_pubkey = $%v", filterOp, len(args))
 		filterOp = "AND"
 	}
+	if len(filter.FeeRecipient) > 0 {
+		args = append(args, filter.FeeRecipient)
+		fmt.Fprintf(&sql, " %v fee_recipient = $%v", filterOp, len(args))
+		filterOp = "AND"
+	}
 	if len(filter.MevRelay) > 0 {
 		seenbyPattern := uint64(0)
 		for _, relayId := range filter.MevRelay {
@@ -0,0 +1,37 @@
+package db
+
+import (
+	"fmt"
+
+	"github.com/ethpandaops/dora/dbtypes"
+)
+
+// packedColumn describes a bytea column that holds a fixed size value for each validator of an epoch,
+// with the value of validator i stored at byte offset i*size.
+// On pgsql these columns are created with STORAGE EXTERNAL (uncompressed), so single validator values
+// can be read via substring without loading & decompressing the whole column.
+type packedColumn struct {
+	name string
+	size uint64
+}
+
+var (
+	attestationOutcomesColumn = packedColumn{name: "outcomes", size: 1}
+	balancesColumn            = packedColumn{name: "balances", size: 8}
+	effectiveBalancesColumn   = packedColumn{name: "effective_balances", size: 2}
+	validatorRewardsColumn    = packedColumn{name: "validator_rewards", size: 8}
+)
+
+// selectValue returns the sql expression that reads a single validator value from the column.
+// The expression expects the offset of the value (see valueOffset) as query argument $argIdx.
+func (column packedColumn) selectValue(argIdx int) string {
+	return EngineQuery(map[dbtypes.DBEngineType]string{
+		dbtypes.DBEnginePgsql:  fmt.Sprintf("substring(%v from $%v for %v)", column.name, argIdx, column.size),
+		dbtypes.DBEngineSqlite: fmt.Sprintf("substr(%v, $%v, %v)", column.name, argIdx, column.size),
+	})
+}
+
+// valueOffset returns the (1-based) sql substring offset of a validator value in the column
+func (column packedColumn) valueOffset(validator uint64) uint64 {
+	return validator*column.size + 1
+}
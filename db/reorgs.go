@@ -0,0 +1,92 @@
+package db
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ethpandaops/dora/dbtypes"
+	"github.com/jmoiron/sqlx"
+)
+
+func InsertReorg(reorg *dbtypes.Reorg, tx *sqlx.Tx) error {
+	_, err := tx.Exec(EngineQuery(map[dbtypes.DBEngineType]string{
+		dbtypes.DBEnginePgsql: `
+			INSERT INTO reorgs (
+				slot_number, head_root, old_head_slot, old_head_root, ancestor_slot, ancestor_root, depth, detected_time, old_head_clients, new_head_clients
+			) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+			ON CONFLICT (old_head_root, head_root) DO NOTHING`,
+		dbtypes.DBEngineSqlite: `
+			INSERT OR IGNORE INTO reorgs (
+				slot_number, head_root, old_head_slot, old_head_root, ancestor_slot, ancestor_root, depth, detected_time, old_head_clients, new_head_clients
+			) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)`,
+	}),
+		reorg.SlotNumber, reorg.HeadRoot, reorg.OldHeadSlot, reorg.OldHeadRoot, reorg.AncestorSlot, reorg.AncestorRoot,
+		reorg.Depth, reorg.DetectedTime, reorg.OldHeadClients, reorg.NewHeadClients)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func GetReorgsFiltered(offset uint64, limit uint32, filter *dbtypes.ReorgFilter) ([]*dbtypes.Reorg, uint64, error) {
+	var sql strings.Builder
+	args := []any{}
+	fmt.Fprint(&sql, `
+	WITH cte AS (
+		SELECT
+			slot_number, head_root, old_head_slot, old_head_root, ancestor_slot, ancestor_root, depth, detected_time, old_head_clients, new_head_clients
+		FROM reorgs
+	`)
+
+	filterOp := "WHERE"
+	if filter.MinSlot > 0 {
+		args = append(args, filter.MinSlot)
+		fmt.Fprintf(&sql, " %v slot_number >= $%v", filterOp, len(args))
+		filterOp = "AND"
+	}
+	if filter.MaxSlot > 0 {
+		args = append(args, filter.MaxSlot)
+		fmt.Fprintf(&sql, " %v slot_number <= $%v", filterOp, len(args))
+		filterOp = "AND"
+	}
+	if filter.MinDepth > 0 {
+		args = append(args, filter.MinDepth)
+		fmt.Fprintf(&sql, " %v depth >= $%v", filterOp, len(args))
+		filterOp = "AND"
+	}
+
+	args = append(args, limit)
+	fmt.Fprintf(&sql, `) 
+	SELECT 
+		count(*) AS slot_number, 
+		null AS head_root,
+		0 AS old_head_slot,
+		null AS old_head_root,
+		null AS ancestor_slot,
+		null AS ancestor_root,
+		0 AS depth,
+		0 AS detected_time,
+		'' AS old_head_clients,
+		'' AS new_head_clients
+	FROM cte
+	UNION ALL SELECT * FROM (
+	SELECT * FROM cte
+	ORDER BY slot_number DESC, detected_time DESC
+	LIMIT $%v 
+	`, len(args))
+
+	if offset > 0 {
+		args = append(args, offset)
+		fmt.Fprintf(&sql, " OFFSET $%v ", len(args))
+	}
+	fmt.Fprintf(&sql, ") AS t1")
+
+	reorgs := []*dbtypes.Reorg{}
+	err := ReaderDb.Select(&reorgs, sql.String(), args...)
+	if err != nil {
+		logger.Errorf("Error while fetching filtered reorgs: %v", err)
+		return nil, 0, err
+	}
+
+	return reorgs[1:], reorgs[0].SlotNumber, nil
+}
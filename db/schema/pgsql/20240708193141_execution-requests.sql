@@ -0,0 +1,83 @@
+-- +goose Up
+-- +goose StatementBegin
+
+CREATE TABLE IF NOT EXISTS deposit_requests (
+    slot_number BIGINT NOT NULL,
+    slot_index INT NOT NULL,
+    slot_root bytea NOT NULL,
+    orphaned bool NOT NULL DEFAULT FALSE,
+    pubkey bytea NOT NULL,
+    withdrawal_credentials bytea NOT NULL,
+    amount BIGINT NOT NULL,
+    signature bytea NOT NULL,
+    deposit_index BIGINT NOT NULL,
+    CONSTRAINT deposit_requests_pkey PRIMARY KEY (slot_root, slot_index)
+);
+
+CREATE INDEX IF NOT EXISTS "deposit_requests_slot_number_idx"
+    ON public."deposit_requests"
+    ("slot_number" ASC NULLS FIRST);
+
+CREATE INDEX IF NOT EXISTS "deposit_requests_pubkey_idx"
+    ON public."deposit_requests"
+    ("pubkey" ASC NULLS FIRST);
+
+CREATE INDEX IF NOT EXISTS "deposit_requests_deposit_index_idx"
+    ON public."deposit_requests"
+    ("deposit_index" ASC NULLS FIRST);
+
+CREATE TABLE IF NOT EXISTS withdrawal_requests (
+    slot_number BIGINT NOT NULL,
+    slot_index INT NOT NULL,
+    slot_root bytea NOT NULL,
+    orphaned bool NOT NULL DEFAULT FALSE,
+    source_address bytea NOT NULL,
+    validator_pubkey bytea NOT NULL,
+    amount BIGINT NOT NULL,
+    CONSTRAINT withdrawal_requests_pkey PRIMARY KEY (slot_root, slot_index)
+);
+
+CREATE INDEX IF NOT EXISTS "withdrawal_requests_slot_number_idx"
+    ON public."withdrawal_requests"
+    ("slot_number" ASC NULLS FIRST);
+
+CREATE INDEX IF NOT EXISTS "withdrawal_requests_source_address_idx"
+    ON public."withdrawal_requests"
+    ("source_address" ASC NULLS FIRST);
+
+CREATE INDEX IF NOT EXISTS "withdrawal_requests_validator_pubkey_idx"
+    ON public."withdrawal_requests"
+    ("validator_pubkey" ASC NULLS FIRST);
+
+CREATE TABLE IF NOT EXISTS consolidation_requests (
+    slot_number BIGINT NOT NULL,
+    slot_index INT NOT NULL,
+    slot_root bytea NOT NULL,
+    orphaned bool NOT NULL DEFAULT FALSE,
+    source_address bytea NOT NULL,
+    source_pubkey bytea NOT NULL,
+    target_pubkey bytea NOT NULL,
+    CONSTRAINT consolidation_requests_pkey PRIMARY KEY (slot_root, slot_index)
+);
+
+CREATE INDEX IF NOT EXISTS "consolidation_requests_slot_number_idx"
+    ON public."consolidation_requests"
+    ("slot_number" ASC NULLS FIRST);
+
+CREATE INDEX IF NOT EXISTS "consolidation_requests_source_address_idx"
+    ON public."consolidation_requests"
+    ("source_address" ASC NULLS FIRST);
+
+CREATE INDEX IF NOT EXISTS "consolidation_requests_source_pubkey_idx"
+    ON public."consolidation_requests"
+    ("source_pubkey" ASC NULLS FIRST);
+
+CREATE INDEX IF NOT EXISTS "consolidation_requests_target_pubkey_idx"
+    ON public."consolidation_requests"
+    ("target_pubkey" ASC NULLS FIRST);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'NOT SUPPORTED';
+-- +goose StatementEnd
@@ -0,0 +1,44 @@
+-- +goose Up
+-- +goose StatementBegin
+
+CREATE TABLE IF NOT EXISTS withdrawals (
+    slot_number BIGINT NOT NULL,
+    slot_index INT NOT NULL,
+    slot_root bytea NOT NULL,
+    orphaned bool NOT NULL DEFAULT FALSE,
+    withdrawal_index BIGINT NOT NULL,
+    validator BIGINT NOT NULL,
+    address bytea NOT NULL,
+    amount BIGINT NOT NULL,
+    type INT NOT NULL,
+    CONSTRAINT withdrawals_pkey PRIMARY KEY (slot_root, slot_index)
+);
+
+CREATE INDEX IF NOT EXISTS "withdrawals_slot_number_idx"
+    ON public."withdrawals"
+    ("slot_number" ASC NULLS FIRST);
+
+CREATE INDEX IF NOT EXISTS "withdrawals_withdrawal_index_idx"
+    ON public."withdrawals"
+    ("withdrawal_index" ASC NULLS FIRST);
+
+CREATE INDEX IF NOT EXISTS "withdrawals_validator_idx"
+    ON public."withdrawals"
+    ("validator" ASC NULLS FIRST);
+
+CREATE INDEX IF NOT EXISTS "withdrawals_address_idx"
+    ON public."withdrawals"
+    ("address" ASC NULLS FIRST);
+
+CREATE INDEX IF NOT EXISTS "withdrawals_type_slot_number_idx"
+    ON public."withdrawals"
+    (
+        "type" ASC NULLS FIRST,
+        "slot_number" ASC NULLS FIRST
+    );
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'NOT SUPPORTED';
+-- +goose StatementEnd
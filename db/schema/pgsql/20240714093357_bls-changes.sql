@@ -0,0 +1,31 @@
+-- +goose Up
+-- +goose StatementBegin
+
+CREATE TABLE IF NOT EXISTS bls_changes (
+    slot_number BIGINT NOT NULL,
+    slot_index INT NOT NULL,
+    slot_root bytea NOT NULL,
+    orphaned bool NOT NULL DEFAULT FALSE,
+    validator BIGINT NOT NULL,
+    pubkey bytea NOT NULL,
+    address bytea NOT NULL,
+    CONSTRAINT bls_changes_pkey PRIMARY KEY (slot_root, slot_index)
+);
+
+CREATE INDEX IF NOT EXISTS "bls_changes_slot_number_idx"
+    ON public."bls_changes"
+    ("slot_number" ASC NULLS FIRST);
+
+CREATE INDEX IF NOT EXISTS "bls_changes_validator_idx"
+    ON public."bls_changes"
+    ("validator" ASC NULLS FIRST);
+
+CREATE INDEX IF NOT EXISTS "bls_changes_address_idx"
+    ON public."bls_changes"
+    ("address" ASC NULLS FIRST);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'NOT SUPPORTED';
+-- +goose StatementEnd
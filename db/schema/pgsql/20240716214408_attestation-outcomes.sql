@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+
+CREATE TABLE IF NOT EXISTS attestation_outcomes (
+    epoch BIGINT NOT NULL,
+    outcomes bytea NOT NULL,
+    CONSTRAINT attestation_outcomes_pkey PRIMARY KEY (epoch)
+);
+
+ALTER TABLE attestation_outcomes ALTER COLUMN outcomes SET STORAGE EXTERNAL;
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'NOT SUPPORTED';
+-- +goose StatementEnd
@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+
+CREATE TABLE IF NOT EXISTS balance_snapshots (
+    epoch BIGINT NOT NULL,
+    balances bytea NOT NULL,
+    effective_balances bytea NOT NULL,
+    CONSTRAINT balance_snapshots_pkey PRIMARY KEY (epoch)
+);
+
+ALTER TABLE balance_snapshots ALTER COLUMN balances SET STORAGE EXTERNAL;
+ALTER TABLE balance_snapshots ALTER COLUMN effective_balances SET STORAGE EXTERNAL;
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'NOT SUPPORTED';
+-- +goose StatementEnd
@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+
+CREATE TABLE IF NOT EXISTS sync_participations (
+    slot_number BIGINT NOT NULL,
+    slot_root bytea NOT NULL,
+    orphaned bool NOT NULL DEFAULT FALSE,
+    bits bytea NOT NULL,
+    CONSTRAINT sync_participations_pkey PRIMARY KEY (slot_root)
+);
+
+CREATE INDEX IF NOT EXISTS "sync_participations_slot_number_idx"
+    ON public."sync_participations"
+    ("slot_number" ASC NULLS FIRST);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'NOT SUPPORTED';
+-- +goose StatementEnd
@@ -0,0 +1,30 @@
+-- +goose Up
+-- +goose StatementBegin
+
+CREATE TABLE IF NOT EXISTS reorgs (
+    slot_number BIGINT NOT NULL,
+    head_root bytea NOT NULL,
+    old_head_slot BIGINT NOT NULL,
+    old_head_root bytea NOT NULL,
+    ancestor_slot BIGINT NULL,
+    ancestor_root bytea NULL,
+    depth BIGINT NOT NULL,
+    detected_time BIGINT NOT NULL,
+    old_head_clients TEXT NOT NULL DEFAULT '',
+    new_head_clients TEXT NOT NULL DEFAULT '',
+    CONSTRAINT reorgs_pkey PRIMARY KEY (old_head_root, head_root)
+);
+
+CREATE INDEX IF NOT EXISTS "reorgs_slot_number_idx"
+    ON public."reorgs"
+    ("slot_number" ASC NULLS FIRST);
+
+CREATE INDEX IF NOT EXISTS "reorgs_depth_idx"
+    ON public."reorgs"
+    ("depth" ASC NULLS FIRST);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'NOT SUPPORTED';
+-- +goose StatementEnd
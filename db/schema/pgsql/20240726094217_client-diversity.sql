@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+
+CREATE TABLE IF NOT EXISTS client_diversity (
+    epoch BIGINT NOT NULL,
+    cl_client TEXT NOT NULL,
+    el_client TEXT NOT NULL,
+    proposed_count INT NOT NULL DEFAULT 0,
+    missed_count INT NOT NULL DEFAULT 0,
+    CONSTRAINT client_diversity_pkey PRIMARY KEY (epoch, cl_client, el_client)
+);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'NOT SUPPORTED';
+-- +goose StatementEnd
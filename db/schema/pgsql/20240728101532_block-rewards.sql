@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+
+ALTER TABLE public."slots"
+    ADD COLUMN IF NOT EXISTS "cl_reward" BIGINT NULL,
+    ADD COLUMN IF NOT EXISTS "cl_reward_attestations" BIGINT NULL,
+    ADD COLUMN IF NOT EXISTS "cl_reward_sync" BIGINT NULL,
+    ADD COLUMN IF NOT EXISTS "cl_reward_slashings" BIGINT NULL,
+    ADD COLUMN IF NOT EXISTS "el_priority_fees" BIGINT NULL;
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'NOT SUPPORTED';
+-- +goose StatementEnd
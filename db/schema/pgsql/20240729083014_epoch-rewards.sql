@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+
+CREATE TABLE IF NOT EXISTS epoch_rewards (
+    epoch BIGINT NOT NULL,
+    validator_count BIGINT NOT NULL DEFAULT 0,
+    ideal_rewards BIGINT NOT NULL DEFAULT 0,
+    attestation_rewards BIGINT NOT NULL DEFAULT 0,
+    attestation_penalties BIGINT NOT NULL DEFAULT 0,
+    inactivity_penalties BIGINT NOT NULL DEFAULT 0,
+    sync_rewards BIGINT NOT NULL DEFAULT 0,
+    sync_penalties BIGINT NOT NULL DEFAULT 0,
+    validator_rewards bytea NOT NULL,
+    CONSTRAINT epoch_rewards_pkey PRIMARY KEY (epoch)
+);
+
+ALTER TABLE epoch_rewards ALTER COLUMN validator_rewards SET STORAGE EXTERNAL;
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'NOT SUPPORTED';
+-- +goose StatementEnd
@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+
+CREATE TABLE IF NOT EXISTS validator_queue_stats (
+    epoch BIGINT NOT NULL,
+    active_count BIGINT NOT NULL DEFAULT 0,
+    activation_count BIGINT NOT NULL DEFAULT 0,
+    activation_amount BIGINT NOT NULL DEFAULT 0,
+    exit_count BIGINT NOT NULL DEFAULT 0,
+    exit_amount BIGINT NOT NULL DEFAULT 0,
+    activation_churn BIGINT NOT NULL DEFAULT 0,
+    exit_churn BIGINT NOT NULL DEFAULT 0,
+    CONSTRAINT validator_queue_stats_pkey PRIMARY KEY (epoch)
+);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'NOT SUPPORTED';
+-- +goose StatementEnd
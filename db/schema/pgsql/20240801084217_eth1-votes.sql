@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+
+ALTER TABLE public."slots"
+    ADD COLUMN IF NOT EXISTS "eth1_data_root" bytea NULL,
+    ADD COLUMN IF NOT EXISTS "eth1_data_count" BIGINT NULL,
+    ADD COLUMN IF NOT EXISTS "eth1_data_block_hash" bytea NULL;
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'NOT SUPPORTED';
+-- +goose StatementEnd
@@ -0,0 +1,40 @@
+-- +goose Up
+-- +goose StatementBegin
+
+CREATE TABLE IF NOT EXISTS el_transactions (
+    tx_hash bytea NOT NULL,
+    block_number BIGINT NOT NULL,
+    block_hash bytea NOT NULL,
+    block_time BIGINT NOT NULL,
+    tx_index INT NOT NULL,
+    tx_from bytea NOT NULL,
+    tx_to bytea NULL,
+    tx_type INT NOT NULL DEFAULT 0,
+    nonce BIGINT NOT NULL DEFAULT 0,
+    value bytea NULL,
+    method_sig bytea NULL,
+    data_len INT NOT NULL DEFAULT 0,
+    gas_limit BIGINT NOT NULL DEFAULT 0,
+    gas_used BIGINT NULL,
+    status INT NULL,
+    contract_address bytea NULL,
+    CONSTRAINT el_transactions_pkey PRIMARY KEY (tx_hash)
+);
+
+CREATE INDEX IF NOT EXISTS "el_transactions_block_idx"
+    ON public."el_transactions"
+    ("block_number" ASC NULLS LAST, "tx_index" ASC NULLS LAST);
+
+CREATE INDEX IF NOT EXISTS "el_transactions_from_idx"
+    ON public."el_transactions"
+    ("tx_from" ASC NULLS LAST, "block_number" DESC NULLS LAST);
+
+CREATE INDEX IF NOT EXISTS "el_transactions_to_idx"
+    ON public."el_transactions"
+    ("tx_to" ASC NULLS LAST, "block_number" DESC NULLS LAST);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'NOT SUPPORTED';
+-- +goose StatementEnd
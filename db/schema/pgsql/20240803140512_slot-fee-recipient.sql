@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+
+ALTER TABLE public."slots"
+    ADD COLUMN IF NOT EXISTS "eth_fee_recipient" bytea NULL;
+
+CREATE INDEX IF NOT EXISTS "slots_eth_fee_recipient_idx"
+    ON public."slots"
+    ("eth_fee_recipient" ASC NULLS LAST);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'NOT SUPPORTED';
+-- +goose StatementEnd
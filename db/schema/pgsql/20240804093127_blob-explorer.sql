@@ -0,0 +1,39 @@
+-- +goose Up
+-- +goose StatementBegin
+
+ALTER TABLE public."blob_assignments"
+    ADD COLUMN IF NOT EXISTS "blob_index" INT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS "versioned_hash" bytea NULL,
+    ADD COLUMN IF NOT EXISTS "tx_hash" bytea NULL,
+    ADD COLUMN IF NOT EXISTS "tx_sender" bytea NULL;
+
+UPDATE public."blob_assignments"
+    SET "versioned_hash" = set_byte(sha256("commitment"), 0, 1)
+    WHERE "versioned_hash" IS NULL;
+
+CREATE INDEX IF NOT EXISTS "blob_assignments_versioned_hash_idx"
+    ON public."blob_assignments"
+    ("versioned_hash" ASC NULLS LAST);
+
+CREATE INDEX IF NOT EXISTS "blob_assignments_tx_sender_idx"
+    ON public."blob_assignments"
+    ("tx_sender" ASC NULLS LAST);
+
+CREATE INDEX IF NOT EXISTS "blob_assignments_slot_idx"
+    ON public."blob_assignments"
+    ("slot" ASC NULLS LAST);
+
+CREATE TABLE IF NOT EXISTS public."blob_stats" (
+    "epoch" BIGINT NOT NULL,
+    "block_count" INT NOT NULL DEFAULT 0,
+    "blob_count" INT NOT NULL DEFAULT 0,
+    "blob_gas_used" BIGINT NOT NULL DEFAULT 0,
+    "blob_base_fee" BIGINT NOT NULL DEFAULT 0,
+    CONSTRAINT "blob_stats_pkey" PRIMARY KEY ("epoch")
+);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'NOT SUPPORTED';
+-- +goose StatementEnd
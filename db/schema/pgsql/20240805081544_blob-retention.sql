@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+
+ALTER TABLE public."blobs" ADD COLUMN IF NOT EXISTS "pruned" boolean NOT NULL DEFAULT false;
+
+CREATE INDEX IF NOT EXISTS "blobs_pruned_idx"
+    ON public."blobs"
+    ("pruned" ASC NULLS LAST);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'NOT SUPPORTED';
+-- +goose StatementEnd
@@ -0,0 +1,83 @@
+-- +goose Up
+-- +goose StatementBegin
+
+CREATE TABLE IF NOT EXISTS deposit_requests (
+    slot_number INT NOT NULL,
+    slot_index INT NOT NULL,
+    slot_root BLOB NOT NULL,
+    orphaned bool NOT NULL DEFAULT FALSE,
+    pubkey BLOB NOT NULL,
+    withdrawal_credentials BLOB NOT NULL,
+    amount BIGINT NOT NULL,
+    signature BLOB NOT NULL,
+    deposit_index BIGINT NOT NULL,
+    CONSTRAINT deposit_requests_pkey PRIMARY KEY (slot_root, slot_index)
+);
+
+CREATE INDEX IF NOT EXISTS "deposit_requests_slot_number_idx"
+    ON "deposit_requests"
+    ("slot_number" ASC);
+
+CREATE INDEX IF NOT EXISTS "deposit_requests_pubkey_idx"
+    ON "deposit_requests"
+    ("pubkey" ASC);
+
+CREATE INDEX IF NOT EXISTS "deposit_requests_deposit_index_idx"
+    ON "deposit_requests"
+    ("deposit_index" ASC);
+
+CREATE TABLE IF NOT EXISTS withdrawal_requests (
+    slot_number INT NOT NULL,
+    slot_index INT NOT NULL,
+    slot_root BLOB NOT NULL,
+    orphaned bool NOT NULL DEFAULT FALSE,
+    source_address BLOB NOT NULL,
+    validator_pubkey BLOB NOT NULL,
+    amount BIGINT NOT NULL,
+    CONSTRAINT withdrawal_requests_pkey PRIMARY KEY (slot_root, slot_index)
+);
+
+CREATE INDEX IF NOT EXISTS "withdrawal_requests_slot_number_idx"
+    ON "withdrawal_requests"
+    ("slot_number" ASC);
+
+CREATE INDEX IF NOT EXISTS "withdrawal_requests_source_address_idx"
+    ON "withdrawal_requests"
+    ("source_address" ASC);
+
+CREATE INDEX IF NOT EXISTS "withdrawal_requests_validator_pubkey_idx"
+    ON "withdrawal_requests"
+    ("validator_pubkey" ASC);
+
+CREATE TABLE IF NOT EXISTS consolidation_requests (
+    slot_number INT NOT NULL,
+    slot_index INT NOT NULL,
+    slot_root BLOB NOT NULL,
+    orphaned bool NOT NULL DEFAULT FALSE,
+    source_address BLOB NOT NULL,
+    source_pubkey BLOB NOT NULL,
+    target_pubkey BLOB NOT NULL,
+    CONSTRAINT consolidation_requests_pkey PRIMARY KEY (slot_root, slot_index)
+);
+
+CREATE INDEX IF NOT EXISTS "consolidation_requests_slot_number_idx"
+    ON "consolidation_requests"
+    ("slot_number" ASC);
+
+CREATE INDEX IF NOT EXISTS "consolidation_requests_source_address_idx"
+    ON "consolidation_requests"
+    ("source_address" ASC);
+
+CREATE INDEX IF NOT EXISTS "consolidation_requests_source_pubkey_idx"
+    ON "consolidation_requests"
+    ("source_pubkey" ASC);
+
+CREATE INDEX IF NOT EXISTS "consolidation_requests_target_pubkey_idx"
+    ON "consolidation_requests"
+    ("target_pubkey" ASC);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'NOT SUPPORTED';
+-- +goose StatementEnd
@@ -0,0 +1,44 @@
+-- +goose Up
+-- +goose StatementBegin
+
+CREATE TABLE IF NOT EXISTS withdrawals (
+    slot_number INT NOT NULL,
+    slot_index INT NOT NULL,
+    slot_root BLOB NOT NULL,
+    orphaned bool NOT NULL DEFAULT FALSE,
+    withdrawal_index BIGINT NOT NULL,
+    validator BIGINT NOT NULL,
+    address BLOB NOT NULL,
+    amount BIGINT NOT NULL,
+    type INT NOT NULL,
+    CONSTRAINT withdrawals_pkey PRIMARY KEY (slot_root, slot_index)
+);
+
+CREATE INDEX IF NOT EXISTS "withdrawals_slot_number_idx"
+    ON "withdrawals"
+    ("slot_number" ASC);
+
+CREATE INDEX IF NOT EXISTS "withdrawals_withdrawal_index_idx"
+    ON "withdrawals"
+    ("withdrawal_index" ASC);
+
+CREATE INDEX IF NOT EXISTS "withdrawals_validator_idx"
+    ON "withdrawals"
+    ("validator" ASC);
+
+CREATE INDEX IF NOT EXISTS "withdrawals_address_idx"
+    ON "withdrawals"
+    ("address" ASC);
+
+CREATE INDEX IF NOT EXISTS "withdrawals_type_slot_number_idx"
+    ON "withdrawals"
+    (
+        "type" ASC,
+        "slot_number" ASC
+    );
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'NOT SUPPORTED';
+-- +goose StatementEnd
@@ -0,0 +1,31 @@
+-- +goose Up
+-- +goose StatementBegin
+
+CREATE TABLE IF NOT EXISTS bls_changes (
+    slot_number INT NOT NULL,
+    slot_index INT NOT NULL,
+    slot_root BLOB NOT NULL,
+    orphaned bool NOT NULL DEFAULT FALSE,
+    validator BIGINT NOT NULL,
+    pubkey BLOB NOT NULL,
+    address BLOB NOT NULL,
+    CONSTRAINT bls_changes_pkey PRIMARY KEY (slot_root, slot_index)
+);
+
+CREATE INDEX IF NOT EXISTS "bls_changes_slot_number_idx"
+    ON "bls_changes"
+    ("slot_number" ASC);
+
+CREATE INDEX IF NOT EXISTS "bls_changes_validator_idx"
+    ON "bls_changes"
+    ("validator" ASC);
+
+CREATE INDEX IF NOT EXISTS "bls_changes_address_idx"
+    ON "bls_changes"
+    ("address" ASC);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'NOT SUPPORTED';
+-- +goose StatementEnd
@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+
+CREATE TABLE IF NOT EXISTS attestation_outcomes (
+    epoch INT NOT NULL,
+    outcomes BLOB NOT NULL,
+    CONSTRAINT attestation_outcomes_pkey PRIMARY KEY (epoch)
+);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'NOT SUPPORTED';
+-- +goose StatementEnd
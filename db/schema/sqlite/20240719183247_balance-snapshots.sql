@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+
+CREATE TABLE IF NOT EXISTS balance_snapshots (
+    epoch INT NOT NULL,
+    balances BLOB NOT NULL,
+    effective_balances BLOB NOT NULL,
+    CONSTRAINT balance_snapshots_pkey PRIMARY KEY (epoch)
+);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'NOT SUPPORTED';
+-- +goose StatementEnd
@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+
+CREATE TABLE IF NOT EXISTS sync_participations (
+    slot_number INT NOT NULL,
+    slot_root BLOB NOT NULL,
+    orphaned bool NOT NULL DEFAULT FALSE,
+    bits BLOB NOT NULL,
+    CONSTRAINT sync_participations_pkey PRIMARY KEY (slot_root)
+);
+
+CREATE INDEX IF NOT EXISTS "sync_participations_slot_number_idx"
+    ON "sync_participations"
+    ("slot_number" ASC);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'NOT SUPPORTED';
+-- +goose StatementEnd
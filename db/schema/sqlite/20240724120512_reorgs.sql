@@ -0,0 +1,30 @@
+-- +goose Up
+-- +goose StatementBegin
+
+CREATE TABLE IF NOT EXISTS reorgs (
+    slot_number INT NOT NULL,
+    head_root BLOB NOT NULL,
+    old_head_slot INT NOT NULL,
+    old_head_root BLOB NOT NULL,
+    ancestor_slot INT NULL,
+    ancestor_root BLOB NULL,
+    depth INT NOT NULL,
+    detected_time INT NOT NULL,
+    old_head_clients TEXT NOT NULL DEFAULT '',
+    new_head_clients TEXT NOT NULL DEFAULT '',
+    CONSTRAINT reorgs_pkey PRIMARY KEY (old_head_root, head_root)
+);
+
+CREATE INDEX IF NOT EXISTS "reorgs_slot_number_idx"
+    ON "reorgs"
+    ("slot_number" ASC);
+
+CREATE INDEX IF NOT EXISTS "reorgs_depth_idx"
+    ON "reorgs"
+    ("depth" ASC);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'NOT SUPPORTED';
+-- +goose StatementEnd
@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+
+ALTER TABLE "slots" ADD "cl_reward" INT NULL;
+ALTER TABLE "slots" ADD "cl_reward_attestations" INT NULL;
+ALTER TABLE "slots" ADD "cl_reward_sync" INT NULL;
+ALTER TABLE "slots" ADD "cl_reward_slashings" INT NULL;
+ALTER TABLE "slots" ADD "el_priority_fees" INT NULL;
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'NOT SUPPORTED';
+-- +goose StatementEnd
@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+
+CREATE TABLE IF NOT EXISTS epoch_rewards (
+    epoch INT NOT NULL,
+    validator_count INT NOT NULL DEFAULT 0,
+    ideal_rewards INT NOT NULL DEFAULT 0,
+    attestation_rewards INT NOT NULL DEFAULT 0,
+    attestation_penalties INT NOT NULL DEFAULT 0,
+    inactivity_penalties INT NOT NULL DEFAULT 0,
+    sync_rewards INT NOT NULL DEFAULT 0,
+    sync_penalties INT NOT NULL DEFAULT 0,
+    validator_rewards BLOB NOT NULL,
+    CONSTRAINT epoch_rewards_pkey PRIMARY KEY (epoch)
+);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'NOT SUPPORTED';
+-- +goose StatementEnd
@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+
+CREATE TABLE IF NOT EXISTS validator_queue_stats (
+    epoch INT NOT NULL,
+    active_count INT NOT NULL DEFAULT 0,
+    activation_count INT NOT NULL DEFAULT 0,
+    activation_amount INT NOT NULL DEFAULT 0,
+    exit_count INT NOT NULL DEFAULT 0,
+    exit_amount INT NOT NULL DEFAULT 0,
+    activation_churn INT NOT NULL DEFAULT 0,
+    exit_churn INT NOT NULL DEFAULT 0,
+    CONSTRAINT validator_queue_stats_pkey PRIMARY KEY (epoch)
+);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'NOT SUPPORTED';
+-- +goose StatementEnd
@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+
+ALTER TABLE "slots" ADD "eth1_data_root" BLOB NULL;
+ALTER TABLE "slots" ADD "eth1_data_count" INT NULL;
+ALTER TABLE "slots" ADD "eth1_data_block_hash" BLOB NULL;
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'NOT SUPPORTED';
+-- +goose StatementEnd
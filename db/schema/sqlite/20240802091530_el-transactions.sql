@@ -0,0 +1,40 @@
+-- +goose Up
+-- +goose StatementBegin
+
+CREATE TABLE IF NOT EXISTS el_transactions (
+    tx_hash BLOB NOT NULL,
+    block_number INT NOT NULL,
+    block_hash BLOB NOT NULL,
+    block_time INT NOT NULL,
+    tx_index INT NOT NULL,
+    tx_from BLOB NOT NULL,
+    tx_to BLOB NULL,
+    tx_type INT NOT NULL DEFAULT 0,
+    nonce INT NOT NULL DEFAULT 0,
+    value BLOB NULL,
+    method_sig BLOB NULL,
+    data_len INT NOT NULL DEFAULT 0,
+    gas_limit INT NOT NULL DEFAULT 0,
+    gas_used INT NULL,
+    status INT NULL,
+    contract_address BLOB NULL,
+    CONSTRAINT el_transactions_pkey PRIMARY KEY (tx_hash)
+);
+
+CREATE INDEX IF NOT EXISTS "el_transactions_block_idx"
+    ON "el_transactions"
+    ("block_number" ASC, "tx_index" ASC);
+
+CREATE INDEX IF NOT EXISTS "el_transactions_from_idx"
+    ON "el_transactions"
+    ("tx_from" ASC, "block_number" DESC);
+
+CREATE INDEX IF NOT EXISTS "el_transactions_to_idx"
+    ON "el_transactions"
+    ("tx_to" ASC, "block_number" DESC);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'NOT SUPPORTED';
+-- +goose StatementEnd
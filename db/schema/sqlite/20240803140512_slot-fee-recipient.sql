@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+
+ALTER TABLE "slots" ADD "eth_fee_recipient" BLOB NULL;
+
+CREATE INDEX IF NOT EXISTS "slots_eth_fee_recipient_idx"
+    ON "slots"
+    ("eth_fee_recipient" ASC);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'NOT SUPPORTED';
+-- +goose StatementEnd
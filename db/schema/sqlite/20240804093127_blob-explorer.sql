@@ -0,0 +1,36 @@
+-- +goose Up
+-- +goose StatementBegin
+
+ALTER TABLE "blob_assignments" ADD "blob_index" INT NOT NULL DEFAULT 0;
+ALTER TABLE "blob_assignments" ADD "versioned_hash" BLOB NULL;
+ALTER TABLE "blob_assignments" ADD "tx_hash" BLOB NULL;
+ALTER TABLE "blob_assignments" ADD "tx_sender" BLOB NULL;
+
+-- sqlite has no sha256 function, so versioned hashes of already indexed blobs are not backfilled
+
+CREATE INDEX IF NOT EXISTS "blob_assignments_versioned_hash_idx"
+    ON "blob_assignments"
+    ("versioned_hash" ASC);
+
+CREATE INDEX IF NOT EXISTS "blob_assignments_tx_sender_idx"
+    ON "blob_assignments"
+    ("tx_sender" ASC);
+
+CREATE INDEX IF NOT EXISTS "blob_assignments_slot_idx"
+    ON "blob_assignments"
+    ("slot" ASC);
+
+CREATE TABLE IF NOT EXISTS "blob_stats" (
+    "epoch" BIGINT NOT NULL,
+    "block_count" INT NOT NULL DEFAULT 0,
+    "blob_count" INT NOT NULL DEFAULT 0,
+    "blob_gas_used" BIGINT NOT NULL DEFAULT 0,
+    "blob_base_fee" BIGINT NOT NULL DEFAULT 0,
+    PRIMARY KEY ("epoch")
+);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'NOT SUPPORTED';
+-- +goose StatementEnd
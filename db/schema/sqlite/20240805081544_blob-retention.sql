@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+
+ALTER TABLE "blobs" ADD "pruned" INTEGER NOT NULL DEFAULT 0;
+
+CREATE INDEX IF NOT EXISTS "blobs_pruned_idx"
+    ON "blobs"
+    ("pruned" ASC);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'NOT SUPPORTED';
+-- +goose StatementEnd
@@ -17,24 +17,50 @@ func InsertSlot(slot *dbtypes.Slot, tx *sqlx.Tx) error {
 				slot, proposer, status, root, parent_root, state_root, graffiti, graffiti_text,
 				attestation_count, deposit_count, exit_count, withdraw_count, withdraw_amount, attester_slashing_count, 
 				proposer_slashing_count, bls_change_count, eth_transaction_count, eth_block_number, eth_block_hash, 
-				eth_block_extra, eth_block_extra_text, sync_participation
-			) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22)
+				eth_block_extra, eth_block_extra_text, sync_participation, cl_reward, cl_reward_attestations, cl_reward_sync,
+				cl_reward_slashings, el_priority_fees, eth1_data_root, eth1_data_count, eth1_data_block_hash, eth_fee_recipient
+			) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23, $24, $25, $26, $27, $28, $29, $30, $31)
 			ON CONFLICT (slot, root) DO UPDATE SET
 				status = excluded.status,
 				eth_block_extra = excluded.eth_block_extra,
-				eth_block_extra_text = excluded.eth_block_extra_text`,
+				eth_block_extra_text = excluded.eth_block_extra_text,
+				cl_reward = COALESCE(excluded.cl_reward, slots.cl_reward),
+				cl_reward_attestations = COALESCE(excluded.cl_reward_attestations, slots.cl_reward_attestations),
+				cl_reward_sync = COALESCE(excluded.cl_reward_sync, slots.cl_reward_sync),
+				cl_reward_slashings = COALESCE(excluded.cl_reward_slashings, slots.cl_reward_slashings),
+				el_priority_fees = COALESCE(excluded.el_priority_fees, slots.el_priority_fees),
+				eth1_data_root = COALESCE(excluded.eth1_data_root, slots.eth1_data_root),
+				eth1_data_count = COALESCE(excluded.eth1_data_count, slots.eth1_data_count),
+				eth1_data_block_hash = COALESCE(excluded.eth1_data_block_hash, slots.eth1_data_block_hash),
+				eth_fee_recipient = COALESCE(excluded.eth_fee_recipient, slots.eth_fee_recipient)`,
 		dbtypes.DBEngineSqlite: `
-			INSERT OR REPLACE INTO slots (
+			INSERT INTO slots (
 				slot, proposer, status, root, parent_root, state_root, graffiti, graffiti_text,
 				attestation_count, deposit_count, exit_count, withdraw_count, withdraw_amount, attester_slashing_count, 
 				proposer_slashing_count, bls_change_count, eth_transaction_count, eth_block_number, eth_block_hash, 
-				eth_block_extra, eth_block_extra_text, sync_participation
-			) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22)`,
+				eth_block_extra, eth_block_extra_text, sync_participation, cl_reward, cl_reward_attestations, cl_reward_sync,
+				cl_reward_slashings, el_priority_fees, eth1_data_root, eth1_data_count, eth1_data_block_hash, eth_fee_recipient
+			) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23, $24, $25, $26, $27, $28, $29, $30, $31)
+			ON CONFLICT (slot, root) DO UPDATE SET
+				status = excluded.status,
+				eth_block_extra = excluded.eth_block_extra,
+				eth_block_extra_text = excluded.eth_block_extra_text,
+				cl_reward = COALESCE(excluded.cl_reward, slots.cl_reward),
+				cl_reward_attestations = COALESCE(excluded.cl_reward_attestations, slots.cl_reward_attestations),
+				cl_reward_sync = COALESCE(excluded.cl_reward_sync, slots.cl_reward_sync),
+				cl_reward_slashings = COALESCE(excluded.cl_reward_slashings, slots.cl_reward_slashings),
+				el_priority_fees = COALESCE(excluded.el_priority_fees, slots.el_priority_fees),
+				eth1_data_root = COALESCE(excluded.eth1_data_root, slots.eth1_data_root),
+				eth1_data_count = COALESCE(excluded.eth1_data_count, slots.eth1_data_count),
+				eth1_data_block_hash = COALESCE(excluded.eth1_data_block_hash, slots.eth1_data_block_hash),
+				eth_fee_recipient = COALESCE(excluded.eth_fee_recipient, slots.eth_fee_recipient)`,
 	}),
 		slot.Slot, slot.Proposer, slot.Status, slot.Root, slot.ParentRoot, slot.StateRoot, slot.Graffiti, slot.GraffitiText,
 		slot.AttestationCount, slot.DepositCount, slot.ExitCount, slot.WithdrawCount, slot.WithdrawAmount, slot.AttesterSlashingCount,
 		slot.ProposerSlashingCount, slot.BLSChangeCount, slot.EthTransactionCount, slot.EthBlockNumber, slot.EthBlockHash,
-		slot.EthBlockExtra, slot.EthBlockExtraText, slot.SyncParticipation)
+		slot.EthBlockExtra, slot.EthBlockExtraText, slot.SyncParticipation, slot.ClReward, slot.ClRewardAttestations, slot.ClRewardSync,
+		slot.ClRewardSlashings, slot.ElPriorityFees, slot.Eth1DataRoot, slot.Eth1DataCount, slot.Eth1DataBlockHash,
+		slot.EthFeeRecipient)
 	if err != nil {
 		return err
 	}
@@ -71,9 +97,11 @@ func InsertMissingSlot(block *dbtypes.SlotHeader, tx *sqlx.Tx) error {
 			ON CONFLICT (slot, root) DO UPDATE SET
 			proposer = excluded.proposer`,
 		dbtypes.DBEngineSqlite: `
-			INSERT OR REPLACE INTO slots (
+			INSERT INTO slots (
 				slot, proposer, status, root
-			) VALUES ($1, $2, $3, '0x')`,
+			) VALUES ($1, $2, $3, '0x')
+			ON CONFLICT (slot, root) DO UPDATE SET
+			proposer = excluded.proposer`,
 	}),
 		block.Slot, block.Proposer, block.Status)
 	if err != nil {
@@ -89,7 +117,9 @@ func GetSlots(firstSlot uint64, limit uint32, withMissing bool, withOrphaned boo
 		"state_root", "root", "slot", "proposer", "status", "parent_root", "graffiti", "graffiti_text",
 		"attestation_count", "deposit_count", "exit_count", "withdraw_count", "withdraw_amount", "attester_slashing_count",
 		"proposer_slashing_count", "bls_change_count", "eth_transaction_count", "eth_block_number", "eth_block_hash",
-		"eth_block_extra", "eth_block_extra_text", "sync_participation",
+		"eth_block_extra", "eth_block_extra_text", "sync_participation", "cl_reward", "cl_reward_attestations", "cl_reward_sync",
+		"cl_reward_slashings", "el_priority_fees", "eth1_data_root", "eth1_data_count", "eth1_data_block_hash",
+		"eth_fee_recipient",
 	}
 	for _, blockField := range blockFields {
 		fmt.Fprintf(&sql, ", slots.%v AS \"block.%v\"", blockField, blockField)
@@ -121,7 +151,9 @@ func GetSlotsRange(firstSlot uint64, lastSlot uint64, withMissing bool, withOrph
 		"state_root", "root", "slot", "proposer", "status", "parent_root", "graffiti", "graffiti_text",
 		"attestation_count", "deposit_count", "exit_count", "withdraw_count", "withdraw_amount", "attester_slashing_count",
 		"proposer_slashing_count", "bls_change_count", "eth_transaction_count", "eth_block_number", "eth_block_hash",
-		"eth_block_extra", "eth_block_extra_text", "sync_participation",
+		"eth_block_extra", "eth_block_extra_text", "sync_participation", "cl_reward", "cl_reward_attestations", "cl_reward_sync",
+		"cl_reward_slashings", "el_priority_fees", "eth1_data_root", "eth1_data_count", "eth1_data_block_hash",
+		"eth_fee_recipient",
 	}
 	for _, blockField := range blockFields {
 		fmt.Fprintf(&sql, ", slots.%v AS \"block.%v\"", blockField, blockField)
@@ -153,7 +185,8 @@ func GetSlotsByParentRoot(parentRoot []byte) []*dbtypes.Slot {
 		slot, proposer, status, root, parent_root, state_root, graffiti, graffiti_text,
 		attestation_count, deposit_count, exit_count, withdraw_count, withdraw_amount, attester_slashing_count, 
 		proposer_slashing_count, bls_change_count, eth_transaction_count, eth_block_number, eth_block_hash, 
-		eth_block_extra, eth_block_extra_text, sync_participation
+		eth_block_extra, eth_block_extra_text, sync_participation, cl_reward, cl_reward_attestations, cl_reward_sync,
+		cl_reward_slashings, el_priority_fees, eth1_data_root, eth1_data_count, eth1_data_block_hash, eth_fee_recipient
 	FROM slots
 	WHERE parent_root = $1
 	ORDER BY slot DESC
@@ -172,7 +205,8 @@ func GetSlotByRoot(root []byte) *dbtypes.Slot {
 		root, slot, parent_root, state_root, status, proposer, graffiti, graffiti_text,
 		attestation_count, deposit_count, exit_count, withdraw_count, withdraw_amount, attester_slashing_count, 
 		proposer_slashing_count, bls_change_count, eth_transaction_count, eth_block_number, eth_block_hash,
-		eth_block_extra, eth_block_extra_text, sync_participation
+		eth_block_extra, eth_block_extra_text, sync_participation, cl_reward, cl_reward_attestations, cl_reward_sync,
+		cl_reward_slashings, el_priority_fees, eth1_data_root, eth1_data_count, eth1_data_block_hash, eth_fee_recipient
 	FROM slots
 	WHERE root = $1
 	`, root)
@@ -190,7 +224,8 @@ func GetSlotsByBlockHash(blockHash []byte) []*dbtypes.Slot {
 		slot, proposer, status, root, parent_root, state_root, graffiti, graffiti_text,
 		attestation_count, deposit_count, exit_count, withdraw_count, withdraw_amount, attester_slashing_count, 
 		proposer_slashing_count, bls_change_count, eth_transaction_count, eth_block_number, eth_block_hash, 
-		eth_block_extra, eth_block_extra_text, sync_participation
+		eth_block_extra, eth_block_extra_text, sync_participation, cl_reward, cl_reward_attestations, cl_reward_sync,
+		cl_reward_slashings, el_priority_fees, eth1_data_root, eth1_data_count, eth1_data_block_hash, eth_fee_recipient
 	FROM slots
 	WHERE eth_block_hash = $1
 	ORDER BY slot DESC
@@ -250,7 +285,9 @@ func GetFilteredSlots(filter *dbtypes.BlockFilter, firstSlot uint64, offset uint
 		"state_root", "root", "slot", "proposer", "status", "parent_root", "graffiti", "graffiti_text",
 		"attestation_count", "deposit_count", "exit_count", "withdraw_count", "withdraw_amount", "attester_slashing_count",
 		"proposer_slashing_count", "bls_change_count", "eth_transaction_count", "eth_block_number", "eth_block_hash",
-		"eth_block_extra", "eth_block_extra_text", "sync_participation",
+		"eth_block_extra", "eth_block_extra_text", "sync_participation", "cl_reward", "cl_reward_attestations", "cl_reward_sync",
+		"cl_reward_slashings", "el_priority_fees", "eth1_data_root", "eth1_data_count", "eth1_data_block_hash",
+		"eth_fee_recipient",
 	}
 	for _, blockField := range blockFields {
 		fmt.Fprintf(&sql, ", slots.%v AS \"block.%v\"", blockField, blockField)
@@ -298,6 +335,11 @@ func GetFilteredSlots(filter *dbtypes.BlockFilter, firstSlot uint64, offset uint
 		}), argIdx)
 		args = append(args, "%"+filter.ExtraData+"%")
 	}
+	if len(filter.FeeRecipient) > 0 {
+		argIdx++
+		fmt.Fprintf(&sql, ` AND slots.eth_fee_recipient = $%v `, argIdx)
+		args = append(args, filter.FeeRecipient)
+	}
 	if filter.ProposerName != "" {
 		argIdx++
 		fmt.Fprintf(&sql, EngineQuery(map[dbtypes.DBEngineType]string{
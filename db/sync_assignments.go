@@ -61,3 +61,49 @@ func GetSyncAssignmentsForPeriod(period uint64) []uint64 {
 	}
 	return assignments
 }
+
+// GetSyncAssignmentsForValidator returns the sync committee assignments of a validator (most recent periods first)
+func GetSyncAssignmentsForValidator(validator uint64, limit uint32) []*dbtypes.SyncAssignment {
+	assignments := []*dbtypes.SyncAssignment{}
+	err := ReaderDb.Select(&assignments, `
+	SELECT
+		period, "index", validator
+	FROM sync_assignments
+	WHERE validator = $1
+	ORDER BY period DESC, "index" ASC
+	LIMIT $2
+	`, validator, limit)
+	if err != nil {
+		logger.Errorf("Error while fetching sync assignments for validator: %v", err)
+		return nil
+	}
+	return assignments
+}
+
+func InsertSyncParticipation(participation *dbtypes.SyncParticipation, tx *sqlx.Tx) error {
+	_, err := tx.Exec(EngineQuery(map[dbtypes.DBEngineType]string{
+		dbtypes.DBEnginePgsql:  `INSERT INTO sync_participations (slot_number, slot_root, orphaned, bits) VALUES ($1, $2, $3, $4) ON CONFLICT (slot_root) DO UPDATE SET orphaned = excluded.orphaned`,
+		dbtypes.DBEngineSqlite: `INSERT OR REPLACE INTO sync_participations (slot_number, slot_root, orphaned, bits) VALUES ($1, $2, $3, $4)`,
+	}), participation.SlotNumber, participation.SlotRoot, participation.Orphaned, participation.Bits)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetSyncParticipations returns the sync committee bits of all canonical blocks in the given slot range
+func GetSyncParticipations(minSlot uint64, maxSlot uint64) []*dbtypes.SyncParticipation {
+	participations := []*dbtypes.SyncParticipation{}
+	err := ReaderDb.Select(&participations, `
+	SELECT
+		slot_number, slot_root, orphaned, bits
+	FROM sync_participations
+	WHERE slot_number >= $1 AND slot_number <= $2 AND orphaned = false
+	ORDER BY slot_number ASC
+	`, minSlot, maxSlot)
+	if err != nil {
+		logger.Errorf("Error while fetching sync participations: %v", err)
+		return nil
+	}
+	return participations
+}
@@ -0,0 +1,47 @@
+package db
+
+import (
+	"github.com/ethpandaops/dora/dbtypes"
+	"github.com/jmoiron/sqlx"
+)
+
+func InsertValidatorQueueStats(stats *dbtypes.ValidatorQueueStats, tx *sqlx.Tx) error {
+	_, err := tx.Exec(EngineQuery(map[dbtypes.DBEngineType]string{
+		dbtypes.DBEnginePgsql: `
+			INSERT INTO validator_queue_stats (
+				epoch, active_count, activation_count, activation_amount, exit_count, exit_amount, activation_churn, exit_churn
+			) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+			ON CONFLICT (epoch) DO UPDATE SET
+				active_count = excluded.active_count,
+				activation_count = excluded.activation_count,
+				activation_amount = excluded.activation_amount,
+				exit_count = excluded.exit_count,
+				exit_amount = excluded.exit_amount,
+				activation_churn = excluded.activation_churn,
+				exit_churn = excluded.exit_churn`,
+		dbtypes.DBEngineSqlite: `
+			INSERT OR REPLACE INTO validator_queue_stats (
+				epoch, active_count, activation_count, activation_amount, exit_count, exit_amount, activation_churn, exit_churn
+			) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`,
+	}),
+		stats.Epoch, stats.ActiveCount, stats.ActivationCount, stats.ActivationAmount, stats.ExitCount, stats.ExitAmount, stats.ActivationChurn, stats.ExitChurn)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetValidatorQueueStats returns the queue lengths of all persisted epochs in the given range (ascending)
+func GetValidatorQueueStats(minEpoch uint64, maxEpoch uint64) ([]*dbtypes.ValidatorQueueStats, error) {
+	stats := []*dbtypes.ValidatorQueueStats{}
+	err := ReaderDb.Select(&stats, `
+		SELECT epoch, active_count, activation_count, activation_amount, exit_count, exit_amount, activation_churn, exit_churn
+		FROM validator_queue_stats
+		WHERE epoch >= $1 AND epoch <= $2
+		ORDER BY epoch ASC`, minEpoch, maxEpoch)
+	if err != nil {
+		logger.Errorf("Error while fetching validator queue stats: %v", err)
+		return nil, err
+	}
+	return stats, nil
+}
@@ -0,0 +1,194 @@
+package db
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ethpandaops/dora/dbtypes"
+	"github.com/ethpandaops/dora/utils"
+	"github.com/jmoiron/sqlx"
+)
+
+func InsertWithdrawals(withdrawals []*dbtypes.Withdrawal, tx *sqlx.Tx) error {
+	var sql strings.Builder
+	fmt.Fprint(&sql,
+		EngineQuery(map[dbtypes.DBEngineType]string{
+			dbtypes.DBEnginePgsql:  "INSERT INTO withdrawals ",
+			dbtypes.DBEngineSqlite: "INSERT INTO withdrawals ",
+		}),
+		"(slot_number, slot_index, slot_root, orphaned, withdrawal_index, validator, address, amount, type)",
+		" VALUES ",
+	)
+	argIdx := 0
+	fieldCount := 9
+
+	args := make([]any, len(withdrawals)*fieldCount)
+	for i, withdrawal := range withdrawals {
+		if i > 0 {
+			fmt.Fprintf(&sql, ", ")
+		}
+		fmt.Fprintf(&sql, "(")
+		for f := 0; f < fieldCount; f++ {
+			if f > 0 {
+				fmt.Fprintf(&sql, ", ")
+			}
+			fmt.Fprintf(&sql, "$%v", argIdx+f+1)
+
+		}
+		fmt.Fprintf(&sql, ")")
+
+		args[argIdx+0] = withdrawal.SlotNumber
+		args[argIdx+1] = withdrawal.SlotIndex
+		args[argIdx+2] = withdrawal.SlotRoot
+		args[argIdx+3] = withdrawal.Orphaned
+		args[argIdx+4] = withdrawal.WithdrawalIndex
+		args[argIdx+5] = withdrawal.ValidatorIndex
+		args[argIdx+6] = withdrawal.Address
+		args[argIdx+7] = withdrawal.Amount
+		args[argIdx+8] = withdrawal.Type
+		argIdx += fieldCount
+	}
+	fmt.Fprint(&sql, EngineQuery(map[dbtypes.DBEngineType]string{
+		dbtypes.DBEnginePgsql:  " ON CONFLICT (slot_root, slot_index) DO UPDATE SET orphaned = excluded.orphaned, type = CASE WHEN excluded.type = 0 THEN withdrawals.type ELSE excluded.type END",
+		dbtypes.DBEngineSqlite: " ON CONFLICT (slot_root, slot_index) DO UPDATE SET orphaned = excluded.orphaned, type = CASE WHEN excluded.type = 0 THEN withdrawals.type ELSE excluded.type END",
+	}))
+
+	_, err := tx.Exec(sql.String(), args...)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func GetWithdrawalsFiltered(offset uint64, limit uint32, finalizedBlock uint64, filter *dbtypes.WithdrawalFilter) ([]*dbtypes.Withdrawal, uint64, error) {
+	var sql strings.Builder
+	args := []any{}
+	fmt.Fprint(&sql, `
+	WITH cte AS (
+		SELECT
+			slot_number, slot_index, slot_root, orphaned, withdrawal_index, validator, address, amount, type
+		FROM withdrawals
+	`)
+
+	if filter.ValidatorName != "" {
+		fmt.Fprint(&sql, `
+		LEFT JOIN validator_names ON validator_names."index" = withdrawals.validator
+		`)
+	}
+
+	filterOp := "WHERE"
+	if filter.MinSlot > 0 {
+		args = append(args, filter.MinSlot)
+		fmt.Fprintf(&sql, " %v slot_number >= $%v", filterOp, len(args))
+		filterOp = "AND"
+	}
+	if filter.MaxSlot > 0 {
+		args = append(args, filter.MaxSlot)
+		fmt.Fprintf(&sql, " %v slot_number <= $%v", filterOp, len(args))
+		filterOp = "AND"
+	}
+	if filter.MinIndex > 0 {
+		args = append(args, filter.MinIndex)
+		fmt.Fprintf(&sql, " %v validator >= $%v", filterOp, len(args))
+		filterOp = "AND"
+	}
+	if filter.MaxIndex > 0 {
+		args = append(args, filter.MaxIndex)
+		fmt.Fprintf(&sql, " %v validator <= $%v", filterOp, len(args))
+		filterOp = "AND"
+	}
+	if filter.ValidatorIndex != nil {
+		args = append(args, *filter.ValidatorIndex)
+		fmt.Fprintf(&sql, " %v validator = $%v", filterOp, len(args))
+		filterOp = "AND"
+	}
+	if len(filter.Address) > 0 {
+		args = append(args, filter.Address)
+		fmt.Fprintf(&sql, " %v address = $%v", filterOp, len(args))
+		filterOp = "AND"
+	}
+	if filter.MinAmount > 0 {
+		args = append(args, filter.MinAmount*utils.GWEI.Uint64())
+		fmt.Fprintf(&sql, " %v amount >= $%v", filterOp, len(args))
+		filterOp = "AND"
+	}
+	if filter.MaxAmount > 0 {
+		args = append(args, filter.MaxAmount*utils.GWEI.Uint64())
+		fmt.Fprintf(&sql, " %v amount <= $%v", filterOp, len(args))
+		filterOp = "AND"
+	}
+	if filter.WithType > 0 {
+		args = append(args, filter.WithType)
+		fmt.Fprintf(&sql, " %v type = $%v", filterOp, len(args))
+		filterOp = "AND"
+	}
+	if filter.WithOrphaned == 0 {
+		args = append(args, finalizedBlock)
+		fmt.Fprintf(&sql, " %v (slot_number > $%v OR orphaned = false)", filterOp, len(args))
+		filterOp = "AND"
+	} else if filter.WithOrphaned == 2 {
+		args = append(args, finalizedBlock)
+		fmt.Fprintf(&sql, " %v (slot_number > $%v OR orphaned = true)", filterOp, len(args))
+		filterOp = "AND"
+	}
+	if filter.ValidatorName != "" {
+		args = append(args, "%"+filter.ValidatorName+"%")
+		fmt.Fprintf(&sql, " %v ", filterOp)
+		fmt.Fprintf(&sql, EngineQuery(map[dbtypes.DBEngineType]string{
+			dbtypes.DBEnginePgsql:  ` validator_names.name ilike $%v `,
+			dbtypes.DBEngineSqlite: ` validator_names.name LIKE $%v `,
+		}), len(args))
+
+		filterOp = "AND"
+	}
+
+	args = append(args, limit)
+	fmt.Fprintf(&sql, `)
+	SELECT
+		count(*) AS slot_number,
+		0 AS slot_index,
+		null AS slot_root,
+		false AS orphaned,
+		0 AS withdrawal_index,
+		0 AS validator,
+		null AS address,
+		0 AS amount,
+		0 AS type
+	FROM cte
+	UNION ALL SELECT * FROM (
+	SELECT * FROM cte
+	ORDER BY slot_number DESC, slot_index DESC
+	LIMIT $%v
+	`, len(args))
+
+	if offset > 0 {
+		args = append(args, offset)
+		fmt.Fprintf(&sql, " OFFSET $%v ", len(args))
+	}
+	fmt.Fprintf(&sql, ") AS t1")
+
+	withdrawals := []*dbtypes.Withdrawal{}
+	err := ReaderDb.Select(&withdrawals, sql.String(), args...)
+	if err != nil {
+		logger.Errorf("Error while fetching filtered withdrawals: %v", err)
+		return nil, 0, err
+	}
+
+	return withdrawals[1:], withdrawals[0].SlotNumber, nil
+}
+
+// GetValidatorWithdrawalSums returns the sum of all canonical withdrawals of a validator in the given slot range, grouped by epoch.
+func GetValidatorWithdrawalSums(validator uint64, minSlot uint64, maxSlot uint64) ([]*dbtypes.EpochAmount, error) {
+	sums := []*dbtypes.EpochAmount{}
+	err := ReaderDb.Select(&sums, `
+		SELECT slot_number / $1 AS epoch, CAST(SUM(amount) AS BIGINT) AS amount
+		FROM withdrawals
+		WHERE validator = $2 AND slot_number >= $3 AND slot_number <= $4 AND orphaned = false
+		GROUP BY slot_number / $1
+	`, utils.Config.Chain.Config.SlotsPerEpoch, validator, minSlot, maxSlot)
+	if err != nil {
+		logger.Errorf("Error while fetching validator withdrawal sums: %v", err)
+		return nil, err
+	}
+	return sums, nil
+}
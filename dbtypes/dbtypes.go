@@ -1,5 +1,7 @@
 package dbtypes
 
+import "encoding/binary"
+
 type ExplorerState struct {
 	Key   string `db:"key"`
 	Value string `db:"value"`
@@ -47,6 +49,15 @@ type Slot struct {
 	EthBlockExtra         []byte     `db:"eth_block_extra"`
 	EthBlockExtraText     string     `db:"eth_block_extra_text"`
 	SyncParticipation     float32    `db:"sync_participation"`
+	ClReward              *uint64    `db:"cl_reward"`
+	ClRewardAttestations  *uint64    `db:"cl_reward_attestations"`
+	ClRewardSync          *uint64    `db:"cl_reward_sync"`
+	ClRewardSlashings     *uint64    `db:"cl_reward_slashings"`
+	ElPriorityFees        *uint64    `db:"el_priority_fees"`
+	Eth1DataRoot          []byte     `db:"eth1_data_root"`
+	Eth1DataCount         *uint64    `db:"eth1_data_count"`
+	Eth1DataBlockHash     []byte     `db:"eth1_data_block_hash"`
+	EthFeeRecipient       []byte     `db:"eth_fee_recipient"`
 }
 
 type Epoch struct {
@@ -90,6 +101,13 @@ type SyncAssignment struct {
 	Validator uint64 `db:"validator"`
 }
 
+type SyncParticipation struct {
+	SlotNumber uint64 `db:"slot_number"`
+	SlotRoot   []byte `db:"slot_root"`
+	Orphaned   bool   `db:"orphaned"`
+	Bits       []byte `db:"bits"`
+}
+
 type UnfinalizedBlock struct {
 	Root      []byte `db:"root"`
 	Slot      uint64 `db:"slot"`
@@ -104,11 +122,36 @@ type Blob struct {
 	Proof      []byte  `db:"proof"`
 	Size       uint32  `db:"size"`
 	Blob       *[]byte `db:"blob"`
+	Pruned     bool    `db:"pruned"`
 }
 
 type BlobAssignment struct {
-	Root       []byte `db:"root"`
+	Root          []byte `db:"root"`
+	Commitment    []byte `db:"commitment"`
+	Slot          uint64 `db:"slot"`
+	BlobIndex     uint64 `db:"blob_index"`
+	VersionedHash []byte `db:"versioned_hash"`
+	TxHash        []byte `db:"tx_hash"`
+	TxSender      []byte `db:"tx_sender"`
+}
+
+// BlobListEntry is a blob assignment with the size of the blob sidecar.
+type BlobListEntry struct {
+	Root          []byte `db:"root"`
+	Commitment    []byte `db:"commitment"`
+	Slot          uint64 `db:"slot"`
+	BlobIndex     uint64 `db:"blob_index"`
+	VersionedHash []byte `db:"versioned_hash"`
+	TxHash        []byte `db:"tx_hash"`
+	TxSender      []byte `db:"tx_sender"`
+	Size          uint32 `db:"size"`
+	Pruned        bool   `db:"pruned"`
+}
+
+// BlobPruneCandidate is a stored blob payload with the slot of its latest assignment.
+type BlobPruneCandidate struct {
 	Commitment []byte `db:"commitment"`
+	Size       uint32 `db:"size"`
 	Slot       uint64 `db:"slot"`
 }
 
@@ -157,6 +200,7 @@ type DepositTx struct {
 	TxHash                []byte `db:"tx_hash"`
 	TxSender              []byte `db:"tx_sender"`
 	TxTarget              []byte `db:"tx_target"`
+	DepositRoot           []byte `db:"deposit_root"`
 }
 
 type Deposit struct {
@@ -178,6 +222,38 @@ type VoluntaryExit struct {
 	ValidatorIndex uint64 `db:"validator"`
 }
 
+type DepositRequest struct {
+	SlotNumber            uint64 `db:"slot_number"`
+	SlotIndex             uint64 `db:"slot_index"`
+	SlotRoot              []byte `db:"slot_root"`
+	Orphaned              bool   `db:"orphaned"`
+	PublicKey             []byte `db:"pubkey"`
+	WithdrawalCredentials []byte `db:"withdrawal_credentials"`
+	Amount                uint64 `db:"amount"`
+	Signature             []byte `db:"signature"`
+	DepositIndex          uint64 `db:"deposit_index"`
+}
+
+type WithdrawalRequest struct {
+	SlotNumber      uint64 `db:"slot_number"`
+	SlotIndex       uint64 `db:"slot_index"`
+	SlotRoot        []byte `db:"slot_root"`
+	Orphaned        bool   `db:"orphaned"`
+	SourceAddress   []byte `db:"source_address"`
+	ValidatorPubkey []byte `db:"validator_pubkey"`
+	Amount          uint64 `db:"amount"`
+}
+
+type ConsolidationRequest struct {
+	SlotNumber    uint64 `db:"slot_number"`
+	SlotIndex     uint64 `db:"slot_index"`
+	SlotRoot      []byte `db:"slot_root"`
+	Orphaned      bool   `db:"orphaned"`
+	SourceAddress []byte `db:"source_address"`
+	SourcePubkey  []byte `db:"source_pubkey"`
+	TargetPubkey  []byte `db:"target_pubkey"`
+}
+
 type SlashingReason uint8
 
 const (
@@ -195,3 +271,207 @@ type Slashing struct {
 	SlasherIndex   uint64         `db:"slasher"`
 	Reason         SlashingReason `db:"reason"`
 }
+
+type WithdrawalType uint8
+
+const (
+	UnspecifiedWithdrawal WithdrawalType = iota
+	PartialWithdrawal
+	FullWithdrawal
+)
+
+type Withdrawal struct {
+	SlotNumber      uint64         `db:"slot_number"`
+	SlotIndex       uint64         `db:"slot_index"`
+	SlotRoot        []byte         `db:"slot_root"`
+	Orphaned        bool           `db:"orphaned"`
+	WithdrawalIndex uint64         `db:"withdrawal_index"`
+	ValidatorIndex  uint64         `db:"validator"`
+	Address         []byte         `db:"address"`
+	Amount          uint64         `db:"amount"`
+	Type            WithdrawalType `db:"type"`
+}
+
+type BLSChange struct {
+	SlotNumber     uint64 `db:"slot_number"`
+	SlotIndex      uint64 `db:"slot_index"`
+	SlotRoot       []byte `db:"slot_root"`
+	Orphaned       bool   `db:"orphaned"`
+	ValidatorIndex uint64 `db:"validator"`
+	BlsPubkey      []byte `db:"pubkey"`
+	Address        []byte `db:"address"`
+}
+
+// AttestationOutcome is the compact (single byte) attestation result of a validator for an epoch.
+// The lower bits are flags, the upper 4 bits hold the inclusion distance (capped at 15).
+// Included attestations always have a correct source vote, as attestations with wrong source are invalid.
+type AttestationOutcome uint8
+
+const (
+	AttestationOutcomeDuty          AttestationOutcome = 0x01
+	AttestationOutcomeIncluded      AttestationOutcome = 0x02
+	AttestationOutcomeTargetCorrect AttestationOutcome = 0x04
+	AttestationOutcomeHeadCorrect   AttestationOutcome = 0x08
+)
+
+func (o AttestationOutcome) HasDuty() bool {
+	return o&AttestationOutcomeDuty != 0
+}
+
+func (o AttestationOutcome) IsIncluded() bool {
+	return o&AttestationOutcomeIncluded != 0
+}
+
+func (o AttestationOutcome) IsTargetCorrect() bool {
+	return o&AttestationOutcomeTargetCorrect != 0
+}
+
+func (o AttestationOutcome) IsHeadCorrect() bool {
+	return o&AttestationOutcomeHeadCorrect != 0
+}
+
+func (o AttestationOutcome) InclusionDistance() uint8 {
+	return uint8(o) >> 4
+}
+
+type ValidatorAttestation struct {
+	Epoch   uint64 `db:"epoch"`
+	Outcome []byte `db:"outcome"`
+}
+
+func (a *ValidatorAttestation) GetOutcome() AttestationOutcome {
+	if len(a.Outcome) == 0 {
+		return 0
+	}
+	return AttestationOutcome(a.Outcome[0])
+}
+
+// BalanceSnapshot holds the balances of all validators at the start of an epoch.
+// Balances are packed as 8 byte big-endian gwei values, effective balances as 2 byte big-endian ETH values (indexed by validator index).
+type BalanceSnapshot struct {
+	Epoch             uint64 `db:"epoch"`
+	Balances          []byte `db:"balances"`
+	EffectiveBalances []byte `db:"effective_balances"`
+}
+
+type ValidatorBalance struct {
+	Epoch            uint64 `db:"epoch"`
+	Balance          []byte `db:"balance"`
+	EffectiveBalance []byte `db:"effective_balance"`
+}
+
+func (b *ValidatorBalance) GetBalance() uint64 {
+	if len(b.Balance) != 8 {
+		return 0
+	}
+	return binary.BigEndian.Uint64(b.Balance)
+}
+
+func (b *ValidatorBalance) GetEffectiveBalance() uint64 {
+	if len(b.EffectiveBalance) != 2 {
+		return 0
+	}
+	return uint64(binary.BigEndian.Uint16(b.EffectiveBalance)) * 1000000000
+}
+
+// EpochRewards holds the attestation & sync committee rewards of an epoch (in gwei).
+// ValidatorRewards holds two signed 32bit big-endian values per validator index: attestation reward, sync committee reward.
+type EpochRewards struct {
+	Epoch                uint64 `db:"epoch"`
+	ValidatorCount       uint64 `db:"validator_count"`
+	IdealRewards         uint64 `db:"ideal_rewards"`
+	AttestationRewards   uint64 `db:"attestation_rewards"`
+	AttestationPenalties uint64 `db:"attestation_penalties"`
+	InactivityPenalties  uint64 `db:"inactivity_penalties"`
+	SyncRewards          uint64 `db:"sync_rewards"`
+	SyncPenalties        uint64 `db:"sync_penalties"`
+	ValidatorRewards     []byte `db:"validator_rewards"`
+}
+
+type ValidatorReward struct {
+	Epoch   uint64 `db:"epoch"`
+	Rewards []byte `db:"rewards"`
+}
+
+func (r *ValidatorReward) GetAttestationReward() int64 {
+	if len(r.Rewards) != 8 {
+		return 0
+	}
+	return int64(int32(binary.BigEndian.Uint32(r.Rewards[0:4])))
+}
+
+func (r *ValidatorReward) GetSyncReward() int64 {
+	if len(r.Rewards) != 8 {
+		return 0
+	}
+	return int64(int32(binary.BigEndian.Uint32(r.Rewards[4:8])))
+}
+
+// Reorg is a switch of the canonical head to a block that does not descend from the previous head.
+// Client names are comma separated lists of the consensus clients that followed the old / new branch when the reorg was detected.
+type Reorg struct {
+	SlotNumber     uint64  `db:"slot_number"`
+	HeadRoot       []byte  `db:"head_root"`
+	OldHeadSlot    uint64  `db:"old_head_slot"`
+	OldHeadRoot    []byte  `db:"old_head_root"`
+	AncestorSlot   *uint64 `db:"ancestor_slot"`
+	AncestorRoot   []byte  `db:"ancestor_root"`
+	Depth          uint64  `db:"depth"`
+	DetectedTime   uint64  `db:"detected_time"`
+	OldHeadClients string  `db:"old_head_clients"`
+	NewHeadClients string  `db:"new_head_clients"`
+}
+
+// ClientDiversity is the number of proposed & missed slots of a cl/el client combination in an epoch.
+// Client names are empty if the client could not be classified.
+type ClientDiversity struct {
+	Epoch         uint64 `db:"epoch"`
+	ClClient      string `db:"cl_client"`
+	ElClient      string `db:"el_client"`
+	ProposedCount uint64 `db:"proposed_count"`
+	MissedCount   uint64 `db:"missed_count"`
+}
+
+// ValidatorQueueStats holds the length of the activation & exit queue at the start of an epoch.
+// The churn is counted in validators before electra and in gwei since electra.
+type ValidatorQueueStats struct {
+	Epoch            uint64 `db:"epoch"`
+	ActiveCount      uint64 `db:"active_count"`
+	ActivationCount  uint64 `db:"activation_count"`
+	ActivationAmount uint64 `db:"activation_amount"`
+	ExitCount        uint64 `db:"exit_count"`
+	ExitAmount       uint64 `db:"exit_amount"`
+	ActivationChurn  uint64 `db:"activation_churn"`
+	ExitChurn        uint64 `db:"exit_churn"`
+}
+
+// BlobStats holds the blob usage of the canonical blocks in an epoch.
+// BlobBaseFee is the average blob base fee (in wei) of the blocks with an execution payload.
+type BlobStats struct {
+	Epoch       uint64 `db:"epoch"`
+	BlockCount  uint64 `db:"block_count"`
+	BlobCount   uint64 `db:"blob_count"`
+	BlobGasUsed uint64 `db:"blob_gas_used"`
+	BlobBaseFee uint64 `db:"blob_base_fee"`
+}
+
+// ElTransaction is a finalized execution layer transaction with the status of its receipt.
+// Value is the big-endian encoded amount of wei, MethodSig the first 4 bytes of the call data.
+type ElTransaction struct {
+	TxHash          []byte  `db:"tx_hash"`
+	BlockNumber     uint64  `db:"block_number"`
+	BlockHash       []byte  `db:"block_hash"`
+	BlockTime       uint64  `db:"block_time"`
+	TxIndex         uint64  `db:"tx_index"`
+	TxFrom          []byte  `db:"tx_from"`
+	TxTo            []byte  `db:"tx_to"`
+	TxType          uint64  `db:"tx_type"`
+	Nonce           uint64  `db:"nonce"`
+	Value           []byte  `db:"value"`
+	MethodSig       []byte  `db:"method_sig"`
+	DataLen         uint64  `db:"data_len"`
+	GasLimit        uint64  `db:"gas_limit"`
+	GasUsed         *uint64 `db:"gas_used"`
+	Status          *uint64 `db:"status"`
+	ContractAddress []byte  `db:"contract_address"`
+}
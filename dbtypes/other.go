@@ -18,11 +18,20 @@ type AssignedBlob struct {
 	Blob       *Blob  `db:"blob"`
 }
 
+type BlobFilter struct {
+	MinSlot       uint64
+	MaxSlot       uint64
+	Sender        []byte
+	VersionedHash []byte
+	Commitment    []byte
+}
+
 type BlockFilter struct {
 	Graffiti      string
 	ExtraData     string
 	ProposerIndex *uint64
 	ProposerName  string
+	FeeRecipient  []byte
 	WithOrphaned  uint8
 	WithMissing   uint8
 }
@@ -34,6 +43,7 @@ type MevBlockFilter struct {
 	MaxIndex      uint64
 	ProposerName  string
 	BuilderPubkey []byte
+	FeeRecipient  []byte
 	Proposed      []uint8
 	MevRelay      []uint8
 }
@@ -78,3 +88,66 @@ type SlashingFilter struct {
 	WithOrphaned  uint8
 	WithReason    SlashingReason
 }
+
+type DepositRequestFilter struct {
+	MinSlot      uint64
+	MaxSlot      uint64
+	PublicKey    []byte
+	MinAmount    uint64
+	MaxAmount    uint64
+	WithOrphaned uint8
+}
+
+type WithdrawalRequestFilter struct {
+	MinSlot       uint64
+	MaxSlot       uint64
+	SourceAddress []byte
+	PublicKey     []byte
+	MinAmount     uint64
+	MaxAmount     uint64
+	WithOrphaned  uint8
+}
+
+type ConsolidationRequestFilter struct {
+	MinSlot       uint64
+	MaxSlot       uint64
+	SourceAddress []byte
+	PublicKey     []byte
+	WithOrphaned  uint8
+}
+
+type WithdrawalFilter struct {
+	MinSlot        uint64
+	MaxSlot        uint64
+	MinIndex       uint64
+	MaxIndex       uint64
+	ValidatorIndex *uint64
+	ValidatorName  string
+	Address        []byte
+	MinAmount      uint64
+	MaxAmount      uint64
+	WithOrphaned   uint8
+	WithType       WithdrawalType
+}
+
+type BLSChangeFilter struct {
+	MinSlot        uint64
+	MaxSlot        uint64
+	MinIndex       uint64
+	MaxIndex       uint64
+	ValidatorIndex *uint64
+	ValidatorName  string
+	Address        []byte
+	WithOrphaned   uint8
+}
+
+type ReorgFilter struct {
+	MinSlot  uint64
+	MaxSlot  uint64
+	MinDepth uint64
+}
+
+type EpochAmount struct {
+	Epoch  uint64 `db:"epoch"`
+	Amount uint64 `db:"amount"`
+}
@@ -32,6 +32,11 @@ type SearchAheadExecBlocksResult []struct {
 	Orphaned   bool   `db:"orphaned"`
 }
 
+type SearchAheadTransactionsResult []struct {
+	TxHash      []byte `db:"tx_hash"`
+	BlockNumber uint64 `db:"block_number"`
+}
+
 type SearchAheadGraffitiResult []struct {
 	Graffiti string `db:"graffiti"`
 	Count    uint64 `db:"count"`
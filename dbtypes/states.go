@@ -5,7 +5,13 @@ type IndexerSyncState struct {
 }
 
 type DepositIndexerState struct {
-	FinalBlock   uint64 `json:"final_block"`
-	HeadBlock    uint64 `json:"head_block"`
-	DepositIndex uint64 `json:"deposit_index"`
+	FinalBlock   uint64   `json:"final_block"`
+	HeadBlock    uint64   `json:"head_block"`
+	DepositIndex uint64   `json:"deposit_index"`
+	TreeCount    uint64   `json:"tree_count"`
+	TreeBranch   [][]byte `json:"tree_branch,omitempty"`
+}
+
+type TxIndexerState struct {
+	FinalBlock uint64 `json:"final_block"`
 }
@@ -0,0 +1,364 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	v1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/gorilla/mux"
+	"github.com/sirupsen/logrus"
+
+	"github.com/ethpandaops/dora/db"
+	"github.com/ethpandaops/dora/dbtypes"
+	"github.com/ethpandaops/dora/services"
+	"github.com/ethpandaops/dora/templates"
+	"github.com/ethpandaops/dora/types"
+	"github.com/ethpandaops/dora/types/models"
+	"github.com/ethpandaops/dora/utils"
+)
+
+// number of deposits, withdrawals & blocks shown on the address page (the full lists are linked)
+const addressPageObjectLimit = 10
+
+// number of validators with withdrawal credentials pointing to the address shown on the address page
+const addressPageValidatorLimit = 100
+
+// Address will return the "address" page using a go template
+func Address(w http.ResponseWriter, r *http.Request) {
+	var addressTemplateFiles = append(layoutTemplateFiles,
+		"address/address.html",
+	)
+	var notfoundTemplateFiles = append(layoutTemplateFiles,
+		"address/notfound.html",
+	)
+
+	vars := mux.Vars(r)
+	addressStr := strings.Replace(vars["address"], "0x", "", -1)
+	address, err := hex.DecodeString(addressStr)
+	if err != nil || len(address) != 20 {
+		data := InitPageData(w, r, "blockchain", "/address", "Address not found", notfoundTemplateFiles)
+		w.Header().Set("Content-Type", "text/html")
+		if handleTemplateError(w, r, "address.go", "Address", "invalidAddress", templates.GetTemplate(notfoundTemplateFiles...).ExecuteTemplate(w, "layout", data)) != nil {
+			return // an error has occurred and was processed
+		}
+		return
+	}
+
+	urlArgs := r.URL.Query()
+	var pageSize uint64 = 25
+	if urlArgs.Has("c") {
+		pageSize, _ = strconv.ParseUint(urlArgs.Get("c"), 10, 64)
+	}
+	var pageIdx uint64 = 1
+	if urlArgs.Has("p") {
+		pageIdx, _ = strconv.ParseUint(urlArgs.Get("p"), 10, 64)
+		if pageIdx < 1 {
+			pageIdx = 1
+		}
+	}
+
+	data := InitPageData(w, r, "blockchain", "/address", fmt.Sprintf("Address 0x%x", address), addressTemplateFiles)
+
+	var pageError error
+	pageError = services.GlobalCallRateLimiter.CheckCallLimit(r, 1)
+	if pageError == nil {
+		data.Data, pageError = getAddressPageData(address, pageIdx, pageSize)
+	}
+	if pageError != nil {
+		handlePageError(w, r, pageError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/html")
+	if handleTemplateError(w, r, "address.go", "Address", "", templates.GetTemplate(addressTemplateFiles...).ExecuteTemplate(w, "layout", data)) != nil {
+		return // an error has occurred and was processed
+	}
+}
+
+func getAddressPageData(address []byte, pageIdx uint64, pageSize uint64) (*models.AddressPageData, error) {
+	pageData := &models.AddressPageData{}
+	pageCacheKey := fmt.Sprintf("address:%x:%v:%v", address, pageIdx, pageSize)
+	pageRes, pageErr := services.GlobalFrontendCache.ProcessCachedPage(pageCacheKey, true, pageData, func(pageCall *services.FrontendCacheProcessingPage) interface{} {
+		pageData, cacheTimeout := buildAddressPageData(address, pageIdx, pageSize)
+		pageCall.CacheTimeout = cacheTimeout
+		return pageData
+	})
+	if pageErr == nil && pageRes != nil {
+		resData, resOk := pageRes.(*models.AddressPageData)
+		if !resOk {
+			return nil, ErrInvalidPageModel
+		}
+		pageData = resData
+	}
+	return pageData, pageErr
+}
+
+func buildAddressPageData(address []byte, pageIdx uint64, pageSize uint64) (*models.AddressPageData, time.Duration) {
+	logrus.Debugf("address page called: 0x%x (%v:%v)", address, pageIdx, pageSize)
+	pageData := &models.AddressPageData{
+		Address:        address,
+		TxIndexEnabled: utils.Config.Indexer.EnableTransactionIndex,
+	}
+	if pageIdx == 1 {
+		pageData.IsDefaultPage = true
+	}
+
+	if pageSize > 100 {
+		pageSize = 100
+	} else if pageSize == 0 {
+		pageSize = 25
+	}
+	pageData.PageSize = pageSize
+	pageData.TotalPages = pageIdx
+	pageData.CurrentPageIndex = pageIdx
+	if pageIdx > 1 {
+		pageData.PrevPageIndex = pageIdx - 1
+	}
+
+	// load indexed transactions
+	pageData.Transactions = make([]*models.AddressPageDataTransaction, 0)
+	if pageData.TxIndexEnabled {
+		dbTransactions, totalRows, err := db.GetElTransactionsByAddress(address, (pageIdx-1)*pageSize, uint32(pageSize))
+		if err == nil {
+			pageData.TotalTransactions = totalRows
+			buildAddressPageTransactions(pageData, dbTransactions)
+		}
+	}
+	pageData.TransactionCount = uint64(len(pageData.Transactions))
+
+	pageData.TotalPages = pageData.TotalTransactions / pageSize
+	if pageData.TotalTransactions%pageSize > 0 {
+		pageData.TotalPages++
+	}
+	pageData.LastPageIndex = pageData.TotalPages
+	if pageIdx < pageData.TotalPages {
+		pageData.NextPageIndex = pageIdx + 1
+	}
+
+	pageData.FirstPageLink = fmt.Sprintf("/address/0x%x?c=%v", address, pageData.PageSize)
+	pageData.PrevPageLink = fmt.Sprintf("/address/0x%x?c=%v&p=%v", address, pageData.PageSize, pageData.PrevPageIndex)
+	pageData.NextPageLink = fmt.Sprintf("/address/0x%x?c=%v&p=%v", address, pageData.PageSize, pageData.NextPageIndex)
+	pageData.LastPageLink = fmt.Sprintf("/address/0x%x?c=%v&p=%v", address, pageData.PageSize, pageData.LastPageIndex)
+
+	// load deposits sent from this address
+	depositSyncState := dbtypes.DepositIndexerState{}
+	db.GetExplorerState("indexer.depositstate", &depositSyncState)
+	dbDepositTxs, totalDeposits, err := db.GetDepositTxsFiltered(0, addressPageObjectLimit, depositSyncState.FinalBlock, &dbtypes.DepositTxFilter{
+		Address:      address,
+		WithOrphaned: 1,
+		WithValid:    1,
+	})
+	if err == nil {
+		pageData.TotalDeposits = totalDeposits
+		for _, depositTx := range dbDepositTxs {
+			pageData.Deposits = append(pageData.Deposits, &models.AddressPageDataDeposit{
+				Index:     depositTx.Index,
+				PublicKey: depositTx.PublicKey,
+				Amount:    depositTx.Amount,
+				TxHash:    depositTx.TxHash,
+				Time:      time.Unix(int64(depositTx.BlockTime), 0),
+				Block:     depositTx.BlockNumber,
+				Orphaned:  depositTx.Orphaned,
+				Valid:     depositTx.ValidSignature,
+			})
+		}
+	}
+	pageData.DepositCount = uint64(len(pageData.Deposits))
+
+	// load deposits sent through this address (batch deposit contracts)
+	dbTargetDepositTxs, totalTargetDeposits, err := db.GetDepositTxsFiltered(0, addressPageObjectLimit, depositSyncState.FinalBlock, &dbtypes.DepositTxFilter{
+		TargetAddress: address,
+		WithOrphaned:  1,
+		WithValid:     1,
+	})
+	if err == nil {
+		pageData.TotalTargetDeposits = totalTargetDeposits
+		for _, depositTx := range dbTargetDepositTxs {
+			pageData.TargetDeposits = append(pageData.TargetDeposits, &models.AddressPageDataDeposit{
+				Index:     depositTx.Index,
+				PublicKey: depositTx.PublicKey,
+				Amount:    depositTx.Amount,
+				TxHash:    depositTx.TxHash,
+				Time:      time.Unix(int64(depositTx.BlockTime), 0),
+				Block:     depositTx.BlockNumber,
+				Orphaned:  depositTx.Orphaned,
+				Valid:     depositTx.ValidSignature,
+			})
+		}
+	}
+	pageData.TargetDepositCount = uint64(len(pageData.TargetDeposits))
+
+	// load withdrawals to this address
+	dbWithdrawals, totalWithdrawals := services.GlobalBeaconService.GetWithdrawalsByFilter(&dbtypes.WithdrawalFilter{
+		Address:      address,
+		WithOrphaned: 1,
+	}, 0, addressPageObjectLimit)
+	pageData.TotalWithdrawals = totalWithdrawals
+	for _, withdrawal := range dbWithdrawals {
+		pageData.Withdrawals = append(pageData.Withdrawals, &models.AddressPageDataWithdrawal{
+			Index:          withdrawal.WithdrawalIndex,
+			Slot:           withdrawal.SlotNumber,
+			SlotRoot:       withdrawal.SlotRoot,
+			Time:           utils.SlotToTime(withdrawal.SlotNumber),
+			Orphaned:       withdrawal.Orphaned,
+			ValidatorIndex: withdrawal.ValidatorIndex,
+			ValidatorName:  services.GlobalBeaconService.GetValidatorName(withdrawal.ValidatorIndex),
+			Amount:         withdrawal.Amount,
+		})
+	}
+	pageData.WithdrawalCount = uint64(len(pageData.Withdrawals))
+
+	// load validators with withdrawal credentials pointing to this address
+	validators := make([]*v1.Validator, 0)
+	for _, validator := range services.GlobalBeaconService.GetCachedValidatorSet() {
+		withdrawalCreds := validator.Validator.WithdrawalCredentials
+		if len(withdrawalCreds) == 32 && withdrawalCreds[0] == 0x01 && bytes.Equal(withdrawalCreds[12:], address) {
+			validators = append(validators, validator)
+		}
+	}
+	sort.Slice(validators, func(a, b int) bool {
+		return validators[a].Index < validators[b].Index
+	})
+	pageData.TotalValidators = uint64(len(validators))
+	if len(validators) > addressPageValidatorLimit {
+		validators = validators[:addressPageValidatorLimit]
+	}
+	for _, validator := range validators {
+		pageData.Validators = append(pageData.Validators, &models.AddressPageDataValidator{
+			Index:            uint64(validator.Index),
+			Name:             services.GlobalBeaconService.GetValidatorName(uint64(validator.Index)),
+			PublicKey:        validator.Validator.PublicKey[:],
+			Balance:          uint64(validator.Balance),
+			EffectiveBalance: uint64(validator.Validator.EffectiveBalance),
+			State:            getAddressValidatorState(validator.Status),
+		})
+	}
+	pageData.ValidatorCount = uint64(len(pageData.Validators))
+
+	// load blocks with this address as execution fee recipient
+	dbBlocks := services.GlobalBeaconService.GetDbBlocksByFilter(&dbtypes.BlockFilter{
+		FeeRecipient: address,
+		WithOrphaned: 1,
+	}, 0, addressPageObjectLimit+1)
+	for idx, dbBlock := range dbBlocks {
+		if idx >= addressPageObjectLimit {
+			pageData.HasMoreBlocks = true
+			break
+		}
+		if dbBlock.Block == nil {
+			continue
+		}
+		blockData := &models.AddressPageDataBlock{
+			Slot:         dbBlock.Slot,
+			BlockRoot:    dbBlock.Block.Root,
+			Time:         utils.SlotToTime(dbBlock.Slot),
+			Orphaned:     dbBlock.Block.Status == dbtypes.Orphaned,
+			Proposer:     dbBlock.Proposer,
+			ProposerName: services.GlobalBeaconService.GetValidatorName(dbBlock.Proposer),
+			EthTxCount:   dbBlock.Block.EthTransactionCount,
+			Graffiti:     dbBlock.Block.Graffiti,
+		}
+		if dbBlock.Block.EthBlockNumber != nil {
+			blockData.EthBlock = *dbBlock.Block.EthBlockNumber
+		}
+		pageData.Blocks = append(pageData.Blocks, blockData)
+	}
+	pageData.BlockCount = uint64(len(pageData.Blocks))
+
+	// load relayed mev blocks with this address as fee recipient
+	dbMevBlocks, totalMevBlocks, err := db.GetMevBlocksFiltered(0, addressPageObjectLimit, &dbtypes.MevBlockFilter{
+		FeeRecipient: address,
+	})
+	if err == nil {
+		pageData.TotalMevBlocks = totalMevBlocks
+		for _, mevBlock := range dbMevBlocks {
+			pageData.MevBlocks = append(pageData.MevBlocks, &models.AddressPageDataMevBlock{
+				Slot:         mevBlock.SlotNumber,
+				BlockHash:    mevBlock.BlockHash,
+				BlockNumber:  mevBlock.BlockNumber,
+				Time:         utils.SlotToTime(mevBlock.SlotNumber),
+				Proposed:     mevBlock.Proposed,
+				Proposer:     mevBlock.ProposerIndex,
+				ProposerName: services.GlobalBeaconService.GetValidatorName(mevBlock.ProposerIndex),
+				TxCount:      mevBlock.TxCount,
+				BlockValue:   mevBlock.BlockValueGwei,
+			})
+		}
+	}
+	pageData.MevBlockCount = uint64(len(pageData.MevBlocks))
+
+	return pageData, 1 * time.Minute
+}
+
+func getAddressValidatorState(status v1.ValidatorState) string {
+	switch {
+	case strings.HasPrefix(status.String(), "pending"):
+		return "Pending"
+	case status == v1.ValidatorStateActiveOngoing:
+		return "Active"
+	case status == v1.ValidatorStateActiveExiting:
+		return "Exiting"
+	case status == v1.ValidatorStateActiveSlashed, status == v1.ValidatorStateExitedSlashed:
+		return "Slashed"
+	case status == v1.ValidatorStateExitedUnslashed:
+		return "Exited"
+	default:
+		return status.String()
+	}
+}
+
+func buildAddressPageTransactions(pageData *models.AddressPageData, dbTransactions []*dbtypes.ElTransaction) {
+	sigLookupBytes := []types.TxSignatureBytes{}
+	sigLookupMap := map[types.TxSignatureBytes][]*models.AddressPageDataTransaction{}
+
+	for _, dbTx := range dbTransactions {
+		txData := &models.AddressPageDataTransaction{
+			TxHash:          dbTx.TxHash,
+			BlockNumber:     dbTx.BlockNumber,
+			Time:            time.Unix(int64(dbTx.BlockTime), 0),
+			From:            dbTx.TxFrom,
+			To:              dbTx.TxTo,
+			ContractAddress: dbTx.ContractAddress,
+			IsOutgoing:      bytes.Equal(dbTx.TxFrom, pageData.Address),
+			Value:           getTransactionEthValue(dbTx.Value),
+		}
+		if dbTx.Status != nil {
+			txData.HasReceipt = true
+			txData.Success = *dbTx.Status == 1
+		}
+		pageData.Transactions = append(pageData.Transactions, txData)
+
+		// check call fn signature
+		if len(dbTx.MethodSig) == 4 {
+			sigBytes := types.TxSignatureBytes(dbTx.MethodSig)
+			if sigLookupMap[sigBytes] == nil {
+				sigLookupBytes = append(sigLookupBytes, sigBytes)
+			}
+			sigLookupMap[sigBytes] = append(sigLookupMap[sigBytes], txData)
+		} else {
+			txData.FuncSigStatus = 10
+			txData.FuncName = "transfer"
+		}
+	}
+
+	if len(sigLookupBytes) > 0 {
+		sigLookups := services.GlobalTxSignaturesService.LookupSignatures(sigLookupBytes)
+		for _, sigLookup := range sigLookups {
+			for _, txData := range sigLookupMap[sigLookup.Bytes] {
+				txData.FuncSigStatus = uint64(sigLookup.Status)
+				txData.FuncBytes = fmt.Sprintf("0x%x", sigLookup.Bytes[:])
+				if sigLookup.Status == types.TxSigStatusFound {
+					txData.FuncSig = sigLookup.Signature
+					txData.FuncName = sigLookup.Name
+				} else {
+					txData.FuncName = "call?"
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,138 @@
+package handlers
+
+import (
+	"encoding/hex"
+	"net/http"
+	"strings"
+
+	"github.com/gorilla/mux"
+
+	"github.com/ethpandaops/dora/services"
+	"github.com/ethpandaops/dora/types/apitypes"
+	"github.com/ethpandaops/dora/types/models"
+)
+
+// ApiAddress will return the deposits, validators, withdrawals and fee recipient blocks related to an execution layer address
+func ApiAddress(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	address, err := hex.DecodeString(strings.TrimPrefix(vars["address"], "0x"))
+	if err != nil || len(address) != 20 {
+		writeApiError(w, r, http.StatusBadRequest, "invalid address: "+vars["address"])
+		return
+	}
+
+	pageError := services.GlobalCallRateLimiter.CheckCallLimit(r, 1)
+	if pageError != nil {
+		handleApiPageError(w, r, pageError)
+		return
+	}
+	pageData, pageError := getAddressPageData(address, 1, addressPageObjectLimit)
+	if pageError != nil {
+		handleApiPageError(w, r, pageError)
+		return
+	}
+
+	addressData := &apitypes.AddressDetails{
+		Address:               pageData.Address,
+		DepositCount:          pageData.TotalDeposits,
+		TargetDepositCount:    pageData.TotalTargetDeposits,
+		ValidatorCount:        pageData.TotalValidators,
+		WithdrawalCount:       pageData.TotalWithdrawals,
+		MevBlockCount:         pageData.TotalMevBlocks,
+		Deposits:              make([]*apitypes.AddressDeposit, 0, len(pageData.Deposits)),
+		TargetDeposits:        make([]*apitypes.AddressDeposit, 0, len(pageData.TargetDeposits)),
+		Validators:            make([]*apitypes.AddressValidator, 0, len(pageData.Validators)),
+		Withdrawals:           make([]*apitypes.AddressWithdrawal, 0, len(pageData.Withdrawals)),
+		FeeRecipientBlocks:    make([]*apitypes.AddressBlock, 0, len(pageData.Blocks)),
+		FeeRecipientMevBlocks: make([]*apitypes.AddressMevBlock, 0, len(pageData.MevBlocks)),
+	}
+
+	if pageData.TxIndexEnabled {
+		addressData.TransactionCount = &pageData.TotalTransactions
+		addressData.Transactions = make([]*apitypes.AddressTransaction, 0, len(pageData.Transactions))
+		for _, tx := range pageData.Transactions {
+			txData := &apitypes.AddressTransaction{
+				TxHash:          tx.TxHash,
+				BlockNumber:     tx.BlockNumber,
+				Time:            tx.Time,
+				From:            tx.From,
+				To:              tx.To,
+				ContractAddress: tx.ContractAddress,
+				Value:           tx.Value,
+				Method:          tx.FuncName,
+			}
+			if tx.HasReceipt {
+				success := tx.Success
+				txData.Success = &success
+			}
+			addressData.Transactions = append(addressData.Transactions, txData)
+		}
+	}
+	for _, deposit := range pageData.Deposits {
+		addressData.Deposits = append(addressData.Deposits, getApiAddressDeposit(deposit))
+	}
+	for _, deposit := range pageData.TargetDeposits {
+		addressData.TargetDeposits = append(addressData.TargetDeposits, getApiAddressDeposit(deposit))
+	}
+	for _, validator := range pageData.Validators {
+		addressData.Validators = append(addressData.Validators, &apitypes.AddressValidator{
+			Index:            validator.Index,
+			Name:             validator.Name,
+			PublicKey:        validator.PublicKey,
+			Balance:          validator.Balance,
+			EffectiveBalance: validator.EffectiveBalance,
+			State:            validator.State,
+		})
+	}
+	for _, withdrawal := range pageData.Withdrawals {
+		addressData.Withdrawals = append(addressData.Withdrawals, &apitypes.AddressWithdrawal{
+			Index:          withdrawal.Index,
+			Slot:           withdrawal.Slot,
+			SlotRoot:       withdrawal.SlotRoot,
+			Time:           withdrawal.Time,
+			Orphaned:       withdrawal.Orphaned,
+			ValidatorIndex: withdrawal.ValidatorIndex,
+			ValidatorName:  withdrawal.ValidatorName,
+			Amount:         withdrawal.Amount,
+		})
+	}
+	for _, block := range pageData.Blocks {
+		addressData.FeeRecipientBlocks = append(addressData.FeeRecipientBlocks, &apitypes.AddressBlock{
+			Slot:           block.Slot,
+			BlockRoot:      block.BlockRoot,
+			Time:           block.Time,
+			Orphaned:       block.Orphaned,
+			Proposer:       block.Proposer,
+			ProposerName:   block.ProposerName,
+			EthBlockNumber: block.EthBlock,
+			EthTxCount:     block.EthTxCount,
+		})
+	}
+	for _, mevBlock := range pageData.MevBlocks {
+		addressData.FeeRecipientMevBlocks = append(addressData.FeeRecipientMevBlocks, &apitypes.AddressMevBlock{
+			Slot:         mevBlock.Slot,
+			BlockHash:    mevBlock.BlockHash,
+			BlockNumber:  mevBlock.BlockNumber,
+			Time:         mevBlock.Time,
+			Proposed:     getApiMevBlockProposed(mevBlock.Proposed),
+			Proposer:     mevBlock.Proposer,
+			ProposerName: mevBlock.ProposerName,
+			TxCount:      mevBlock.TxCount,
+			BlockValue:   mevBlock.BlockValue,
+		})
+	}
+	writeApiResponse(w, r, addressData, nil)
+}
+
+func getApiAddressDeposit(deposit *models.AddressPageDataDeposit) *apitypes.AddressDeposit {
+	return &apitypes.AddressDeposit{
+		Index:     deposit.Index,
+		PublicKey: deposit.PublicKey,
+		Amount:    deposit.Amount,
+		TxHash:    deposit.TxHash,
+		Time:      deposit.Time,
+		Block:     deposit.Block,
+		Orphaned:  deposit.Orphaned,
+		Valid:     deposit.Valid,
+	}
+}
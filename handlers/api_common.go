@@ -0,0 +1,135 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/ethpandaops/dora/dbtypes"
+	"github.com/ethpandaops/dora/services"
+	"github.com/ethpandaops/dora/types/apitypes"
+)
+
+const apiDefaultPageSize = 50
+const apiMaxPageSize = 100
+
+// ApiNotFound returns the api error object for unknown /api/v1 routes
+func ApiNotFound(w http.ResponseWriter, r *http.Request) {
+	writeApiError(w, r, http.StatusNotFound, "endpoint not found")
+}
+
+func writeApiResponse(w http.ResponseWriter, r *http.Request, data interface{}, pagination *apitypes.Pagination) {
+	w.Header().Set("Content-Type", "application/json")
+	err := json.NewEncoder(w).Encode(&apitypes.Response{
+		Status:     apitypes.StatusOK,
+		Data:       data,
+		Pagination: pagination,
+	})
+	if err != nil {
+		logrus.Errorf("error encoding api response for %v route: %v", r.URL.String(), err)
+		http.Error(w, "Internal server error", http.StatusServiceUnavailable)
+	}
+}
+
+func writeApiError(w http.ResponseWriter, r *http.Request, statusCode int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	err := json.NewEncoder(w).Encode(&apitypes.Response{
+		Status: apitypes.StatusError,
+		Error: &apitypes.Error{
+			Code:    statusCode,
+			Message: message,
+		},
+	})
+	if err != nil {
+		logrus.Errorf("error encoding api error for %v route: %v", r.URL.String(), err)
+	}
+}
+
+func handleApiPageError(w http.ResponseWriter, r *http.Request, pageError error) {
+	if errors.Is(pageError, services.ErrCallRateLimitExceeded) {
+		writeApiError(w, r, http.StatusTooManyRequests, pageError.Error())
+		return
+	}
+	logrus.Errorf("api call error for %v route: %v", r.URL.String(), pageError)
+	writeApiError(w, r, http.StatusInternalServerError, pageError.Error())
+}
+
+// getApiUintArg parses an optional unsigned integer query argument
+func getApiUintArg(urlArgs url.Values, name string, defaultValue uint64) (uint64, error) {
+	if !urlArgs.Has(name) {
+		return defaultValue, nil
+	}
+	value, err := strconv.ParseUint(urlArgs.Get(name), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %v parameter: %v", name, urlArgs.Get(name))
+	}
+	return value, nil
+}
+
+// getApiPaging parses the `page` (1-based) and `limit` query arguments shared by all paged endpoints
+func getApiPaging(urlArgs url.Values) (uint64, uint64, error) {
+	page, err := getApiUintArg(urlArgs, "page", 1)
+	if err != nil {
+		return 0, 0, err
+	}
+	if page < 1 {
+		return 0, 0, fmt.Errorf("invalid page parameter: must be >= 1")
+	}
+	limit, err := getApiUintArg(urlArgs, "limit", apiDefaultPageSize)
+	if err != nil {
+		return 0, 0, err
+	}
+	if limit < 1 || limit > apiMaxPageSize {
+		return 0, 0, fmt.Errorf("invalid limit parameter: must be between 1 and %v", apiMaxPageSize)
+	}
+	return page, limit, nil
+}
+
+func buildApiPagination(page uint64, limit uint64, totalPages uint64) *apitypes.Pagination {
+	pagination := &apitypes.Pagination{
+		Page:       page,
+		Limit:      limit,
+		TotalPages: totalPages,
+	}
+	if page > 1 {
+		pagination.PrevPage = page - 1
+	}
+	if page < totalPages {
+		pagination.NextPage = page + 1
+	}
+	return pagination
+}
+
+func getApiTotalPages(totalRows uint64, limit uint64) uint64 {
+	totalPages := totalRows / limit
+	if totalRows%limit > 0 {
+		totalPages++
+	}
+	return totalPages
+}
+
+func getApiSlotStatus(status uint8, scheduled bool) string {
+	switch dbtypes.SlotStatus(status) {
+	case dbtypes.Canonical:
+		return "canonical"
+	case dbtypes.Orphaned:
+		return "orphaned"
+	}
+	if scheduled {
+		return "scheduled"
+	}
+	return "missed"
+}
+
+func getApiUint64Ptr(value uint64, isSet bool) *uint64 {
+	if !isSet {
+		return nil
+	}
+	return &value
+}
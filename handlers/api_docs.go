@@ -0,0 +1,38 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/ethpandaops/dora/templates"
+	"github.com/ethpandaops/dora/types/apitypes"
+	"github.com/ethpandaops/dora/types/models"
+)
+
+// ApiOpenApiSpec will return the OpenAPI document describing the explorer api
+func ApiOpenApiSpec(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	_, err := w.Write(apitypes.OpenApiSpec)
+	if err != nil {
+		logrus.WithError(err).Error("error writing openapi spec")
+	}
+}
+
+// ApiDocs will return the "api docs" page using a go template
+func ApiDocs(w http.ResponseWriter, r *http.Request) {
+	var templateFiles = append(layoutTemplateFiles,
+		"api_docs/api_docs.html",
+	)
+
+	var pageTemplate = templates.GetTemplate(templateFiles...)
+	data := InitPageData(w, r, "api", "/api/docs", "API Documentation", templateFiles)
+	data.Data = &models.ApiDocsPageData{
+		SpecUrl: "/api/openapi.json",
+	}
+
+	w.Header().Set("Content-Type", "text/html")
+	if handleTemplateError(w, r, "api_docs.go", "ApiDocs", "", pageTemplate.ExecuteTemplate(w, "layout", data)) != nil {
+		return // an error has occurred and was processed
+	}
+}
@@ -0,0 +1,146 @@
+package handlers
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"github.com/ethpandaops/dora/services"
+	"github.com/ethpandaops/dora/types/apitypes"
+	"github.com/ethpandaops/dora/utils"
+)
+
+// ApiEpochs will return the list of most recent epochs
+func ApiEpochs(w http.ResponseWriter, r *http.Request) {
+	page, limit, err := getApiPaging(r.URL.Query())
+	if err != nil {
+		writeApiError(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	currentEpoch := uint64(utils.TimeToEpoch(time.Now()))
+	firstEpoch := uint64(math.MaxUint64)
+	if page > 1 {
+		skipEpochs := (page - 1) * limit
+		if skipEpochs > currentEpoch {
+			writeApiResponse(w, r, []*apitypes.Epoch{}, buildApiPagination(page, limit, 0))
+			return
+		}
+		firstEpoch = currentEpoch - skipEpochs
+	}
+
+	pageError := services.GlobalCallRateLimiter.CheckCallLimit(r, 1)
+	if pageError != nil {
+		handleApiPageError(w, r, pageError)
+		return
+	}
+	pageData, pageError := getEpochsPageData(firstEpoch, limit)
+	if pageError != nil {
+		handleApiPageError(w, r, pageError)
+		return
+	}
+
+	epochs := make([]*apitypes.Epoch, 0, len(pageData.Epochs))
+	for _, epoch := range pageData.Epochs {
+		epochs = append(epochs, &apitypes.Epoch{
+			Epoch:                   epoch.Epoch,
+			Time:                    epoch.Ts,
+			Finalized:               epoch.Finalized,
+			Justified:               epoch.Justified,
+			Synchronized:            epoch.Synchronized,
+			CanonicalBlockCount:     epoch.CanonicalBlockCount,
+			OrphanedBlockCount:      epoch.OrphanedBlockCount,
+			AttestationCount:        epoch.AttestationCount,
+			DepositCount:            epoch.DepositCount,
+			ExitCount:               epoch.ExitCount,
+			ProposerSlashingCount:   epoch.ProposerSlashingCount,
+			AttesterSlashingCount:   epoch.AttesterSlashingCount,
+			EligibleEther:           epoch.EligibleEther,
+			TargetVoted:             epoch.TargetVoted,
+			HeadVoted:               epoch.HeadVoted,
+			TotalVoted:              epoch.TotalVoted,
+			TargetVoteParticipation: epoch.TargetVoteParticipation,
+			HeadVoteParticipation:   epoch.HeadVoteParticipation,
+			TotalVoteParticipation:  epoch.TotalVoteParticipation,
+			EthTransactionCount:     epoch.EthTransactionCount,
+		})
+	}
+	writeApiResponse(w, r, epochs, buildApiPagination(page, limit, pageData.TotalPages))
+}
+
+// ApiEpoch will return the details of a single epoch
+func ApiEpoch(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	epoch, err := strconv.ParseUint(vars["epoch"], 10, 64)
+	if err != nil {
+		writeApiError(w, r, http.StatusBadRequest, "invalid epoch")
+		return
+	}
+
+	pageError := services.GlobalCallRateLimiter.CheckCallLimit(r, 1)
+	if pageError != nil {
+		handleApiPageError(w, r, pageError)
+		return
+	}
+	pageData, pageError := getEpochPageData(epoch)
+	if pageError != nil {
+		handleApiPageError(w, r, pageError)
+		return
+	}
+	if pageData == nil {
+		writeApiError(w, r, http.StatusNotFound, "epoch not found")
+		return
+	}
+
+	epochData := &apitypes.EpochDetails{
+		Epoch:                   pageData.Epoch,
+		Time:                    pageData.Ts,
+		Finalized:               pageData.Finalized,
+		Synchronized:            pageData.Synchronized,
+		AttestationCount:        pageData.AttestationCount,
+		DepositCount:            pageData.DepositCount,
+		ExitCount:               pageData.ExitCount,
+		WithdrawalCount:         pageData.WithdrawalCount,
+		WithdrawalAmount:        pageData.WithdrawalAmount,
+		ProposerSlashingCount:   pageData.ProposerSlashingCount,
+		AttesterSlashingCount:   pageData.AttesterSlashingCount,
+		EligibleEther:           pageData.EligibleEther,
+		TargetVoted:             pageData.TargetVoted,
+		HeadVoted:               pageData.HeadVoted,
+		TotalVoted:              pageData.TotalVoted,
+		TargetVoteParticipation: pageData.TargetVoteParticipation,
+		HeadVoteParticipation:   pageData.HeadVoteParticipation,
+		TotalVoteParticipation:  pageData.TotalVoteParticipation,
+		SyncParticipation:       pageData.SyncParticipation,
+		ValidatorCount:          pageData.ValidatorCount,
+		AverageValidatorBalance: pageData.AverageValidatorBalance,
+		CanonicalCount:          pageData.CanonicalCount,
+		MissedCount:             pageData.MissedCount,
+		ScheduledCount:          pageData.ScheduledCount,
+		OrphanedCount:           pageData.OrphanedCount,
+		Slots:                   make([]*apitypes.EpochSlot, 0, len(pageData.Slots)),
+	}
+	for _, slot := range pageData.Slots {
+		epochData.Slots = append(epochData.Slots, &apitypes.EpochSlot{
+			Slot:                  slot.Slot,
+			Time:                  slot.Ts,
+			Status:                getApiSlotStatus(slot.Status, slot.Scheduled),
+			Proposer:              slot.Proposer,
+			ProposerName:          slot.ProposerName,
+			AttestationCount:      slot.AttestationCount,
+			DepositCount:          slot.DepositCount,
+			ExitCount:             slot.ExitCount,
+			ProposerSlashingCount: slot.ProposerSlashingCount,
+			AttesterSlashingCount: slot.AttesterSlashingCount,
+			SyncParticipation:     slot.SyncParticipation,
+			EthTransactionCount:   slot.EthTransactionCount,
+			EthBlockNumber:        getApiUint64Ptr(slot.EthBlockNumber, slot.WithEthBlock),
+			Graffiti:              slot.Graffiti,
+			BlockRoot:             slot.BlockRoot,
+		})
+	}
+	writeApiResponse(w, r, epochData, nil)
+}
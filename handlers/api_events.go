@@ -0,0 +1,92 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ethpandaops/dora/services"
+	"github.com/ethpandaops/dora/utils"
+)
+
+// ApiEvents streams live chain events (new blocks, heads, reorgs, finalized checkpoints & epoch summaries) as server-sent events
+func ApiEvents(w http.ResponseWriter, r *http.Request) {
+	if services.GlobalEventStreamService == nil {
+		writeApiError(w, r, http.StatusServiceUnavailable, "event stream not available")
+		return
+	}
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		writeApiError(w, r, http.StatusInternalServerError, "streaming not supported")
+		return
+	}
+
+	topics := services.EventStreamTopics
+	if topicsArg := r.URL.Query().Get("topics"); topicsArg != "" {
+		topics = []string{}
+		for _, topic := range strings.Split(topicsArg, ",") {
+			topic = strings.TrimSpace(topic)
+			if !utils.SliceContains(services.EventStreamTopics, topic) {
+				writeApiError(w, r, http.StatusBadRequest, fmt.Sprintf("invalid topic: %v", topic))
+				return
+			}
+			topics = append(topics, topic)
+		}
+	}
+
+	lastEventIdStr := r.Header.Get("Last-Event-ID")
+	if lastEventIdStr == "" {
+		lastEventIdStr = r.URL.Query().Get("last_event_id")
+	}
+	lastEventId, _ := strconv.ParseUint(lastEventIdStr, 10, 64)
+
+	// the frontend server applies a write timeout to all requests. try to lift it for this stream,
+	// otherwise end the stream cleanly before the timeout hits and let the client reconnect & replay.
+	var streamEnd <-chan time.Time
+	if err := http.NewResponseController(w).SetWriteDeadline(time.Time{}); err != nil && utils.Config.Frontend.HttpWriteTimeout > 0 {
+		streamTimeout := utils.Config.Frontend.HttpWriteTimeout - 2*time.Second
+		if streamTimeout < time.Second {
+			streamTimeout = time.Second
+		}
+		streamEnd = time.After(streamTimeout)
+	}
+
+	client, replay := services.GlobalEventStreamService.Subscribe(topics, lastEventId)
+	defer services.GlobalEventStreamService.Unsubscribe(client)
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	fmt.Fprintf(w, "retry: 1000\n\n")
+	for _, event := range replay {
+		writeApiStreamEvent(w, event)
+	}
+	flusher.Flush()
+
+	keepalive := time.NewTicker(10 * time.Second)
+	defer keepalive.Stop()
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-streamEnd:
+			return
+		case <-keepalive.C:
+			fmt.Fprintf(w, ": keepalive\n\n")
+			flusher.Flush()
+		case event := <-client.Channel():
+			writeApiStreamEvent(w, event)
+			flusher.Flush()
+		}
+	}
+}
+
+func writeApiStreamEvent(w http.ResponseWriter, event *services.EventStreamEvent) {
+	fmt.Fprintf(w, "id: %v\nevent: %v\ndata: %s\n\n", event.Id, event.Topic, event.Data)
+}
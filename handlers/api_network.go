@@ -0,0 +1,330 @@
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/ethpandaops/dora/services"
+	"github.com/ethpandaops/dora/types/apitypes"
+	"github.com/ethpandaops/dora/utils"
+)
+
+// ApiForks will return the current head forks of the connected consensus clients
+func ApiForks(w http.ResponseWriter, r *http.Request) {
+	pageError := services.GlobalCallRateLimiter.CheckCallLimit(r, 1)
+	if pageError != nil {
+		handleApiPageError(w, r, pageError)
+		return
+	}
+	pageData, pageError := getForksPageData()
+	if pageError != nil {
+		handleApiPageError(w, r, pageError)
+		return
+	}
+
+	forks := make([]*apitypes.Fork, 0, len(pageData.Forks))
+	for _, fork := range pageData.Forks {
+		forkData := &apitypes.Fork{
+			HeadSlot: fork.HeadSlot,
+			HeadRoot: fork.HeadRoot,
+			Clients:  make([]*apitypes.ForkClient, 0, len(fork.Clients)),
+		}
+		for _, client := range fork.Clients {
+			forkData.Clients = append(forkData.Clients, &apitypes.ForkClient{
+				Index:       client.Index,
+				Name:        client.Name,
+				Version:     client.Version,
+				Status:      client.Status,
+				HeadSlot:    client.HeadSlot,
+				Distance:    client.Distance,
+				LastRefresh: client.LastRefresh,
+				LastError:   client.LastError,
+			})
+		}
+		forks = append(forks, forkData)
+	}
+	writeApiResponse(w, r, forks, nil)
+}
+
+// ApiForkTree will return the fork choice tree (all unfinalized blocks since the last finalized checkpoint)
+func ApiForkTree(w http.ResponseWriter, r *http.Request) {
+	pageError := services.GlobalCallRateLimiter.CheckCallLimit(r, 1)
+	if pageError != nil {
+		handleApiPageError(w, r, pageError)
+		return
+	}
+	pageData, pageError := getForkTreePageData()
+	if pageError != nil {
+		handleApiPageError(w, r, pageError)
+		return
+	}
+
+	tree := &apitypes.ForkChoiceTree{
+		FinalizedEpoch: pageData.FinalizedEpoch,
+		FinalizedRoot:  pageData.FinalizedRoot,
+		JustifiedEpoch: pageData.JustifiedEpoch,
+		JustifiedRoot:  pageData.JustifiedRoot,
+		HeadSlot:       pageData.HeadSlot,
+		HeadRoot:       pageData.HeadRoot,
+		WeightUnit:     "gwei",
+		TotalWeight:    pageData.TotalWeight,
+		Nodes:          make([]*apitypes.ForkChoiceNode, 0, len(pageData.Nodes)),
+	}
+	if pageData.VoteCounts {
+		tree.WeightUnit = "validators"
+	}
+	for _, node := range pageData.Nodes {
+		nodeData := &apitypes.ForkChoiceNode{
+			Slot:          node.Slot,
+			Root:          node.Root,
+			ParentRoot:    node.ParentRoot,
+			Canonical:     node.Canonical,
+			Orphaned:      node.Orphaned,
+			Finalized:     node.IsFinalized,
+			Justified:     node.IsJustified,
+			Proposer:      node.Proposer,
+			ProposerName:  node.ProposerName,
+			VoteWeight:    node.VoteWeight,
+			Weight:        node.Weight,
+			WeightPercent: node.WeightPercent,
+			ChildCount:    node.ChildCount,
+			HeadClients:   make([]string, 0, len(node.Clients)),
+		}
+		for _, client := range node.Clients {
+			nodeData.HeadClients = append(nodeData.HeadClients, client.Name)
+		}
+		tree.Nodes = append(tree.Nodes, nodeData)
+	}
+	writeApiResponse(w, r, tree, nil)
+}
+
+// ApiReorgs will return the reorgs recorded by the indexer (most recent first)
+func ApiReorgs(w http.ResponseWriter, r *http.Request) {
+	urlArgs := r.URL.Query()
+	page, limit, err := getApiPaging(urlArgs)
+	if err != nil {
+		writeApiError(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+	filterArgs := map[string]uint64{
+		"min_slot":  0,
+		"max_slot":  0,
+		"min_depth": 0,
+	}
+	for name, defaultValue := range filterArgs {
+		filterArgs[name], err = getApiUintArg(urlArgs, name, defaultValue)
+		if err != nil {
+			writeApiError(w, r, http.StatusBadRequest, err.Error())
+			return
+		}
+	}
+
+	pageError := services.GlobalCallRateLimiter.CheckCallLimit(r, 2)
+	if pageError != nil {
+		handleApiPageError(w, r, pageError)
+		return
+	}
+	pageData, pageError := getFilteredReorgsPageData(page, limit, filterArgs["min_slot"], filterArgs["max_slot"], filterArgs["min_depth"])
+	if pageError != nil {
+		handleApiPageError(w, r, pageError)
+		return
+	}
+
+	reorgs := make([]*apitypes.Reorg, 0, len(pageData.Reorgs))
+	for _, reorg := range pageData.Reorgs {
+		reorgData := &apitypes.Reorg{
+			Slot:              reorg.SlotNumber,
+			HeadRoot:          reorg.HeadRoot,
+			Time:              reorg.Time,
+			OldHeadSlot:       reorg.OldHeadSlot,
+			OldHeadRoot:       reorg.OldHeadRoot,
+			Depth:             reorg.Depth,
+			FirstAffectedSlot: reorg.FirstAffectedSlot,
+			LastAffectedSlot:  reorg.LastAffectedSlot,
+			DetectedTime:      reorg.DetectedTime,
+			OldHeadClients:    reorg.OldHeadClients,
+			NewHeadClients:    reorg.NewHeadClients,
+		}
+		if reorg.HasAncestor {
+			ancestorSlot := reorg.AncestorSlot
+			reorgData.AncestorSlot = &ancestorSlot
+			reorgData.AncestorRoot = reorg.AncestorRoot
+		}
+		reorgs = append(reorgs, reorgData)
+	}
+	writeApiResponse(w, r, reorgs, buildApiPagination(page, limit, pageData.TotalPages))
+}
+
+// ApiClientsCL will return the status of the connected consensus clients
+func ApiClientsCL(w http.ResponseWriter, r *http.Request) {
+	pageError := services.GlobalCallRateLimiter.CheckCallLimit(r, 1)
+	if pageError != nil {
+		handleApiPageError(w, r, pageError)
+		return
+	}
+	pageData, pageError := getCLClientsPageData()
+	if pageError != nil {
+		handleApiPageError(w, r, pageError)
+		return
+	}
+
+	clients := make([]*apitypes.Client, 0, len(pageData.Clients))
+	for _, client := range pageData.Clients {
+		clientData := &apitypes.Client{
+			Index:       client.Index,
+			Name:        client.Name,
+			Version:     client.Version,
+			Status:      client.Status,
+			HeadSlot:    client.HeadSlot,
+			HeadRoot:    client.HeadRoot,
+			LastRefresh: client.LastRefresh,
+			LastError:   client.LastError,
+			PeerID:      client.PeerID,
+			PeerCount:   client.PeersInboundCounter + client.PeersOutboundCounter,
+			Peers:       make([]*apitypes.ClientPeer, 0, len(client.Peers)),
+		}
+		for _, peer := range client.Peers {
+			clientData.Peers = append(clientData.Peers, &apitypes.ClientPeer{
+				ID:        peer.ID,
+				Alias:     peer.Alias,
+				Type:      peer.Type,
+				State:     peer.State,
+				Direction: peer.Direction,
+			})
+		}
+		clients = append(clients, clientData)
+	}
+	writeApiResponse(w, r, clients, nil)
+}
+
+// ApiClientsEL will return the status of the connected execution clients
+func ApiClientsEL(w http.ResponseWriter, r *http.Request) {
+	pageError := services.GlobalCallRateLimiter.CheckCallLimit(r, 1)
+	if pageError != nil {
+		handleApiPageError(w, r, pageError)
+		return
+	}
+	pageData, pageError := getELClientsPageData()
+	if pageError != nil {
+		handleApiPageError(w, r, pageError)
+		return
+	}
+
+	clients := make([]*apitypes.Client, 0, len(pageData.Clients))
+	for _, client := range pageData.Clients {
+		clientData := &apitypes.Client{
+			Index:       client.Index,
+			Name:        client.Name,
+			Version:     client.Version,
+			Status:      client.Status,
+			HeadNumber:  client.HeadSlot,
+			HeadRoot:    client.HeadRoot,
+			LastRefresh: client.LastRefresh,
+			LastError:   client.LastError,
+			PeerID:      client.PeerID,
+			PeerCount:   client.PeersInboundCounter + client.PeersOutboundCounter,
+			Peers:       make([]*apitypes.ClientPeer, 0, len(client.Peers)),
+		}
+		for _, peer := range client.Peers {
+			clientData.Peers = append(clientData.Peers, &apitypes.ClientPeer{
+				ID:        peer.ID,
+				Alias:     peer.Alias,
+				Type:      peer.Type,
+				State:     peer.State,
+				Direction: peer.Direction,
+			})
+		}
+		clients = append(clients, clientData)
+	}
+	writeApiResponse(w, r, clients, nil)
+}
+
+// ApiClientDiversity will return the proposer client statistics of an epoch range (default: last 225 epochs, max 10000 epochs)
+func ApiClientDiversity(w http.ResponseWriter, r *http.Request) {
+	urlArgs := r.URL.Query()
+	currentEpoch := utils.EpochOfSlot(utils.TimeToSlot(uint64(time.Now().Unix())))
+	maxEpoch, err := getApiUintArg(urlArgs, "max_epoch", currentEpoch)
+	if err != nil {
+		writeApiError(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+	defaultMinEpoch := uint64(0)
+	if maxEpoch > 225 {
+		defaultMinEpoch = maxEpoch - 225
+	}
+	minEpoch, err := getApiUintArg(urlArgs, "min_epoch", defaultMinEpoch)
+	if err != nil {
+		writeApiError(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+	if minEpoch > maxEpoch {
+		writeApiError(w, r, http.StatusBadRequest, "min_epoch must not be greater than max_epoch")
+		return
+	}
+	if maxEpoch-minEpoch >= 10000 {
+		writeApiError(w, r, http.StatusBadRequest, "epoch range must not exceed 10000 epochs")
+		return
+	}
+
+	pageError := services.GlobalCallRateLimiter.CheckCallLimit(r, 2)
+	if pageError != nil {
+		handleApiPageError(w, r, pageError)
+		return
+	}
+	pageData, pageError := getClientDiversityPageData(minEpoch, maxEpoch, true)
+	if pageError != nil {
+		handleApiPageError(w, r, pageError)
+		return
+	}
+
+	diversity := &apitypes.ClientDiversity{
+		MinEpoch:      pageData.MinEpoch,
+		MaxEpoch:      pageData.MaxEpoch,
+		EpochCount:    pageData.EpochCount,
+		TotalProposed: pageData.TotalProposed,
+		TotalMissed:   pageData.TotalMissed,
+		MissedRate:    pageData.MissedRate,
+		ClClients:     make([]*apitypes.ClientDiversityClient, 0, len(pageData.ClLayer.Clients)),
+		ElClients:     make([]*apitypes.ClientDiversityClient, 0, len(pageData.ElLayer.Clients)),
+		Pairs:         make([]*apitypes.ClientDiversityPair, 0, len(pageData.Pairs)),
+		Epochs:        make([]*apitypes.ClientDiversityEpoch, 0, len(pageData.Epochs)),
+	}
+	for _, client := range pageData.ClLayer.Clients {
+		diversity.ClClients = append(diversity.ClClients, &apitypes.ClientDiversityClient{
+			Client:     client.Name,
+			Proposed:   client.ProposedCount,
+			Missed:     client.MissedCount,
+			Share:      client.Share,
+			MissedRate: client.MissedRate,
+		})
+	}
+	for _, client := range pageData.ElLayer.Clients {
+		diversity.ElClients = append(diversity.ElClients, &apitypes.ClientDiversityClient{
+			Client:     client.Name,
+			Proposed:   client.ProposedCount,
+			Missed:     client.MissedCount,
+			Share:      client.Share,
+			MissedRate: client.MissedRate,
+		})
+	}
+	for _, pair := range pageData.Pairs {
+		diversity.Pairs = append(diversity.Pairs, &apitypes.ClientDiversityPair{
+			ClClient:   pair.ClClient,
+			ElClient:   pair.ElClient,
+			Proposed:   pair.ProposedCount,
+			Missed:     pair.MissedCount,
+			Share:      pair.Share,
+			MissedRate: pair.MissedRate,
+		})
+	}
+	for _, epoch := range pageData.Epochs {
+		diversity.Epochs = append(diversity.Epochs, &apitypes.ClientDiversityEpoch{
+			Epoch:    epoch.Epoch,
+			ClClient: epoch.ClClient,
+			ElClient: epoch.ElClient,
+			Proposed: epoch.ProposedCount,
+			Missed:   epoch.MissedCount,
+		})
+	}
+	writeApiResponse(w, r, diversity, nil)
+}
@@ -0,0 +1,446 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/ethpandaops/dora/dbtypes"
+	"github.com/ethpandaops/dora/services"
+	"github.com/ethpandaops/dora/types/apitypes"
+)
+
+// ApiInitiatedDeposits will return the list of deposits sent to the deposit contract
+func ApiInitiatedDeposits(w http.ResponseWriter, r *http.Request) {
+	urlArgs := r.URL.Query()
+	page, limit, err := getApiPaging(urlArgs)
+	if err != nil {
+		writeApiError(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+	filterArgs := map[string]uint64{
+		"min_amount":    0,
+		"max_amount":    0,
+		"with_orphaned": 1,
+		"with_valid":    1,
+	}
+	for name, defaultValue := range filterArgs {
+		filterArgs[name], err = getApiUintArg(urlArgs, name, defaultValue)
+		if err != nil {
+			writeApiError(w, r, http.StatusBadRequest, err.Error())
+			return
+		}
+	}
+
+	pageError := services.GlobalCallRateLimiter.CheckCallLimit(r, 2)
+	if pageError != nil {
+		handleApiPageError(w, r, pageError)
+		return
+	}
+	pageData, pageError := getFilteredInitiatedDepositsPageData(page, limit, urlArgs.Get("address"), urlArgs.Get("pubkey"), urlArgs.Get("validator_name"), filterArgs["min_amount"], filterArgs["max_amount"], uint8(filterArgs["with_orphaned"]), uint8(filterArgs["with_valid"]))
+	if pageError != nil {
+		handleApiPageError(w, r, pageError)
+		return
+	}
+
+	deposits := make([]*apitypes.InitiatedDeposit, 0, len(pageData.Deposits))
+	for _, deposit := range pageData.Deposits {
+		deposits = append(deposits, &apitypes.InitiatedDeposit{
+			Index:                 deposit.Index,
+			Address:               deposit.Address,
+			PublicKey:             deposit.PublicKey,
+			WithdrawalCredentials: deposit.Withdrawalcredentials,
+			Amount:                deposit.Amount,
+			TxHash:                deposit.TxHash,
+			Time:                  deposit.Time,
+			Block:                 deposit.Block,
+			Orphaned:              deposit.Orphaned,
+			Valid:                 deposit.Valid,
+			ValidatorStatus:       deposit.ValidatorStatus,
+		})
+	}
+	writeApiResponse(w, r, deposits, buildApiPagination(page, limit, pageData.TotalPages))
+}
+
+// ApiIncludedDeposits will return the list of deposits included in the beacon chain
+func ApiIncludedDeposits(w http.ResponseWriter, r *http.Request) {
+	urlArgs := r.URL.Query()
+	page, limit, err := getApiPaging(urlArgs)
+	if err != nil {
+		writeApiError(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+	filterArgs := map[string]uint64{
+		"min_index":     0,
+		"max_index":     0,
+		"min_amount":    0,
+		"max_amount":    0,
+		"with_orphaned": 1,
+	}
+	for name, defaultValue := range filterArgs {
+		filterArgs[name], err = getApiUintArg(urlArgs, name, defaultValue)
+		if err != nil {
+			writeApiError(w, r, http.StatusBadRequest, err.Error())
+			return
+		}
+	}
+
+	pageError := services.GlobalCallRateLimiter.CheckCallLimit(r, 2)
+	if pageError != nil {
+		handleApiPageError(w, r, pageError)
+		return
+	}
+	pageData, pageError := getFilteredIncludedDepositsPageData(page, limit, filterArgs["min_index"], filterArgs["max_index"], urlArgs.Get("pubkey"), urlArgs.Get("validator_name"), filterArgs["min_amount"], filterArgs["max_amount"], uint8(filterArgs["with_orphaned"]))
+	if pageError != nil {
+		handleApiPageError(w, r, pageError)
+		return
+	}
+
+	deposits := make([]*apitypes.IncludedDeposit, 0, len(pageData.Deposits))
+	for _, deposit := range pageData.Deposits {
+		deposits = append(deposits, &apitypes.IncludedDeposit{
+			Index:                 getApiUint64Ptr(deposit.Index, deposit.HasIndex),
+			PublicKey:             deposit.PublicKey,
+			WithdrawalCredentials: deposit.Withdrawalcredentials,
+			Amount:                deposit.Amount,
+			Slot:                  deposit.SlotNumber,
+			SlotRoot:              deposit.SlotRoot,
+			Time:                  deposit.Time,
+			Orphaned:              deposit.Orphaned,
+			ValidatorStatus:       deposit.ValidatorStatus,
+		})
+	}
+	writeApiResponse(w, r, deposits, buildApiPagination(page, limit, pageData.TotalPages))
+}
+
+// ApiDepositSnapshot will return the EIP-4881 deposit tree snapshot at the latest finalized eth1 data
+func ApiDepositSnapshot(w http.ResponseWriter, r *http.Request) {
+	pageError := services.GlobalCallRateLimiter.CheckCallLimit(r, 1)
+	if pageError != nil {
+		handleApiPageError(w, r, pageError)
+		return
+	}
+	snapshot, err := services.GlobalBeaconService.GetIndexer().GetDepositSnapshot()
+	if err != nil {
+		writeApiError(w, r, http.StatusServiceUnavailable, fmt.Sprintf("deposit snapshot not available: %v", err))
+		return
+	}
+
+	finalized := make([]hexutil.Bytes, len(snapshot.Finalized))
+	for idx, root := range snapshot.Finalized {
+		finalized[idx] = root
+	}
+	writeApiResponse(w, r, &apitypes.DepositSnapshot{
+		Finalized:            finalized,
+		DepositRoot:          snapshot.DepositRoot,
+		DepositCount:         snapshot.DepositCount,
+		ExecutionBlockHash:   snapshot.ExecutionBlockHash,
+		ExecutionBlockHeight: snapshot.ExecutionBlockHeight,
+	}, nil)
+}
+
+// ApiVoluntaryExits will return the list of voluntary exits included in the beacon chain
+func ApiVoluntaryExits(w http.ResponseWriter, r *http.Request) {
+	urlArgs := r.URL.Query()
+	page, limit, err := getApiPaging(urlArgs)
+	if err != nil {
+		writeApiError(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+	filterArgs := map[string]uint64{
+		"min_slot":      0,
+		"max_slot":      0,
+		"min_index":     0,
+		"max_index":     0,
+		"with_orphaned": 1,
+	}
+	for name, defaultValue := range filterArgs {
+		filterArgs[name], err = getApiUintArg(urlArgs, name, defaultValue)
+		if err != nil {
+			writeApiError(w, r, http.StatusBadRequest, err.Error())
+			return
+		}
+	}
+
+	pageError := services.GlobalCallRateLimiter.CheckCallLimit(r, 2)
+	if pageError != nil {
+		handleApiPageError(w, r, pageError)
+		return
+	}
+	pageData, pageError := getFilteredVoluntaryExitsPageData(page, limit, filterArgs["min_slot"], filterArgs["max_slot"], filterArgs["min_index"], filterArgs["max_index"], urlArgs.Get("validator_name"), uint8(filterArgs["with_orphaned"]))
+	if pageError != nil {
+		handleApiPageError(w, r, pageError)
+		return
+	}
+
+	exits := make([]*apitypes.VoluntaryExit, 0, len(pageData.VoluntaryExits))
+	for _, exit := range pageData.VoluntaryExits {
+		exits = append(exits, &apitypes.VoluntaryExit{
+			Slot:                  exit.SlotNumber,
+			SlotRoot:              exit.SlotRoot,
+			Time:                  exit.Time,
+			Orphaned:              exit.Orphaned,
+			ValidatorIndex:        exit.ValidatorIndex,
+			ValidatorName:         exit.ValidatorName,
+			PublicKey:             exit.PublicKey,
+			WithdrawalCredentials: exit.WithdrawalCreds,
+			ValidatorStatus:       exit.ValidatorStatus,
+		})
+	}
+	writeApiResponse(w, r, exits, buildApiPagination(page, limit, pageData.TotalPages))
+}
+
+// ApiSlashings will return the list of slashings included in the beacon chain
+func ApiSlashings(w http.ResponseWriter, r *http.Request) {
+	urlArgs := r.URL.Query()
+	page, limit, err := getApiPaging(urlArgs)
+	if err != nil {
+		writeApiError(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+	filterArgs := map[string]uint64{
+		"min_slot":      0,
+		"max_slot":      0,
+		"min_index":     0,
+		"max_index":     0,
+		"with_reason":   0,
+		"with_orphaned": 1,
+	}
+	for name, defaultValue := range filterArgs {
+		filterArgs[name], err = getApiUintArg(urlArgs, name, defaultValue)
+		if err != nil {
+			writeApiError(w, r, http.StatusBadRequest, err.Error())
+			return
+		}
+	}
+
+	pageError := services.GlobalCallRateLimiter.CheckCallLimit(r, 2)
+	if pageError != nil {
+		handleApiPageError(w, r, pageError)
+		return
+	}
+	pageData, pageError := getFilteredSlashingsPageData(page, limit, filterArgs["min_slot"], filterArgs["max_slot"], filterArgs["min_index"], filterArgs["max_index"], urlArgs.Get("validator_name"), urlArgs.Get("slasher_name"), uint8(filterArgs["with_reason"]), uint8(filterArgs["with_orphaned"]))
+	if pageError != nil {
+		handleApiPageError(w, r, pageError)
+		return
+	}
+
+	slashings := make([]*apitypes.Slashing, 0, len(pageData.Slashings))
+	for _, slashing := range pageData.Slashings {
+		var reason string
+		switch dbtypes.SlashingReason(slashing.Reason) {
+		case dbtypes.ProposerSlashing:
+			reason = "proposer"
+		case dbtypes.AttesterSlashing:
+			reason = "attester"
+		default:
+			reason = "unspecified"
+		}
+		slashings = append(slashings, &apitypes.Slashing{
+			Slot:            slashing.SlotNumber,
+			SlotRoot:        slashing.SlotRoot,
+			Time:            slashing.Time,
+			Orphaned:        slashing.Orphaned,
+			ValidatorIndex:  slashing.ValidatorIndex,
+			ValidatorName:   slashing.ValidatorName,
+			ValidatorStatus: slashing.ValidatorStatus,
+			Balance:         slashing.Balance,
+			Reason:          reason,
+			SlasherIndex:    slashing.SlasherIndex,
+			SlasherName:     slashing.SlasherName,
+		})
+	}
+	writeApiResponse(w, r, slashings, buildApiPagination(page, limit, pageData.TotalPages))
+}
+
+// ApiWithdrawals will return the list of withdrawals included in the beacon chain
+func ApiWithdrawals(w http.ResponseWriter, r *http.Request) {
+	urlArgs := r.URL.Query()
+	page, limit, err := getApiPaging(urlArgs)
+	if err != nil {
+		writeApiError(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+	filterArgs := map[string]uint64{
+		"min_slot":      0,
+		"max_slot":      0,
+		"min_index":     0,
+		"max_index":     0,
+		"min_amount":    0,
+		"max_amount":    0,
+		"with_type":     0,
+		"with_orphaned": 1,
+	}
+	for name, defaultValue := range filterArgs {
+		filterArgs[name], err = getApiUintArg(urlArgs, name, defaultValue)
+		if err != nil {
+			writeApiError(w, r, http.StatusBadRequest, err.Error())
+			return
+		}
+	}
+
+	pageError := services.GlobalCallRateLimiter.CheckCallLimit(r, 2)
+	if pageError != nil {
+		handleApiPageError(w, r, pageError)
+		return
+	}
+	pageData, pageError := getFilteredWithdrawalsPageData(page, limit, filterArgs["min_slot"], filterArgs["max_slot"], filterArgs["min_index"], filterArgs["max_index"], urlArgs.Get("validator_name"), urlArgs.Get("address"), filterArgs["min_amount"], filterArgs["max_amount"], uint8(filterArgs["with_type"]), uint8(filterArgs["with_orphaned"]))
+	if pageError != nil {
+		handleApiPageError(w, r, pageError)
+		return
+	}
+
+	withdrawals := make([]*apitypes.Withdrawal, 0, len(pageData.Withdrawals))
+	for _, withdrawal := range pageData.Withdrawals {
+		var withdrawalType string
+		switch dbtypes.WithdrawalType(withdrawal.Type) {
+		case dbtypes.PartialWithdrawal:
+			withdrawalType = "partial"
+		case dbtypes.FullWithdrawal:
+			withdrawalType = "full"
+		default:
+			withdrawalType = "unspecified"
+		}
+		withdrawals = append(withdrawals, &apitypes.Withdrawal{
+			Slot:            withdrawal.SlotNumber,
+			SlotRoot:        withdrawal.SlotRoot,
+			Time:            withdrawal.Time,
+			Orphaned:        withdrawal.Orphaned,
+			Index:           withdrawal.Index,
+			ValidatorIndex:  withdrawal.ValidatorIndex,
+			ValidatorName:   withdrawal.ValidatorName,
+			ValidatorStatus: withdrawal.ValidatorStatus,
+			Address:         withdrawal.Address,
+			Amount:          withdrawal.Amount,
+			Type:            withdrawalType,
+		})
+	}
+	writeApiResponse(w, r, withdrawals, buildApiPagination(page, limit, pageData.TotalPages))
+}
+
+// ApiBLSChanges will return the list of bls to execution changes included in the beacon chain
+func ApiBLSChanges(w http.ResponseWriter, r *http.Request) {
+	urlArgs := r.URL.Query()
+	page, limit, err := getApiPaging(urlArgs)
+	if err != nil {
+		writeApiError(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+	filterArgs := map[string]uint64{
+		"min_slot":      0,
+		"max_slot":      0,
+		"min_index":     0,
+		"max_index":     0,
+		"with_orphaned": 1,
+	}
+	for name, defaultValue := range filterArgs {
+		filterArgs[name], err = getApiUintArg(urlArgs, name, defaultValue)
+		if err != nil {
+			writeApiError(w, r, http.StatusBadRequest, err.Error())
+			return
+		}
+	}
+
+	pageError := services.GlobalCallRateLimiter.CheckCallLimit(r, 2)
+	if pageError != nil {
+		handleApiPageError(w, r, pageError)
+		return
+	}
+	pageData, pageError := getFilteredBLSChangesPageData(page, limit, filterArgs["min_slot"], filterArgs["max_slot"], filterArgs["min_index"], filterArgs["max_index"], urlArgs.Get("validator_name"), urlArgs.Get("address"), uint8(filterArgs["with_orphaned"]))
+	if pageError != nil {
+		handleApiPageError(w, r, pageError)
+		return
+	}
+
+	blsChanges := make([]*apitypes.BLSChange, 0, len(pageData.BLSChanges))
+	for _, blsChange := range pageData.BLSChanges {
+		blsChanges = append(blsChanges, &apitypes.BLSChange{
+			Slot:            blsChange.SlotNumber,
+			SlotRoot:        blsChange.SlotRoot,
+			Time:            blsChange.Time,
+			Orphaned:        blsChange.Orphaned,
+			ValidatorIndex:  blsChange.ValidatorIndex,
+			ValidatorName:   blsChange.ValidatorName,
+			ValidatorStatus: blsChange.ValidatorStatus,
+			BlsPubkey:       blsChange.BlsPubkey,
+			Address:         blsChange.Address,
+		})
+	}
+	writeApiResponse(w, r, blsChanges, buildApiPagination(page, limit, pageData.TotalPages))
+}
+
+// ApiMevBlocks will return the list of blocks delivered by the configured mev relays
+func ApiMevBlocks(w http.ResponseWriter, r *http.Request) {
+	urlArgs := r.URL.Query()
+	page, limit, err := getApiPaging(urlArgs)
+	if err != nil {
+		writeApiError(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+	filterArgs := map[string]uint64{
+		"min_slot":  0,
+		"max_slot":  0,
+		"min_index": 0,
+		"max_index": 0,
+	}
+	for name, defaultValue := range filterArgs {
+		filterArgs[name], err = getApiUintArg(urlArgs, name, defaultValue)
+		if err != nil {
+			writeApiError(w, r, http.StatusBadRequest, err.Error())
+			return
+		}
+	}
+	// relays & proposed are comma separated lists of relay indexes / proposal states
+	withRelays := strings.ReplaceAll(urlArgs.Get("relays"), ",", " ")
+	withProposed := strings.ReplaceAll(urlArgs.Get("proposed"), ",", " ")
+
+	pageError := services.GlobalCallRateLimiter.CheckCallLimit(r, 2)
+	if pageError != nil {
+		handleApiPageError(w, r, pageError)
+		return
+	}
+	pageData, pageError := getFilteredMevBlocksPageData(page, limit, filterArgs["min_slot"], filterArgs["max_slot"], filterArgs["min_index"], filterArgs["max_index"], urlArgs.Get("validator_name"), withRelays, withProposed)
+	if pageError != nil {
+		handleApiPageError(w, r, pageError)
+		return
+	}
+
+	mevBlocks := make([]*apitypes.MevBlock, 0, len(pageData.MevBlocks))
+	for _, mevBlock := range pageData.MevBlocks {
+		relays := make([]string, 0, len(mevBlock.Relays))
+		for _, relay := range mevBlock.Relays {
+			relays = append(relays, relay.Name)
+		}
+		mevBlocks = append(mevBlocks, &apitypes.MevBlock{
+			Slot:           mevBlock.SlotNumber,
+			BlockHash:      mevBlock.BlockHash,
+			BlockNumber:    mevBlock.BlockNumber,
+			Time:           mevBlock.Time,
+			ValidatorIndex: mevBlock.ValidatorIndex,
+			ValidatorName:  mevBlock.ValidatorName,
+			BuilderPubkey:  mevBlock.BuilderPubkey,
+			Proposed:       getApiMevBlockProposed(mevBlock.Proposed),
+			Relays:         relays,
+			FeeRecipient:   mevBlock.FeeRecipient,
+			TxCount:        mevBlock.TxCount,
+			GasUsed:        mevBlock.GasUsed,
+			BlockValue:     mevBlock.BlockValue,
+		})
+	}
+	writeApiResponse(w, r, mevBlocks, buildApiPagination(page, limit, pageData.TotalPages))
+}
+
+func getApiMevBlockProposed(proposed uint8) string {
+	switch proposed {
+	case 0:
+		return "missed"
+	case 1:
+		return "proposed"
+	case 2:
+		return "orphaned"
+	default:
+		return "unknown"
+	}
+}
@@ -0,0 +1,194 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/ethpandaops/dora/dbtypes"
+	"github.com/ethpandaops/dora/services"
+	"github.com/ethpandaops/dora/types/apitypes"
+	"github.com/ethpandaops/dora/utils"
+)
+
+// ApiDepositRequests will return the list of execution layer deposit requests included in the beacon chain
+func ApiDepositRequests(w http.ResponseWriter, r *http.Request) {
+	urlArgs := r.URL.Query()
+	page, limit, err := getApiPaging(urlArgs)
+	if err != nil {
+		writeApiError(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+	filterArgs := map[string]uint64{
+		"min_slot":      0,
+		"max_slot":      0,
+		"min_amount":    0,
+		"max_amount":    0,
+		"with_orphaned": 1,
+	}
+	for name, defaultValue := range filterArgs {
+		filterArgs[name], err = getApiUintArg(urlArgs, name, defaultValue)
+		if err != nil {
+			writeApiError(w, r, http.StatusBadRequest, err.Error())
+			return
+		}
+	}
+
+	pageError := services.GlobalCallRateLimiter.CheckCallLimit(r, 2)
+	if pageError != nil {
+		handleApiPageError(w, r, pageError)
+		return
+	}
+
+	requestFilter := &dbtypes.DepositRequestFilter{
+		MinSlot:      filterArgs["min_slot"],
+		MaxSlot:      filterArgs["max_slot"],
+		PublicKey:    common.FromHex(urlArgs.Get("pubkey")),
+		MinAmount:    filterArgs["min_amount"],
+		MaxAmount:    filterArgs["max_amount"],
+		WithOrphaned: uint8(filterArgs["with_orphaned"]),
+	}
+	dbRequests, totalRows := services.GlobalBeaconService.GetDepositRequestsByFilter(requestFilter, page-1, uint32(limit))
+
+	requests := make([]*apitypes.DepositRequest, 0, len(dbRequests))
+	for _, request := range dbRequests {
+		requests = append(requests, &apitypes.DepositRequest{
+			Slot:                  request.SlotNumber,
+			SlotRoot:              request.SlotRoot,
+			Time:                  utils.SlotToTime(request.SlotNumber),
+			Orphaned:              request.Orphaned,
+			Index:                 request.DepositIndex,
+			PublicKey:             request.PublicKey,
+			WithdrawalCredentials: request.WithdrawalCredentials,
+			Amount:                request.Amount,
+			Signature:             request.Signature,
+		})
+	}
+	writeApiResponse(w, r, requests, buildApiPagination(page, limit, getApiTotalPages(totalRows, limit)))
+}
+
+// ApiWithdrawalRequests will return the list of execution layer withdrawal requests included in the beacon chain
+func ApiWithdrawalRequests(w http.ResponseWriter, r *http.Request) {
+	urlArgs := r.URL.Query()
+	page, limit, err := getApiPaging(urlArgs)
+	if err != nil {
+		writeApiError(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+	filterArgs := map[string]uint64{
+		"min_slot":      0,
+		"max_slot":      0,
+		"min_amount":    0,
+		"max_amount":    0,
+		"with_orphaned": 1,
+	}
+	for name, defaultValue := range filterArgs {
+		filterArgs[name], err = getApiUintArg(urlArgs, name, defaultValue)
+		if err != nil {
+			writeApiError(w, r, http.StatusBadRequest, err.Error())
+			return
+		}
+	}
+
+	pageError := services.GlobalCallRateLimiter.CheckCallLimit(r, 2)
+	if pageError != nil {
+		handleApiPageError(w, r, pageError)
+		return
+	}
+
+	requestFilter := &dbtypes.WithdrawalRequestFilter{
+		MinSlot:       filterArgs["min_slot"],
+		MaxSlot:       filterArgs["max_slot"],
+		SourceAddress: common.FromHex(urlArgs.Get("address")),
+		PublicKey:     common.FromHex(urlArgs.Get("pubkey")),
+		MinAmount:     filterArgs["min_amount"],
+		MaxAmount:     filterArgs["max_amount"],
+		WithOrphaned:  uint8(filterArgs["with_orphaned"]),
+	}
+	dbRequests, totalRows := services.GlobalBeaconService.GetWithdrawalRequestsByFilter(requestFilter, page-1, uint32(limit))
+	validatorSetRsp := services.GlobalBeaconService.GetCachedValidatorPubkeyMap()
+
+	requests := make([]*apitypes.WithdrawalRequest, 0, len(dbRequests))
+	for _, request := range dbRequests {
+		requestData := &apitypes.WithdrawalRequest{
+			Slot:            request.SlotNumber,
+			SlotRoot:        request.SlotRoot,
+			Time:            utils.SlotToTime(request.SlotNumber),
+			Orphaned:        request.Orphaned,
+			SourceAddress:   request.SourceAddress,
+			ValidatorPubkey: request.ValidatorPubkey,
+			Amount:          request.Amount,
+		}
+		if validator := validatorSetRsp[phase0.BLSPubKey(request.ValidatorPubkey)]; validator != nil {
+			validatorIndex := uint64(validator.Index)
+			requestData.ValidatorIndex = &validatorIndex
+			requestData.ValidatorName = services.GlobalBeaconService.GetValidatorName(validatorIndex)
+		}
+		requests = append(requests, requestData)
+	}
+	writeApiResponse(w, r, requests, buildApiPagination(page, limit, getApiTotalPages(totalRows, limit)))
+}
+
+// ApiConsolidationRequests will return the list of execution layer consolidation requests included in the beacon chain
+func ApiConsolidationRequests(w http.ResponseWriter, r *http.Request) {
+	urlArgs := r.URL.Query()
+	page, limit, err := getApiPaging(urlArgs)
+	if err != nil {
+		writeApiError(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+	filterArgs := map[string]uint64{
+		"min_slot":      0,
+		"max_slot":      0,
+		"with_orphaned": 1,
+	}
+	for name, defaultValue := range filterArgs {
+		filterArgs[name], err = getApiUintArg(urlArgs, name, defaultValue)
+		if err != nil {
+			writeApiError(w, r, http.StatusBadRequest, err.Error())
+			return
+		}
+	}
+
+	pageError := services.GlobalCallRateLimiter.CheckCallLimit(r, 2)
+	if pageError != nil {
+		handleApiPageError(w, r, pageError)
+		return
+	}
+
+	requestFilter := &dbtypes.ConsolidationRequestFilter{
+		MinSlot:       filterArgs["min_slot"],
+		MaxSlot:       filterArgs["max_slot"],
+		SourceAddress: common.FromHex(urlArgs.Get("address")),
+		PublicKey:     common.FromHex(urlArgs.Get("pubkey")),
+		WithOrphaned:  uint8(filterArgs["with_orphaned"]),
+	}
+	dbRequests, totalRows := services.GlobalBeaconService.GetConsolidationRequestsByFilter(requestFilter, page-1, uint32(limit))
+	validatorSetRsp := services.GlobalBeaconService.GetCachedValidatorPubkeyMap()
+
+	requests := make([]*apitypes.ConsolidationRequest, 0, len(dbRequests))
+	for _, request := range dbRequests {
+		requestData := &apitypes.ConsolidationRequest{
+			Slot:          request.SlotNumber,
+			SlotRoot:      request.SlotRoot,
+			Time:          utils.SlotToTime(request.SlotNumber),
+			Orphaned:      request.Orphaned,
+			SourceAddress: request.SourceAddress,
+			SourcePubkey:  request.SourcePubkey,
+			TargetPubkey:  request.TargetPubkey,
+		}
+		if validator := validatorSetRsp[phase0.BLSPubKey(request.SourcePubkey)]; validator != nil {
+			sourceIndex := uint64(validator.Index)
+			requestData.SourceIndex = &sourceIndex
+			requestData.SourceName = services.GlobalBeaconService.GetValidatorName(sourceIndex)
+		}
+		if validator := validatorSetRsp[phase0.BLSPubKey(request.TargetPubkey)]; validator != nil {
+			targetIndex := uint64(validator.Index)
+			requestData.TargetIndex = &targetIndex
+			requestData.TargetName = services.GlobalBeaconService.GetValidatorName(targetIndex)
+		}
+		requests = append(requests, requestData)
+	}
+	writeApiResponse(w, r, requests, buildApiPagination(page, limit, getApiTotalPages(totalRows, limit)))
+}
@@ -0,0 +1,364 @@
+package handlers
+
+import (
+	"encoding/hex"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"github.com/ethpandaops/dora/services"
+	"github.com/ethpandaops/dora/types"
+	"github.com/ethpandaops/dora/types/apitypes"
+	"github.com/ethpandaops/dora/types/models"
+	"github.com/ethpandaops/dora/utils"
+)
+
+// ApiSlots will return the list of most recent slots
+func ApiSlots(w http.ResponseWriter, r *http.Request) {
+	page, limit, err := getApiPaging(r.URL.Query())
+	if err != nil {
+		writeApiError(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	firstSlot := uint64(math.MaxUint64)
+	if page > 1 {
+		currentSlot := utils.TimeToSlot(uint64(time.Now().Unix()))
+		currentEpoch := utils.EpochOfSlot(currentSlot)
+		maxSlot := currentSlot + 8
+		if maxSlot >= (currentEpoch+1)*utils.Config.Chain.Config.SlotsPerEpoch {
+			maxSlot = ((currentEpoch + 1) * utils.Config.Chain.Config.SlotsPerEpoch) - 1
+		}
+		skipSlots := (page - 1) * limit
+		if skipSlots > maxSlot {
+			writeApiResponse(w, r, []*apitypes.Slot{}, buildApiPagination(page, limit, 0))
+			return
+		}
+		firstSlot = maxSlot - skipSlots
+	}
+
+	pageError := services.GlobalCallRateLimiter.CheckCallLimit(r, 1)
+	if pageError != nil {
+		handleApiPageError(w, r, pageError)
+		return
+	}
+	pageData, pageError := getSlotsPageData(firstSlot, limit)
+	if pageError != nil {
+		handleApiPageError(w, r, pageError)
+		return
+	}
+
+	slots := make([]*apitypes.Slot, 0, len(pageData.Slots))
+	for _, slot := range pageData.Slots {
+		slots = append(slots, &apitypes.Slot{
+			Slot:                  slot.Slot,
+			Epoch:                 slot.Epoch,
+			Time:                  slot.Ts,
+			Finalized:             slot.Finalized,
+			Scheduled:             slot.Scheduled,
+			Status:                getApiSlotStatus(slot.Status, slot.Scheduled),
+			Proposer:              slot.Proposer,
+			ProposerName:          slot.ProposerName,
+			AttestationCount:      slot.AttestationCount,
+			DepositCount:          slot.DepositCount,
+			ExitCount:             slot.ExitCount,
+			ProposerSlashingCount: slot.ProposerSlashingCount,
+			AttesterSlashingCount: slot.AttesterSlashingCount,
+			SyncParticipation:     slot.SyncParticipation,
+			EthTransactionCount:   slot.EthTransactionCount,
+			EthBlockNumber:        getApiUint64Ptr(slot.EthBlockNumber, slot.WithEthBlock),
+			Graffiti:              slot.Graffiti,
+			BlockRoot:             slot.BlockRoot,
+			ParentRoot:            slot.ParentRoot,
+		})
+	}
+	writeApiResponse(w, r, slots, buildApiPagination(page, limit, pageData.TotalPages))
+}
+
+// ApiSlotsFiltered will return the list of slots matching the given filters
+func ApiSlotsFiltered(w http.ResponseWriter, r *http.Request) {
+	urlArgs := r.URL.Query()
+	page, limit, err := getApiPaging(urlArgs)
+	if err != nil {
+		writeApiError(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+	withOrphaned, err := getApiUintArg(urlArgs, "with_orphaned", 1)
+	if err != nil {
+		writeApiError(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+	withMissing, err := getApiUintArg(urlArgs, "with_missing", 1)
+	if err != nil {
+		writeApiError(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+	proposer := urlArgs.Get("proposer")
+	if proposer != "" {
+		if _, err := strconv.ParseUint(proposer, 10, 64); err != nil {
+			writeApiError(w, r, http.StatusBadRequest, "invalid proposer parameter: "+proposer)
+			return
+		}
+	}
+
+	feeRecipient := urlArgs.Get("fee_recipient")
+	if feeRecipient != "" {
+		if addr, err := hex.DecodeString(strings.TrimPrefix(feeRecipient, "0x")); err != nil || len(addr) != 20 {
+			writeApiError(w, r, http.StatusBadRequest, "invalid fee_recipient parameter: "+feeRecipient)
+			return
+		}
+	}
+
+	pageError := services.GlobalCallRateLimiter.CheckCallLimit(r, 2)
+	if pageError != nil {
+		handleApiPageError(w, r, pageError)
+		return
+	}
+	pageData, pageError := getFilteredSlotsPageData(page-1, limit, urlArgs.Get("graffiti"), urlArgs.Get("extra_data"), feeRecipient, proposer, urlArgs.Get("proposer_name"), uint8(withOrphaned), uint8(withMissing), "")
+	if pageError != nil {
+		handleApiPageError(w, r, pageError)
+		return
+	}
+
+	slots := make([]*apitypes.Slot, 0, len(pageData.Slots))
+	for _, slot := range pageData.Slots {
+		slots = append(slots, &apitypes.Slot{
+			Slot:                  slot.Slot,
+			Epoch:                 slot.Epoch,
+			Time:                  slot.Ts,
+			Finalized:             slot.Finalized,
+			Scheduled:             slot.Scheduled,
+			Status:                getApiSlotStatus(slot.Status, slot.Scheduled),
+			Proposer:              slot.Proposer,
+			ProposerName:          slot.ProposerName,
+			AttestationCount:      slot.AttestationCount,
+			DepositCount:          slot.DepositCount,
+			ExitCount:             slot.ExitCount,
+			ProposerSlashingCount: slot.ProposerSlashingCount,
+			AttesterSlashingCount: slot.AttesterSlashingCount,
+			SyncParticipation:     slot.SyncParticipation,
+			EthTransactionCount:   slot.EthTransactionCount,
+			EthBlockNumber:        getApiUint64Ptr(slot.EthBlockNumber, slot.WithEthBlock),
+			Graffiti:              slot.Graffiti,
+			ElExtraData:           slot.ElExtraData,
+			BlockRoot:             slot.BlockRoot,
+			ParentRoot:            slot.ParentRoot,
+		})
+	}
+	writeApiResponse(w, r, slots, buildApiPagination(page, limit, pageData.TotalPages))
+}
+
+// ApiSlot will return the details of a single slot by slot number or block root
+func ApiSlot(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	slotOrHash := strings.Replace(vars["slotOrHash"], "0x", "", -1)
+	blockSlot := int64(-1)
+	blockRootHash, err := hex.DecodeString(slotOrHash)
+	if err != nil || len(slotOrHash) != 64 {
+		blockRootHash = []byte{}
+		blockSlot, err = strconv.ParseInt(vars["slotOrHash"], 10, 64)
+		if err != nil || blockSlot < 0 || blockSlot >= 2147483648 { // block slot must be lower then max int4
+			writeApiError(w, r, http.StatusBadRequest, "invalid slot number or block root")
+			return
+		}
+	}
+
+	pageError := services.GlobalCallRateLimiter.CheckCallLimit(r, 1)
+	if pageError != nil {
+		handleApiPageError(w, r, pageError)
+		return
+	}
+	pageData, pageError := getSlotPageData(blockSlot, blockRootHash, r.URL.Query().Has("duties"))
+	if pageError != nil {
+		handleApiPageError(w, r, pageError)
+		return
+	}
+	if pageData == nil {
+		writeApiError(w, r, http.StatusNotFound, "slot not found")
+		return
+	}
+
+	slotData := &apitypes.SlotDetails{
+		Slot:           pageData.Slot,
+		Epoch:          pageData.Epoch,
+		EpochFinalized: pageData.EpochFinalized,
+		Time:           pageData.Ts,
+		Status:         getApiSlotStatus(uint8(pageData.Status), pageData.Future),
+		Proposer:       pageData.Proposer,
+		ProposerName:   pageData.ProposerName,
+	}
+	if pageData.Block != nil {
+		slotData.Block = buildApiSlotBlock(pageData.Block)
+	}
+	writeApiResponse(w, r, slotData, nil)
+}
+
+func buildApiSlotBlock(blockData *models.SlotPageBlockData) *apitypes.SlotBlock {
+	block := &apitypes.SlotBlock{
+		BlockRoot:              blockData.BlockRoot,
+		ParentRoot:             blockData.ParentRoot,
+		StateRoot:              blockData.StateRoot,
+		Signature:              blockData.Signature,
+		RandaoReveal:           blockData.RandaoReveal,
+		Graffiti:               blockData.Graffiti,
+		Eth1DataDepositRoot:    blockData.Eth1dataDepositroot,
+		Eth1DataDepositCount:   blockData.Eth1dataDepositcount,
+		Eth1DataBlockHash:      blockData.Eth1dataBlockhash,
+		SyncAggregateBits:      blockData.SyncAggregateBits,
+		SyncAggregateSignature: blockData.SyncAggregateSignature,
+		SyncParticipation:      blockData.SyncAggParticipation,
+		Attestations:           make([]*apitypes.SlotAttestation, 0, len(blockData.Attestations)),
+		Deposits:               make([]*apitypes.SlotDeposit, 0, len(blockData.Deposits)),
+		VoluntaryExits:         make([]*apitypes.SlotVoluntaryExit, 0, len(blockData.VoluntaryExits)),
+		ProposerSlashings:      make([]*apitypes.SlotProposerSlashing, 0, len(blockData.ProposerSlashings)),
+		AttesterSlashings:      make([]*apitypes.SlotAttesterSlashing, 0, len(blockData.AttesterSlashings)),
+		BLSChanges:             make([]*apitypes.SlotBLSChange, 0, len(blockData.BLSChanges)),
+		Withdrawals:            make([]*apitypes.SlotWithdrawal, 0, len(blockData.Withdrawals)),
+		Blobs:                  make([]*apitypes.SlotBlob, 0, len(blockData.Blobs)),
+		Transactions:           make([]*apitypes.SlotTransaction, 0, len(blockData.Transactions)),
+	}
+
+	if executionData := blockData.ExecutionData; executionData != nil {
+		block.ExecutionPayload = &apitypes.SlotExecutionPayload{
+			BlockNumber:   executionData.BlockNumber,
+			BlockHash:     executionData.BlockHash,
+			ParentHash:    executionData.ParentHash,
+			FeeRecipient:  executionData.FeeRecipient,
+			StateRoot:     executionData.StateRoot,
+			ReceiptsRoot:  executionData.ReceiptsRoot,
+			LogsBloom:     executionData.LogsBloom,
+			Random:        executionData.Random,
+			GasLimit:      executionData.GasLimit,
+			GasUsed:       executionData.GasUsed,
+			Timestamp:     executionData.Timestamp,
+			ExtraData:     executionData.ExtraData,
+			BaseFeePerGas: executionData.BaseFeePerGas,
+		}
+	}
+
+	for _, attestation := range blockData.Attestations {
+		block.Attestations = append(block.Attestations, &apitypes.SlotAttestation{
+			Slot:            attestation.Slot,
+			CommitteeIndex:  attestation.CommitteeIndex,
+			AggregationBits: attestation.AggregationBits,
+			Validators:      getApiValidatorIndices(attestation.Validators),
+			Signature:       attestation.Signature,
+			BeaconBlockRoot: attestation.BeaconBlockRoot,
+			SourceEpoch:     attestation.SourceEpoch,
+			SourceRoot:      attestation.SourceRoot,
+			TargetEpoch:     attestation.TargetEpoch,
+			TargetRoot:      attestation.TargetRoot,
+		})
+	}
+	for _, deposit := range blockData.Deposits {
+		block.Deposits = append(block.Deposits, &apitypes.SlotDeposit{
+			PublicKey:             deposit.PublicKey,
+			WithdrawalCredentials: deposit.Withdrawalcredentials,
+			Amount:                deposit.Amount,
+			Signature:             deposit.Signature,
+		})
+	}
+	for _, exit := range blockData.VoluntaryExits {
+		block.VoluntaryExits = append(block.VoluntaryExits, &apitypes.SlotVoluntaryExit{
+			ValidatorIndex: exit.ValidatorIndex,
+			ValidatorName:  exit.ValidatorName,
+			Epoch:          exit.Epoch,
+			Signature:      exit.Signature,
+		})
+	}
+	for _, slashing := range blockData.ProposerSlashings {
+		block.ProposerSlashings = append(block.ProposerSlashings, &apitypes.SlotProposerSlashing{
+			ProposerIndex: slashing.ProposerIndex,
+			ProposerName:  slashing.ProposerName,
+			Header1Slot:   slashing.Header1Slot,
+			Header2Slot:   slashing.Header2Slot,
+		})
+	}
+	for _, slashing := range blockData.AttesterSlashings {
+		block.AttesterSlashings = append(block.AttesterSlashings, &apitypes.SlotAttesterSlashing{
+			Attestation1Indices: slashing.Attestation1Indices,
+			Attestation2Indices: slashing.Attestation2Indices,
+			SlashedValidators:   getApiValidatorIndices(slashing.SlashedValidators),
+		})
+	}
+	for _, blsChange := range blockData.BLSChanges {
+		block.BLSChanges = append(block.BLSChanges, &apitypes.SlotBLSChange{
+			ValidatorIndex: blsChange.ValidatorIndex,
+			ValidatorName:  blsChange.ValidatorName,
+			BlsPubkey:      blsChange.BlsPubkey,
+			Address:        blsChange.Address,
+			Signature:      blsChange.Signature,
+		})
+	}
+	for _, withdrawal := range blockData.Withdrawals {
+		block.Withdrawals = append(block.Withdrawals, &apitypes.SlotWithdrawal{
+			Index:          withdrawal.Index,
+			ValidatorIndex: withdrawal.ValidatorIndex,
+			ValidatorName:  withdrawal.ValidatorName,
+			Address:        withdrawal.Address,
+			Amount:         withdrawal.Amount,
+		})
+	}
+	for _, blob := range blockData.Blobs {
+		block.Blobs = append(block.Blobs, &apitypes.SlotBlob{
+			Index:         blob.Index,
+			KzgCommitment: blob.KzgCommitment,
+		})
+	}
+	for _, depositRequest := range blockData.DepositRequests {
+		block.DepositRequests = append(block.DepositRequests, &apitypes.SlotDepositRequest{
+			Index:                 depositRequest.Index,
+			PublicKey:             depositRequest.PublicKey,
+			WithdrawalCredentials: depositRequest.Withdrawalcredentials,
+			Amount:                depositRequest.Amount,
+			Signature:             depositRequest.Signature,
+		})
+	}
+	for _, withdrawalRequest := range blockData.WithdrawalRequests {
+		block.WithdrawalRequests = append(block.WithdrawalRequests, &apitypes.SlotWithdrawalRequest{
+			SourceAddress:   withdrawalRequest.SourceAddress,
+			ValidatorPubkey: withdrawalRequest.ValidatorPubkey,
+			ValidatorIndex:  getApiUint64Ptr(withdrawalRequest.ValidatorIndex, withdrawalRequest.ValidatorValid),
+			ValidatorName:   withdrawalRequest.ValidatorName,
+			Amount:          withdrawalRequest.Amount,
+		})
+	}
+	for _, consolidationRequest := range blockData.ConsolidationRequests {
+		block.ConsolidationRequests = append(block.ConsolidationRequests, &apitypes.SlotConsolidationRequest{
+			SourceAddress: consolidationRequest.SourceAddress,
+			SourcePubkey:  consolidationRequest.SourcePubkey,
+			SourceIndex:   getApiUint64Ptr(consolidationRequest.SourceIndex, consolidationRequest.SourceValid),
+			SourceName:    consolidationRequest.SourceName,
+			TargetPubkey:  consolidationRequest.TargetPubkey,
+			TargetIndex:   getApiUint64Ptr(consolidationRequest.TargetIndex, consolidationRequest.TargetValid),
+			TargetName:    consolidationRequest.TargetName,
+		})
+	}
+	for _, tx := range blockData.Transactions {
+		block.Transactions = append(block.Transactions, &apitypes.SlotTransaction{
+			Index:    tx.Index,
+			Hash:     tx.Hash,
+			From:     tx.From,
+			To:       tx.To,
+			Value:    tx.Value,
+			Type:     tx.Type,
+			DataLen:  tx.DataLen,
+			FuncSig:  tx.FuncSig,
+			FuncName: tx.FuncName,
+		})
+	}
+
+	return block
+}
+
+func getApiValidatorIndices(validators []types.NamedValidator) []uint64 {
+	indices := make([]uint64, len(validators))
+	for idx, validator := range validators {
+		indices[idx] = validator.Index
+	}
+	return indices
+}
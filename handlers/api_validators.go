@@ -0,0 +1,176 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/gorilla/mux"
+
+	"github.com/ethpandaops/dora/services"
+	"github.com/ethpandaops/dora/types/apitypes"
+	"github.com/ethpandaops/dora/utils"
+)
+
+const apiMaxAttestationHistory = 1000
+
+// ApiValidator will return the details of a single validator by index or public key
+func ApiValidator(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	validator := getValidatorByIndexOrPubKey(vars["idxOrPubKey"])
+	if validator == nil {
+		writeApiError(w, r, http.StatusNotFound, "validator not found")
+		return
+	}
+
+	pageError := services.GlobalCallRateLimiter.CheckCallLimit(r, 1)
+	if pageError != nil {
+		handleApiPageError(w, r, pageError)
+		return
+	}
+	pageData, pageError := getValidatorPageData(uint64(validator.Index))
+	if pageError != nil {
+		handleApiPageError(w, r, pageError)
+		return
+	}
+
+	validatorData := &apitypes.Validator{
+		Index:                 pageData.Index,
+		Name:                  pageData.Name,
+		PublicKey:             pageData.PublicKey,
+		Balance:               pageData.Balance,
+		EffectiveBalance:      pageData.EffectiveBalance,
+		State:                 pageData.State,
+		BeaconState:           pageData.BeaconState,
+		EligibilityEpoch:      getApiUint64Ptr(pageData.EligibleEpoch, pageData.ShowEligible),
+		ActivationEpoch:       getApiUint64Ptr(pageData.ActivationEpoch, pageData.ShowActivation),
+		ExitEpoch:             getApiUint64Ptr(pageData.ExitEpoch, pageData.ShowExit),
+		WithdrawalCredentials: pageData.WithdrawCredentials,
+		UpcheckActivity:       pageData.UpcheckActivity,
+		UpcheckMaximum:        pageData.UpcheckMaximum,
+		RecentBlocks:          make([]*apitypes.ValidatorBlock, 0, len(pageData.RecentBlocks)),
+	}
+	if pageData.ShowWithdrawAddress {
+		validatorData.WithdrawalAddress = pageData.WithdrawAddress
+	}
+	for _, block := range pageData.RecentBlocks {
+		validatorData.RecentBlocks = append(validatorData.RecentBlocks, &apitypes.ValidatorBlock{
+			Slot:           block.Slot,
+			Epoch:          block.Epoch,
+			Time:           block.Ts,
+			Status:         getApiSlotStatus(uint8(block.Status), false),
+			EthBlockNumber: getApiUint64Ptr(block.EthBlock, block.WithEthBlock),
+			BlockRoot:      block.BlockRoot,
+			Graffiti:       block.Graffiti,
+		})
+	}
+	writeApiResponse(w, r, validatorData, nil)
+}
+
+// ApiValidatorAttestations will return the attestation history of a single validator by index or public key
+func ApiValidatorAttestations(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	validator := getValidatorByIndexOrPubKey(vars["idxOrPubKey"])
+	if validator == nil {
+		writeApiError(w, r, http.StatusNotFound, "validator not found")
+		return
+	}
+
+	limit, err := getApiUintArg(r.URL.Query(), "limit", 100)
+	if err != nil {
+		writeApiError(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+	if limit < 1 || limit > apiMaxAttestationHistory {
+		writeApiError(w, r, http.StatusBadRequest, fmt.Sprintf("invalid limit parameter: must be between 1 and %v", apiMaxAttestationHistory))
+		return
+	}
+
+	pageError := services.GlobalCallRateLimiter.CheckCallLimit(r, 2)
+	if pageError != nil {
+		handleApiPageError(w, r, pageError)
+		return
+	}
+
+	currentEpoch := utils.TimeToEpoch(time.Now())
+	attestations := services.GlobalBeaconService.GetValidatorAttestations(uint64(validator.Index), uint32(limit))
+	result := make([]*apitypes.ValidatorAttestation, 0, len(attestations))
+	for _, attestation := range attestations {
+		outcome := attestation.GetOutcome()
+		if !outcome.HasDuty() {
+			continue
+		}
+		attestationData := &apitypes.ValidatorAttestation{
+			Epoch: attestation.Epoch,
+			Time:  utils.EpochToTime(attestation.Epoch),
+		}
+		if outcome.IsIncluded() {
+			attestationData.Status = "included"
+			attestationData.SourceCorrect = true
+			attestationData.TargetCorrect = outcome.IsTargetCorrect()
+			attestationData.HeadCorrect = outcome.IsHeadCorrect()
+			attestationData.InclusionDistance = getApiUint64Ptr(uint64(outcome.InclusionDistance()), true)
+		} else if int64(attestation.Epoch) >= currentEpoch-1 {
+			attestationData.Status = "pending"
+		} else {
+			attestationData.Status = "missed"
+		}
+		result = append(result, attestationData)
+	}
+	writeApiResponse(w, r, result, nil)
+}
+
+// ApiValidatorQueue will return the activation / exit queue position and estimate of a validator
+func ApiValidatorQueue(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	validator := getValidatorByIndexOrPubKey(vars["idxOrPubKey"])
+	if validator == nil {
+		writeApiError(w, r, http.StatusNotFound, "validator not found")
+		return
+	}
+
+	pageError := services.GlobalCallRateLimiter.CheckCallLimit(r, 1)
+	if pageError != nil {
+		handleApiPageError(w, r, pageError)
+		return
+	}
+
+	queues := services.GlobalBeaconService.GetValidatorQueues()
+	if queues == nil {
+		writeApiError(w, r, http.StatusServiceUnavailable, "validator set not loaded yet")
+		return
+	}
+
+	result := &apitypes.ValidatorQueueEstimate{
+		Index:        uint64(validator.Index),
+		PublicKey:    validator.Validator.PublicKey[:],
+		Epoch:        queues.Epoch,
+		Queue:        "none",
+		BalanceChurn: queues.BalanceChurn,
+	}
+	entry, isActivation := queues.GetValidatorQueueEntry(validator.Index)
+	if entry != nil {
+		estimatedTime := utils.EpochToTime(entry.EstimatedEpoch)
+		result.Position = &entry.Position
+		result.Scheduled = entry.Scheduled
+		result.EstimatedEpoch = &entry.EstimatedEpoch
+		result.EstimatedTime = &estimatedTime
+		if isActivation {
+			result.Queue = "activation"
+			result.QueueLength = uint64(len(queues.ActivationQueue))
+			result.Churn = queues.ActivationChurn
+		} else {
+			result.Queue = "exit"
+			result.QueueLength = uint64(len(queues.ExitQueue))
+			result.Churn = queues.ExitChurn
+		}
+	} else if validator.Validator.ActivationEpoch <= phase0.Epoch(queues.Epoch) && validator.Validator.ExitEpoch > phase0.Epoch(queues.Epoch) {
+		nextExitTime := utils.EpochToTime(queues.NextExitEpoch)
+		result.QueueLength = uint64(len(queues.ExitQueue))
+		result.Churn = queues.ExitChurn
+		result.NextExitEpoch = &queues.NextExitEpoch
+		result.NextExitTime = &nextExitTime
+	}
+	writeApiResponse(w, r, result, nil)
+}
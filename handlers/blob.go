@@ -0,0 +1,171 @@
+package handlers
+
+import (
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
+	"github.com/gorilla/mux"
+	"github.com/sirupsen/logrus"
+
+	"github.com/ethpandaops/dora/blobstore"
+	"github.com/ethpandaops/dora/db"
+	"github.com/ethpandaops/dora/services"
+	"github.com/ethpandaops/dora/templates"
+	"github.com/ethpandaops/dora/types/models"
+	"github.com/ethpandaops/dora/utils"
+)
+
+const blobPagePreviewSize = 512
+
+// Blob will return the "blob" page using a go template
+func Blob(w http.ResponseWriter, r *http.Request) {
+	var blobTemplateFiles = append(layoutTemplateFiles,
+		"blob/blob.html",
+	)
+	var notfoundTemplateFiles = append(layoutTemplateFiles,
+		"blob/notfound.html",
+	)
+
+	vars := mux.Vars(r)
+	hashStr := strings.Replace(vars["hash"], "0x", "", -1)
+	blobHash, err := hex.DecodeString(hashStr)
+	if err != nil || (len(blobHash) != 32 && len(blobHash) != 48) {
+		data := InitPageData(w, r, "blockchain", "/blob", "Blob not found", notfoundTemplateFiles)
+		w.Header().Set("Content-Type", "text/html")
+		if handleTemplateError(w, r, "blob.go", "Blob", "invalidHash", templates.GetTemplate(notfoundTemplateFiles...).ExecuteTemplate(w, "layout", data)) != nil {
+			return // an error has occurred and was processed
+		}
+		return
+	}
+
+	var pageData *models.BlobPageData
+	var pageError error
+	pageError = services.GlobalCallRateLimiter.CheckCallLimit(r, 2)
+	if pageError == nil {
+		pageData, pageError = getBlobPageData(blobHash)
+	}
+	if pageError != nil {
+		handlePageError(w, r, pageError)
+		return
+	}
+	if pageData == nil {
+		data := InitPageData(w, r, "blockchain", "/blob", "Blob not found", notfoundTemplateFiles)
+		w.Header().Set("Content-Type", "text/html")
+		if handleTemplateError(w, r, "blob.go", "Blob", "notFound", templates.GetTemplate(notfoundTemplateFiles...).ExecuteTemplate(w, "layout", data)) != nil {
+			return // an error has occurred and was processed
+		}
+		return
+	}
+
+	data := InitPageData(w, r, "blockchain", "/blob", fmt.Sprintf("Blob 0x%x", pageData.VersionedHash), blobTemplateFiles)
+	data.Data = pageData
+	w.Header().Set("Content-Type", "text/html")
+	if handleTemplateError(w, r, "blob.go", "Blob", "", templates.GetTemplate(blobTemplateFiles...).ExecuteTemplate(w, "layout", data)) != nil {
+		return // an error has occurred and was processed
+	}
+}
+
+func getBlobPageData(blobHash []byte) (*models.BlobPageData, error) {
+	pageData := &models.BlobPageData{}
+	pageCacheKey := fmt.Sprintf("blob:%x", blobHash)
+	pageRes, pageErr := services.GlobalFrontendCache.ProcessCachedPage(pageCacheKey, true, pageData, func(pageCall *services.FrontendCacheProcessingPage) interface{} {
+		pageData, cacheTimeout := buildBlobPageData(blobHash)
+		pageCall.CacheTimeout = cacheTimeout
+		return pageData
+	})
+	if pageErr == nil && pageRes != nil {
+		resData, resOk := pageRes.(*models.BlobPageData)
+		if !resOk {
+			return nil, ErrInvalidPageModel
+		}
+		pageData = resData
+	}
+	return pageData, pageErr
+}
+
+// buildBlobPageData resolves a versioned hash or kzg commitment and verifies the blob against its kzg proof.
+func buildBlobPageData(blobHash []byte) (*models.BlobPageData, time.Duration) {
+	logrus.Debugf("blob page called: 0x%x", blobHash)
+	chainConfig := utils.Config.Chain.Config
+
+	commitment := blobHash
+	if len(blobHash) == 32 {
+		commitment = db.GetBlobCommitmentByVersionedHash(blobHash)
+	}
+	if commitment == nil {
+		return nil, time.Duration(chainConfig.SecondsPerSlot) * time.Second
+	}
+	assignments := db.GetBlobAssignments(commitment)
+	if len(assignments) == 0 {
+		return nil, time.Duration(chainConfig.SecondsPerSlot) * time.Second
+	}
+
+	pageData := &models.BlobPageData{
+		Commitment:    commitment,
+		VersionedHash: blobstore.GetBlobVersionedHash(commitment),
+		Assignments:   make([]*models.BlobPageDataAssignment, 0, len(assignments)),
+	}
+	for _, assignment := range assignments {
+		pageData.Assignments = append(pageData.Assignments, &models.BlobPageDataAssignment{
+			Slot:      assignment.Slot,
+			BlockRoot: assignment.Root,
+			Time:      utils.SlotToTime(assignment.Slot),
+			BlobIndex: assignment.BlobIndex,
+		})
+		if pageData.TxHash == nil && assignment.TxHash != nil {
+			pageData.TxHash = assignment.TxHash
+			pageData.TxSender = assignment.TxSender
+		}
+	}
+	pageData.AssignmentCount = uint64(len(pageData.Assignments))
+	pageData.DataLink = fmt.Sprintf("/slot/0x%x/blob/0x%x", assignments[0].Root, commitment)
+
+	client := services.GlobalBeaconService.GetIndexer().GetReadyClClient(false, nil, nil)
+	blobData, err := services.GlobalBeaconService.GetIndexer().BlobStore.LoadBlob(commitment, assignments[0].Root, client)
+	if err != nil {
+		logrus.Warnf("blob page: error loading blob 0x%x: %v", commitment, err)
+	}
+	if blobData != nil {
+		pageData.Proof = blobData.Proof
+		pageData.Size = uint64(blobData.Size)
+		pageData.Pruned = blobData.Pruned
+		if blobData.Blob != nil {
+			blob := *blobData.Blob
+			pageData.HaveData = true
+			if len(blob) > blobPagePreviewSize {
+				pageData.DataPreview = blob[:blobPagePreviewSize]
+				pageData.IsShort = true
+			} else {
+				pageData.DataPreview = blob
+			}
+			verifyBlobPageKzgProof(pageData, blob)
+		}
+	}
+
+	return pageData, time.Duration(chainConfig.SecondsPerSlot*chainConfig.SlotsPerEpoch) * time.Second
+}
+
+func verifyBlobPageKzgProof(pageData *models.BlobPageData, blob []byte) {
+	var kzgBlob kzg4844.Blob
+	if len(blob) != len(kzgBlob) {
+		pageData.KzgError = fmt.Sprintf("invalid blob size: %v", len(blob))
+		return
+	}
+	if len(pageData.Proof) != 48 {
+		pageData.KzgError = "missing kzg proof"
+		return
+	}
+	copy(kzgBlob[:], blob)
+	pageData.KzgVerified = true
+
+	err := kzg4844.VerifyBlobProof(&kzgBlob, kzg4844.Commitment(pageData.Commitment), kzg4844.Proof(pageData.Proof))
+	if err != nil {
+		pageData.KzgError = err.Error()
+	} else {
+		pageData.KzgValid = true
+	}
+}
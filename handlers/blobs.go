@@ -0,0 +1,247 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethpandaops/dora/db"
+	"github.com/ethpandaops/dora/dbtypes"
+	"github.com/ethpandaops/dora/services"
+	"github.com/ethpandaops/dora/templates"
+	"github.com/ethpandaops/dora/types/models"
+	"github.com/ethpandaops/dora/utils"
+	"github.com/sirupsen/logrus"
+)
+
+// Blobs will return the filtered "blobs" page using a go template
+func Blobs(w http.ResponseWriter, r *http.Request) {
+	var templateFiles = append(layoutTemplateFiles,
+		"blobs/blobs.html",
+		"_svg/professor.html",
+	)
+
+	var pageTemplate = templates.GetTemplate(templateFiles...)
+	data := InitPageData(w, r, "blockchain", "/blobs", "Blobs", templateFiles)
+
+	urlArgs := r.URL.Query()
+	var pageSize uint64 = 50
+	if urlArgs.Has("c") {
+		pageSize, _ = strconv.ParseUint(urlArgs.Get("c"), 10, 64)
+	}
+	var pageIdx uint64 = 1
+	if urlArgs.Has("p") {
+		pageIdx, _ = strconv.ParseUint(urlArgs.Get("p"), 10, 64)
+		if pageIdx < 1 {
+			pageIdx = 1
+		}
+	}
+	rangeName := urlArgs.Get("range")
+	if validatorQueuesRanges[rangeName] == 0 {
+		rangeName = "7d"
+	}
+
+	var minSlot uint64
+	var maxSlot uint64
+	var sender string
+	var hash string
+
+	if urlArgs.Has("f") {
+		if urlArgs.Has("f.mins") {
+			minSlot, _ = strconv.ParseUint(urlArgs.Get("f.mins"), 10, 64)
+		}
+		if urlArgs.Has("f.maxs") {
+			maxSlot, _ = strconv.ParseUint(urlArgs.Get("f.maxs"), 10, 64)
+		}
+		if urlArgs.Has("f.sender") {
+			sender = urlArgs.Get("f.sender")
+		}
+		if urlArgs.Has("f.hash") {
+			hash = urlArgs.Get("f.hash")
+		}
+	}
+	var pageError error
+	pageError = services.GlobalCallRateLimiter.CheckCallLimit(r, 2)
+	if pageError == nil {
+		data.Data, pageError = getFilteredBlobsPageData(pageIdx, pageSize, minSlot, maxSlot, sender, hash, rangeName)
+	}
+	if pageError != nil {
+		handlePageError(w, r, pageError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/html")
+	if handleTemplateError(w, r, "blobs.go", "Blobs", "", pageTemplate.ExecuteTemplate(w, "layout", data)) != nil {
+		return // an error has occurred and was processed
+	}
+}
+
+func getFilteredBlobsPageData(pageIdx uint64, pageSize uint64, minSlot uint64, maxSlot uint64, sender string, hash string, rangeName string) (*models.BlobsPageData, error) {
+	pageData := &models.BlobsPageData{}
+	pageCacheKey := fmt.Sprintf("blobs:%v:%v:%v:%v:%v:%v:%v", pageIdx, pageSize, minSlot, maxSlot, sender, hash, rangeName)
+	pageRes, pageErr := services.GlobalFrontendCache.ProcessCachedPage(pageCacheKey, true, pageData, func(pageCall *services.FrontendCacheProcessingPage) interface{} {
+		pageData, cacheTimeout := buildFilteredBlobsPageData(pageIdx, pageSize, minSlot, maxSlot, sender, hash, rangeName)
+		pageCall.CacheTimeout = cacheTimeout
+		return pageData
+	})
+	if pageErr == nil && pageRes != nil {
+		resData, resOk := pageRes.(*models.BlobsPageData)
+		if !resOk {
+			return nil, ErrInvalidPageModel
+		}
+		pageData = resData
+	}
+	return pageData, pageErr
+}
+
+func buildFilteredBlobsPageData(pageIdx uint64, pageSize uint64, minSlot uint64, maxSlot uint64, sender string, hash string, rangeName string) (*models.BlobsPageData, time.Duration) {
+	filterArgs := url.Values{}
+	if minSlot != 0 {
+		filterArgs.Add("f.mins", fmt.Sprintf("%v", minSlot))
+	}
+	if maxSlot != 0 {
+		filterArgs.Add("f.maxs", fmt.Sprintf("%v", maxSlot))
+	}
+	if sender != "" {
+		filterArgs.Add("f.sender", sender)
+	}
+	if hash != "" {
+		filterArgs.Add("f.hash", hash)
+	}
+
+	pageData := &models.BlobsPageData{
+		FilterMinSlot: minSlot,
+		FilterMaxSlot: maxSlot,
+		FilterSender:  sender,
+		FilterHash:    hash,
+		Range:         rangeName,
+	}
+	logrus.Debugf("blobs page called: %v:%v [%v,%v,%v,%v]", pageIdx, pageSize, minSlot, maxSlot, sender, hash)
+	if pageIdx == 1 {
+		pageData.IsDefaultPage = true
+	}
+
+	if pageSize > 100 {
+		pageSize = 100
+	}
+	if pageSize == 0 {
+		pageSize = 50
+	}
+	pageData.PageSize = pageSize
+	pageData.TotalPages = pageIdx
+	pageData.CurrentPageIndex = pageIdx
+	if pageIdx > 1 {
+		pageData.PrevPageIndex = pageIdx - 1
+	}
+
+	// load blobs
+	blobFilter := &dbtypes.BlobFilter{
+		MinSlot: minSlot,
+		MaxSlot: maxSlot,
+		Sender:  common.FromHex(sender),
+	}
+	hashBytes := common.FromHex(hash)
+	if len(hashBytes) == 48 {
+		blobFilter.Commitment = hashBytes
+	} else if len(hashBytes) > 0 {
+		blobFilter.VersionedHash = hashBytes
+	}
+
+	offset := (pageIdx - 1) * pageSize
+	dbBlobs, totalRows, err := db.GetBlobsFiltered(offset, uint32(pageSize), blobFilter)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, dbBlob := range dbBlobs {
+		pageData.Blobs = append(pageData.Blobs, &models.BlobsPageDataBlob{
+			Slot:          dbBlob.Slot,
+			BlockRoot:     dbBlob.Root,
+			Time:          utils.SlotToTime(dbBlob.Slot),
+			BlobIndex:     dbBlob.BlobIndex,
+			Commitment:    dbBlob.Commitment,
+			VersionedHash: dbBlob.VersionedHash,
+			TxHash:        dbBlob.TxHash,
+			TxSender:      dbBlob.TxSender,
+			Size:          uint64(dbBlob.Size),
+			Pruned:        dbBlob.Pruned,
+		})
+	}
+	pageData.BlobCount = uint64(len(pageData.Blobs))
+	pageData.TotalRows = totalRows
+
+	pageData.TotalPages = totalRows / pageSize
+	if totalRows%pageSize > 0 {
+		pageData.TotalPages++
+	}
+	pageData.LastPageIndex = pageData.TotalPages
+	if pageIdx < pageData.TotalPages {
+		pageData.NextPageIndex = pageIdx + 1
+	}
+
+	pageData.FirstPageLink = fmt.Sprintf("/blobs?f&%v&c=%v&range=%v", filterArgs.Encode(), pageData.PageSize, rangeName)
+	pageData.PrevPageLink = fmt.Sprintf("/blobs?f&%v&c=%v&range=%v&p=%v", filterArgs.Encode(), pageData.PageSize, rangeName, pageData.PrevPageIndex)
+	pageData.NextPageLink = fmt.Sprintf("/blobs?f&%v&c=%v&range=%v&p=%v", filterArgs.Encode(), pageData.PageSize, rangeName, pageData.NextPageIndex)
+	pageData.LastPageLink = fmt.Sprintf("/blobs?f&%v&c=%v&range=%v&p=%v", filterArgs.Encode(), pageData.PageSize, rangeName, pageData.LastPageIndex)
+	pageData.RangeLink = fmt.Sprintf("/blobs?f&%v&c=%v", filterArgs.Encode(), pageData.PageSize)
+
+	// load blob gas statistics
+	epochDuration := time.Duration(utils.Config.Chain.Config.SecondsPerSlot*utils.Config.Chain.Config.SlotsPerEpoch) * time.Second
+	maxEpoch := utils.EpochOfSlot(utils.TimeToSlot(uint64(time.Now().Unix())))
+	minEpoch := uint64(0)
+	if rangeEpochs := uint64(validatorQueuesRanges[rangeName] / epochDuration); maxEpoch > rangeEpochs {
+		minEpoch = maxEpoch - rangeEpochs
+	}
+	stats, err := db.GetBlobStats(minEpoch, maxEpoch)
+	if err != nil {
+		logrus.Warnf("blobs page: error loading blob stats: %v", err)
+	} else if len(stats) > 0 {
+		buildBlobsCharts(pageData, stats)
+	}
+
+	return pageData, epochDuration
+}
+
+// buildBlobsCharts builds the blob count, blob gas & blob base fee lines over the persisted epoch stats.
+func buildBlobsCharts(pageData *models.BlobsPageData, stats []*dbtypes.BlobStats) {
+	pageData.ChartMinEpoch = stats[0].Epoch
+	pageData.ChartMaxEpoch = stats[len(stats)-1].Epoch
+	pageData.ChartCount = uint64(len(stats))
+
+	pageData.BlobCountMax = 1
+	pageData.BlobGasMax = 1
+	pageData.BlobBaseFeeMax = 1
+	for _, stat := range stats {
+		pageData.RangeBlobCount += stat.BlobCount
+		pageData.RangeBlobGas += stat.BlobGasUsed
+		if stat.BlobCount > pageData.BlobCountMax {
+			pageData.BlobCountMax = stat.BlobCount
+		}
+		if stat.BlobGasUsed > pageData.BlobGasMax {
+			pageData.BlobGasMax = stat.BlobGasUsed
+		}
+		if stat.BlobBaseFee > pageData.BlobBaseFeeMax {
+			pageData.BlobBaseFeeMax = stat.BlobBaseFee
+		}
+	}
+
+	epochSpan := float64(pageData.ChartMaxEpoch - pageData.ChartMinEpoch)
+	if epochSpan == 0 {
+		epochSpan = 1
+	}
+	countPoints := make([]string, len(stats))
+	gasPoints := make([]string, len(stats))
+	feePoints := make([]string, len(stats))
+	for idx, stat := range stats {
+		posX := float64(stat.Epoch-pageData.ChartMinEpoch) * 1000 / epochSpan
+		countPoints[idx] = fmt.Sprintf("%.1f,%.1f", posX, 200-float64(stat.BlobCount)*200/float64(pageData.BlobCountMax))
+		gasPoints[idx] = fmt.Sprintf("%.1f,%.1f", posX, 200-float64(stat.BlobGasUsed)*200/float64(pageData.BlobGasMax))
+		feePoints[idx] = fmt.Sprintf("%.1f,%.1f", posX, 200-float64(stat.BlobBaseFee)*200/float64(pageData.BlobBaseFeeMax))
+	}
+	pageData.BlobCountChart = strings.Join(countPoints, " ")
+	pageData.BlobGasChart = strings.Join(gasPoints, " ")
+	pageData.BlobBaseFeeChart = strings.Join(feePoints, " ")
+}
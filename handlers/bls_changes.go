@@ -0,0 +1,240 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	v1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethpandaops/dora/dbtypes"
+	"github.com/ethpandaops/dora/services"
+	"github.com/ethpandaops/dora/templates"
+	"github.com/ethpandaops/dora/types/models"
+	"github.com/ethpandaops/dora/utils"
+	"github.com/sirupsen/logrus"
+)
+
+// BLSChanges will return the filtered "bls_changes" page using a go template
+func BLSChanges(w http.ResponseWriter, r *http.Request) {
+	var templateFiles = append(layoutTemplateFiles,
+		"bls_changes/bls_changes.html",
+		"_svg/professor.html",
+	)
+
+	var pageTemplate = templates.GetTemplate(templateFiles...)
+	data := InitPageData(w, r, "validators", "/validators/bls_changes", "BLS Changes", templateFiles)
+
+	urlArgs := r.URL.Query()
+	var pageSize uint64 = 50
+	if urlArgs.Has("c") {
+		pageSize, _ = strconv.ParseUint(urlArgs.Get("c"), 10, 64)
+	}
+	var pageIdx uint64 = 1
+	if urlArgs.Has("p") {
+		pageIdx, _ = strconv.ParseUint(urlArgs.Get("p"), 10, 64)
+		if pageIdx < 1 {
+			pageIdx = 1
+		}
+	}
+
+	var minSlot uint64
+	var maxSlot uint64
+	var minIndex uint64
+	var maxIndex uint64
+	var vname string
+	var address string
+	var withOrphaned uint64
+
+	if urlArgs.Has("f") {
+		if urlArgs.Has("f.mins") {
+			minSlot, _ = strconv.ParseUint(urlArgs.Get("f.mins"), 10, 64)
+		}
+		if urlArgs.Has("f.maxs") {
+			maxSlot, _ = strconv.ParseUint(urlArgs.Get("f.maxs"), 10, 64)
+		}
+		if urlArgs.Has("f.mini") {
+			minIndex, _ = strconv.ParseUint(urlArgs.Get("f.mini"), 10, 64)
+		}
+		if urlArgs.Has("f.maxi") {
+			maxIndex, _ = strconv.ParseUint(urlArgs.Get("f.maxi"), 10, 64)
+		}
+		if urlArgs.Has("f.vname") {
+			vname = urlArgs.Get("f.vname")
+		}
+		if urlArgs.Has("f.address") {
+			address = urlArgs.Get("f.address")
+		}
+		if urlArgs.Has("f.orphaned") {
+			withOrphaned, _ = strconv.ParseUint(urlArgs.Get("f.orphaned"), 10, 64)
+		}
+	} else {
+		withOrphaned = 1
+	}
+	var pageError error
+	pageError = services.GlobalCallRateLimiter.CheckCallLimit(r, 2)
+	if pageError == nil {
+		data.Data, pageError = getFilteredBLSChangesPageData(pageIdx, pageSize, minSlot, maxSlot, minIndex, maxIndex, vname, address, uint8(withOrphaned))
+	}
+	if pageError != nil {
+		handlePageError(w, r, pageError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/html")
+	if handleTemplateError(w, r, "bls_changes.go", "BLSChanges", "", pageTemplate.ExecuteTemplate(w, "layout", data)) != nil {
+		return // an error has occurred and was processed
+	}
+}
+
+func getFilteredBLSChangesPageData(pageIdx uint64, pageSize uint64, minSlot uint64, maxSlot uint64, minIndex uint64, maxIndex uint64, vname string, address string, withOrphaned uint8) (*models.BLSChangesPageData, error) {
+	pageData := &models.BLSChangesPageData{}
+	pageCacheKey := fmt.Sprintf("bls_changes:%v:%v:%v:%v:%v:%v:%v:%v:%v", pageIdx, pageSize, minSlot, maxSlot, minIndex, maxIndex, vname, address, withOrphaned)
+	pageRes, pageErr := services.GlobalFrontendCache.ProcessCachedPage(pageCacheKey, true, pageData, func(_ *services.FrontendCacheProcessingPage) interface{} {
+		return buildFilteredBLSChangesPageData(pageIdx, pageSize, minSlot, maxSlot, minIndex, maxIndex, vname, address, withOrphaned)
+	})
+	if pageErr == nil && pageRes != nil {
+		resData, resOk := pageRes.(*models.BLSChangesPageData)
+		if !resOk {
+			return nil, ErrInvalidPageModel
+		}
+		pageData = resData
+	}
+	return pageData, pageErr
+}
+
+func buildFilteredBLSChangesPageData(pageIdx uint64, pageSize uint64, minSlot uint64, maxSlot uint64, minIndex uint64, maxIndex uint64, vname string, address string, withOrphaned uint8) *models.BLSChangesPageData {
+	filterArgs := url.Values{}
+	if minSlot != 0 {
+		filterArgs.Add("f.mins", fmt.Sprintf("%v", minSlot))
+	}
+	if maxSlot != 0 {
+		filterArgs.Add("f.maxs", fmt.Sprintf("%v", maxSlot))
+	}
+	if minIndex != 0 {
+		filterArgs.Add("f.mini", fmt.Sprintf("%v", minIndex))
+	}
+	if maxIndex != 0 {
+		filterArgs.Add("f.maxi", fmt.Sprintf("%v", maxIndex))
+	}
+	if vname != "" {
+		filterArgs.Add("f.vname", vname)
+	}
+	if address != "" {
+		filterArgs.Add("f.address", address)
+	}
+	if withOrphaned != 0 {
+		filterArgs.Add("f.orphaned", fmt.Sprintf("%v", withOrphaned))
+	}
+
+	pageData := &models.BLSChangesPageData{
+		FilterMinSlot:       minSlot,
+		FilterMaxSlot:       maxSlot,
+		FilterMinIndex:      minIndex,
+		FilterMaxIndex:      maxIndex,
+		FilterValidatorName: vname,
+		FilterAddress:       address,
+		FilterWithOrphaned:  withOrphaned,
+	}
+	logrus.Debugf("bls_changes page called: %v:%v [%v,%v,%v,%v,%v,%v]", pageIdx, pageSize, minSlot, maxSlot, minIndex, maxIndex, vname, address)
+	if pageIdx == 1 {
+		pageData.IsDefaultPage = true
+	}
+
+	if pageSize > 100 {
+		pageSize = 100
+	}
+	pageData.PageSize = pageSize
+	pageData.TotalPages = pageIdx
+	pageData.CurrentPageIndex = pageIdx
+	if pageIdx > 1 {
+		pageData.PrevPageIndex = pageIdx - 1
+	}
+
+	// load bls changes
+	blsChangeFilter := &dbtypes.BLSChangeFilter{
+		MinSlot:       minSlot,
+		MaxSlot:       maxSlot,
+		MinIndex:      minIndex,
+		MaxIndex:      maxIndex,
+		ValidatorName: vname,
+		Address:       common.FromHex(address),
+		WithOrphaned:  withOrphaned,
+	}
+
+	dbBLSChanges, totalRows := services.GlobalBeaconService.GetBLSChangesByFilter(blsChangeFilter, pageIdx-1, uint32(pageSize))
+
+	validatorSetRsp := services.GlobalBeaconService.GetCachedValidatorSet()
+	validatorActivityMap, validatorActivityMax := services.GlobalBeaconService.GetValidatorActivity()
+
+	for _, blsChange := range dbBLSChanges {
+		blsChangeData := &models.BLSChangesPageDataChange{
+			SlotNumber:      blsChange.SlotNumber,
+			SlotRoot:        blsChange.SlotRoot,
+			Time:            utils.SlotToTime(blsChange.SlotNumber),
+			Orphaned:        blsChange.Orphaned,
+			ValidatorIndex:  blsChange.ValidatorIndex,
+			ValidatorName:   services.GlobalBeaconService.GetValidatorName(blsChange.ValidatorIndex),
+			BlsPubkey:       blsChange.BlsPubkey,
+			Address:         blsChange.Address,
+			ValidatorStatus: "",
+		}
+
+		validator := validatorSetRsp[phase0.ValidatorIndex(blsChange.ValidatorIndex)]
+		if validator == nil {
+			blsChangeData.ValidatorStatus = "Unknown"
+		} else {
+			if strings.HasPrefix(validator.Status.String(), "pending") {
+				blsChangeData.ValidatorStatus = "Pending"
+			} else if validator.Status == v1.ValidatorStateActiveOngoing {
+				blsChangeData.ValidatorStatus = "Active"
+				blsChangeData.ShowUpcheck = true
+			} else if validator.Status == v1.ValidatorStateActiveExiting {
+				blsChangeData.ValidatorStatus = "Exiting"
+				blsChangeData.ShowUpcheck = true
+			} else if validator.Status == v1.ValidatorStateActiveSlashed {
+				blsChangeData.ValidatorStatus = "Slashed"
+				blsChangeData.ShowUpcheck = true
+			} else if validator.Status == v1.ValidatorStateExitedUnslashed {
+				blsChangeData.ValidatorStatus = "Exited"
+			} else if validator.Status == v1.ValidatorStateExitedSlashed {
+				blsChangeData.ValidatorStatus = "Slashed"
+			} else if validator.Status == v1.ValidatorStateWithdrawalPossible || validator.Status == v1.ValidatorStateWithdrawalDone {
+				blsChangeData.ValidatorStatus = "Withdrawn"
+			} else {
+				blsChangeData.ValidatorStatus = validator.Status.String()
+			}
+
+			if blsChangeData.ShowUpcheck {
+				blsChangeData.UpcheckActivity = validatorActivityMap[uint64(validator.Index)]
+				blsChangeData.UpcheckMaximum = uint8(validatorActivityMax)
+			}
+		}
+
+		pageData.BLSChanges = append(pageData.BLSChanges, blsChangeData)
+	}
+	pageData.BLSChangeCount = uint64(len(pageData.BLSChanges))
+
+	if pageData.BLSChangeCount > 0 {
+		pageData.FirstIndex = pageData.BLSChanges[0].SlotNumber
+		pageData.LastIndex = pageData.BLSChanges[pageData.BLSChangeCount-1].SlotNumber
+	}
+
+	pageData.TotalPages = totalRows / pageSize
+	if totalRows%pageSize > 0 {
+		pageData.TotalPages++
+	}
+	pageData.LastPageIndex = pageData.TotalPages
+	if pageIdx < pageData.TotalPages {
+		pageData.NextPageIndex = pageIdx + 1
+	}
+
+	pageData.FirstPageLink = fmt.Sprintf("/validators/bls_changes?f&%v&c=%v", filterArgs.Encode(), pageData.PageSize)
+	pageData.PrevPageLink = fmt.Sprintf("/validators/bls_changes?f&%v&c=%v&p=%v", filterArgs.Encode(), pageData.PageSize, pageData.PrevPageIndex)
+	pageData.NextPageLink = fmt.Sprintf("/validators/bls_changes?f&%v&c=%v&p=%v", filterArgs.Encode(), pageData.PageSize, pageData.NextPageIndex)
+	pageData.LastPageLink = fmt.Sprintf("/validators/bls_changes?f&%v&c=%v&p=%v", filterArgs.Encode(), pageData.PageSize, pageData.LastPageIndex)
+
+	return pageData
+}
@@ -0,0 +1,306 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ethpandaops/dora/db"
+	"github.com/ethpandaops/dora/dbtypes"
+	"github.com/ethpandaops/dora/services"
+	"github.com/ethpandaops/dora/templates"
+	"github.com/ethpandaops/dora/types/models"
+	"github.com/ethpandaops/dora/utils"
+	"github.com/sirupsen/logrus"
+)
+
+const clientDiversityChartBuckets = 100
+
+var clientDiversityColors = []string{
+	"#3b82f6", "#f97316", "#22c55e", "#a855f7", "#ef4444", "#14b8a6", "#eab308", "#ec4899", "#6366f1", "#84cc16",
+}
+
+var clientDiversityRanges = map[string]time.Duration{
+	"1d":  24 * time.Hour,
+	"7d":  7 * 24 * time.Hour,
+	"30d": 30 * 24 * time.Hour,
+}
+
+// ClientDiversity will return the "client diversity" page using a go template
+func ClientDiversity(w http.ResponseWriter, r *http.Request) {
+	var templateFiles = append(layoutTemplateFiles,
+		"clients/diversity.html",
+	)
+
+	var pageTemplate = templates.GetTemplate(templateFiles...)
+	data := InitPageData(w, r, "clients", "/clients/diversity", "Client Diversity", templateFiles)
+
+	urlArgs := r.URL.Query()
+	rangeName := urlArgs.Get("range")
+	if clientDiversityRanges[rangeName] == 0 {
+		rangeName = "1d"
+	}
+
+	epochDuration := time.Duration(utils.Config.Chain.Config.SecondsPerSlot*utils.Config.Chain.Config.SlotsPerEpoch) * time.Second
+	maxEpoch := utils.EpochOfSlot(utils.TimeToSlot(uint64(time.Now().Unix())))
+	minEpoch := uint64(0)
+	if rangeEpochs := uint64(clientDiversityRanges[rangeName] / epochDuration); maxEpoch > rangeEpochs {
+		minEpoch = maxEpoch - rangeEpochs
+	}
+
+	var pageError error
+	pageError = services.GlobalCallRateLimiter.CheckCallLimit(r, 2)
+	if pageError == nil {
+		var pageData *models.ClientDiversityPageData
+		pageData, pageError = getClientDiversityPageData(minEpoch, maxEpoch, false)
+		if pageData != nil {
+			pageData.Range = rangeName
+		}
+		data.Data = pageData
+	}
+	if pageError != nil {
+		handlePageError(w, r, pageError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/html")
+	if handleTemplateError(w, r, "client_diversity.go", "ClientDiversity", "", pageTemplate.ExecuteTemplate(w, "layout", data)) != nil {
+		return // an error has occurred and was processed
+	}
+}
+
+func getClientDiversityPageData(minEpoch uint64, maxEpoch uint64, withEpochs bool) (*models.ClientDiversityPageData, error) {
+	pageData := &models.ClientDiversityPageData{}
+	pageCacheKey := fmt.Sprintf("clientdiversity:%v:%v:%v", minEpoch, maxEpoch, withEpochs)
+	pageRes, pageErr := services.GlobalFrontendCache.ProcessCachedPage(pageCacheKey, true, pageData, func(pageCall *services.FrontendCacheProcessingPage) interface{} {
+		pageData, cacheTimeout := buildClientDiversityPageData(minEpoch, maxEpoch, withEpochs)
+		pageCall.CacheTimeout = cacheTimeout
+		return pageData
+	})
+	if pageErr == nil && pageRes != nil {
+		resData, resOk := pageRes.(*models.ClientDiversityPageData)
+		if !resOk {
+			return nil, ErrInvalidPageModel
+		}
+		pageData = resData
+	}
+	return pageData, pageErr
+}
+
+func buildClientDiversityPageData(minEpoch uint64, maxEpoch uint64, withEpochs bool) (*models.ClientDiversityPageData, time.Duration) {
+	logrus.Debugf("client diversity page called: %v-%v", minEpoch, maxEpoch)
+	cacheTime := time.Duration(utils.Config.Chain.Config.SecondsPerSlot*utils.Config.Chain.Config.SlotsPerEpoch) * time.Second
+	pageData := &models.ClientDiversityPageData{
+		MinEpoch: minEpoch,
+		MaxEpoch: maxEpoch,
+		StartTs:  utils.EpochToTime(minEpoch),
+		EndTs:    utils.EpochToTime(maxEpoch + 1),
+		ClLayer: &models.ClientDiversityPageDataLayer{
+			Title:   "Consensus Clients",
+			Clients: []*models.ClientDiversityPageDataClient{},
+			Chart:   []*models.ClientDiversityPageDataSeries{},
+		},
+		ElLayer: &models.ClientDiversityPageDataLayer{
+			Title:   "Execution Clients",
+			Clients: []*models.ClientDiversityPageDataClient{},
+			Chart:   []*models.ClientDiversityPageDataSeries{},
+		},
+		Pairs: []*models.ClientDiversityPageDataPair{},
+	}
+
+	stats, err := db.GetClientDiversity(minEpoch, maxEpoch)
+	if err != nil {
+		return pageData, 0
+	}
+
+	clClientMap := map[string]*models.ClientDiversityPageDataClient{}
+	elClientMap := map[string]*models.ClientDiversityPageDataClient{}
+	pairMap := map[string]*models.ClientDiversityPageDataPair{}
+	epochMap := map[uint64]bool{}
+	if withEpochs {
+		pageData.Epochs = make([]*models.ClientDiversityPageDataEpoch, 0, len(stats))
+	}
+	for _, stat := range stats {
+		clName := getClientDiversityName(stat.ClClient)
+		elName := getClientDiversityName(stat.ElClient)
+		epochMap[stat.Epoch] = true
+		pageData.TotalProposed += stat.ProposedCount
+		pageData.TotalMissed += stat.MissedCount
+
+		clClient := clClientMap[clName]
+		if clClient == nil {
+			clClient = &models.ClientDiversityPageDataClient{Name: clName}
+			clClientMap[clName] = clClient
+			pageData.ClLayer.Clients = append(pageData.ClLayer.Clients, clClient)
+		}
+		clClient.ProposedCount += stat.ProposedCount
+		clClient.MissedCount += stat.MissedCount
+
+		elClient := elClientMap[elName]
+		if elClient == nil {
+			elClient = &models.ClientDiversityPageDataClient{Name: elName}
+			elClientMap[elName] = elClient
+			pageData.ElLayer.Clients = append(pageData.ElLayer.Clients, elClient)
+		}
+		elClient.ProposedCount += stat.ProposedCount
+		elClient.MissedCount += stat.MissedCount
+
+		pairKey := fmt.Sprintf("%v:%v", clName, elName)
+		pair := pairMap[pairKey]
+		if pair == nil {
+			pair = &models.ClientDiversityPageDataPair{ClClient: clName, ElClient: elName}
+			pairMap[pairKey] = pair
+			pageData.Pairs = append(pageData.Pairs, pair)
+		}
+		pair.ProposedCount += stat.ProposedCount
+		pair.MissedCount += stat.MissedCount
+
+		if withEpochs {
+			pageData.Epochs = append(pageData.Epochs, &models.ClientDiversityPageDataEpoch{
+				Epoch:         stat.Epoch,
+				ClClient:      clName,
+				ElClient:      elName,
+				ProposedCount: stat.ProposedCount,
+				MissedCount:   stat.MissedCount,
+			})
+		}
+	}
+	pageData.EpochCount = uint64(len(epochMap))
+
+	totalSlots := pageData.TotalProposed + pageData.TotalMissed
+	if totalSlots > 0 {
+		pageData.MissedRate = float64(pageData.TotalMissed) * 100 / float64(totalSlots)
+	}
+	for _, layer := range []*models.ClientDiversityPageDataLayer{pageData.ClLayer, pageData.ElLayer} {
+		clients := layer.Clients
+		for _, client := range clients {
+			client.Share, client.MissedRate = getClientDiversityRates(client.ProposedCount, client.MissedCount, totalSlots)
+		}
+		sortClientDiversityClients(clients)
+		colorIdx := 0
+		for _, client := range clients {
+			if client.Name == "Unknown" {
+				client.Color = "#9ca3af"
+			} else {
+				client.Color = clientDiversityColors[colorIdx%len(clientDiversityColors)]
+				colorIdx++
+			}
+		}
+	}
+	for _, pair := range pageData.Pairs {
+		pair.Share, pair.MissedRate = getClientDiversityRates(pair.ProposedCount, pair.MissedCount, totalSlots)
+	}
+	sort.Slice(pageData.Pairs, func(a, b int) bool {
+		return pageData.Pairs[a].ProposedCount+pageData.Pairs[a].MissedCount > pageData.Pairs[b].ProposedCount+pageData.Pairs[b].MissedCount
+	})
+
+	pageData.ClLayer.Chart = buildClientDiversityChart(minEpoch, maxEpoch, pageData.ClLayer.Clients, func(clClient, _ string) string {
+		return clClient
+	}, stats)
+	pageData.ElLayer.Chart = buildClientDiversityChart(minEpoch, maxEpoch, pageData.ElLayer.Clients, func(_, elClient string) string {
+		return elClient
+	}, stats)
+
+	return pageData, cacheTime
+}
+
+func getClientDiversityName(client string) string {
+	if client == "" {
+		return "Unknown"
+	}
+	return client
+}
+
+func getClientDiversityRates(proposed uint64, missed uint64, totalSlots uint64) (float64, float64) {
+	share := float64(0)
+	missedRate := float64(0)
+	if totalSlots > 0 {
+		share = float64(proposed+missed) * 100 / float64(totalSlots)
+	}
+	if proposed+missed > 0 {
+		missedRate = float64(missed) * 100 / float64(proposed+missed)
+	}
+	return share, missedRate
+}
+
+// sortClientDiversityClients orders clients by share, with the unclassified proposals last
+func sortClientDiversityClients(clients []*models.ClientDiversityPageDataClient) {
+	sort.Slice(clients, func(a, b int) bool {
+		if (clients[a].Name == "Unknown") != (clients[b].Name == "Unknown") {
+			return clients[b].Name == "Unknown"
+		}
+		return clients[a].Share > clients[b].Share
+	})
+}
+
+// buildClientDiversityChart builds a stacked area chart of the client shares over time.
+// The epoch range is split into fixed buckets, each point is the share of the client in the assigned slots of the bucket.
+func buildClientDiversityChart(minEpoch uint64, maxEpoch uint64, clients []*models.ClientDiversityPageDataClient, getClient func(clClient, elClient string) string, stats []*dbtypes.ClientDiversity) []*models.ClientDiversityPageDataSeries {
+	series := []*models.ClientDiversityPageDataSeries{}
+	epochCount := maxEpoch - minEpoch + 1
+	bucketCount := uint64(clientDiversityChartBuckets)
+	if epochCount < bucketCount {
+		bucketCount = epochCount
+	}
+
+	clientIdx := map[string]int{}
+	for idx, client := range clients {
+		clientIdx[client.Name] = idx
+	}
+	bucketSlots := make([][]uint64, bucketCount)
+	bucketTotals := make([]uint64, bucketCount)
+	for _, stat := range stats {
+		if stat.Epoch < minEpoch || stat.Epoch > maxEpoch {
+			continue
+		}
+		bucket := (stat.Epoch - minEpoch) * bucketCount / epochCount
+		if bucketSlots[bucket] == nil {
+			bucketSlots[bucket] = make([]uint64, len(clients))
+		}
+		idx := clientIdx[getClientDiversityName(getClient(stat.ClClient, stat.ElClient))]
+		bucketSlots[bucket][idx] += stat.ProposedCount + stat.MissedCount
+		bucketTotals[bucket] += stat.ProposedCount + stat.MissedCount
+	}
+
+	// x positions of all buckets with data
+	bucketPosX := []float64{}
+	bucketIdx := []uint64{}
+	for bucket := uint64(0); bucket < bucketCount; bucket++ {
+		if bucketTotals[bucket] == 0 {
+			continue
+		}
+		posX := float64(0)
+		if bucketCount > 1 {
+			posX = float64(bucket) * 1000 / float64(bucketCount-1)
+		}
+		bucketPosX = append(bucketPosX, posX)
+		bucketIdx = append(bucketIdx, bucket)
+	}
+	if len(bucketIdx) == 1 {
+		// stretch a single data point over the whole chart
+		bucketPosX = []float64{0, 1000}
+		bucketIdx = []uint64{bucketIdx[0], bucketIdx[0]}
+	}
+	if len(bucketIdx) == 0 {
+		return series
+	}
+
+	lowerShares := make([]float64, len(bucketIdx))
+	for idx, client := range clients {
+		upperPoints := make([]string, len(bucketIdx))
+		lowerPoints := make([]string, len(bucketIdx))
+		for i, bucket := range bucketIdx {
+			share := float64(bucketSlots[bucket][idx]) * 100 / float64(bucketTotals[bucket])
+			lowerPoints[len(bucketIdx)-i-1] = fmt.Sprintf("%.1f,%.1f", bucketPosX[i], 200-lowerShares[i]*2)
+			lowerShares[i] += share
+			upperPoints[i] = fmt.Sprintf("%.1f,%.1f", bucketPosX[i], 200-lowerShares[i]*2)
+		}
+		series = append(series, &models.ClientDiversityPageDataSeries{
+			Name:   client.Name,
+			Color:  client.Color,
+			Points: strings.Join(append(upperPoints, lowerPoints...), " "),
+		})
+	}
+	return series
+}
@@ -130,6 +130,24 @@ func buildDepositsPageData(firstEpoch uint64, pageSize uint64) (*models.Deposits
 	}
 	pageData.InitiatedDepositCount = uint64(len(pageData.InitiatedDeposits))
 
+	// deposit tree state
+	if treeStatus := services.GlobalBeaconService.GetIndexer().GetDepositTreeStatus(); treeStatus != nil {
+		pageData.DepositTree = &models.DepositsPageDataTree{
+			DepositCount:    treeStatus.DepositCount,
+			DepositRoot:     treeStatus.DepositRoot,
+			FinalBlock:      treeStatus.FinalBlock,
+			ContractChecked: treeStatus.ContractChecked,
+			ContractBlock:   treeStatus.ContractBlock,
+			ContractMatch:   treeStatus.ContractMatch,
+			ContractCount:   treeStatus.ContractCount,
+			ContractRoot:    treeStatus.ContractRoot,
+			Eth1DataChecked: treeStatus.Eth1DataChecked,
+			Eth1DataMatch:   treeStatus.Eth1DataMatch,
+			Eth1DataCount:   treeStatus.Eth1DataCount,
+			Eth1DataRoot:    treeStatus.Eth1DataRoot,
+		}
+	}
+
 	// load included deposits
 	dbDeposits, _ := services.GlobalBeaconService.GetIncludedDepositsByFilter(&dbtypes.DepositFilter{}, 0, 20)
 	for _, deposit := range dbDeposits {
@@ -10,6 +10,7 @@ import (
 	"github.com/gorilla/mux"
 	"github.com/sirupsen/logrus"
 
+	"github.com/ethpandaops/dora/db"
 	"github.com/ethpandaops/dora/dbtypes"
 	"github.com/ethpandaops/dora/services"
 	"github.com/ethpandaops/dora/templates"
@@ -137,6 +138,21 @@ func buildEpochPageData(epoch uint64) (*models.EpochPageData, time.Duration) {
 		}
 	}
 
+	// load attestation & sync committee rewards
+	if dbRewards := db.GetEpochRewards(epoch); dbRewards != nil {
+		pageData.RewardsLoaded = true
+		pageData.IdealRewards = dbRewards.IdealRewards
+		pageData.AttestationRewards = dbRewards.AttestationRewards
+		pageData.AttestationPenalties = dbRewards.AttestationPenalties
+		pageData.InactivityPenalties = dbRewards.InactivityPenalties
+		pageData.SyncRewards = dbRewards.SyncRewards
+		pageData.SyncPenalties = dbRewards.SyncPenalties
+		pageData.TotalPenalties = dbRewards.AttestationPenalties + dbRewards.InactivityPenalties + dbRewards.SyncPenalties
+		if dbRewards.IdealRewards > 0 {
+			pageData.RewardEfficiency = float64(dbRewards.AttestationRewards) * 100 / float64(dbRewards.IdealRewards)
+		}
+	}
+
 	// load slots
 	pageData.Slots = make([]*models.EpochPageDataSlot, 0)
 	dbSlots := services.GlobalBeaconService.GetDbBlocksForSlots(uint64(lastSlot), uint32(utils.Config.Chain.Config.SlotsPerEpoch), true, true)
@@ -177,6 +193,7 @@ func buildEpochPageData(epoch uint64) (*models.EpochPageData, time.Duration) {
 				ProposerSlashingCount: dbSlot.ProposerSlashingCount,
 				AttesterSlashingCount: dbSlot.AttesterSlashingCount,
 				SyncParticipation:     float64(dbSlot.SyncParticipation) * 100,
+				Reward:                buildSlotReward(dbSlot.ClReward, dbSlot.ClRewardAttestations, dbSlot.ClRewardSync, dbSlot.ClRewardSlashings, dbSlot.ElPriorityFees),
 				EthTransactionCount:   dbSlot.EthTransactionCount,
 				Graffiti:              dbSlot.Graffiti,
 				BlockRoot:             dbSlot.Root,
@@ -185,6 +202,10 @@ func buildEpochPageData(epoch uint64) (*models.EpochPageData, time.Duration) {
 				slotData.WithEthBlock = true
 				slotData.EthBlockNumber = *dbSlot.EthBlockNumber
 			}
+			if slotData.Reward != nil && dbSlot.Status == dbtypes.Canonical {
+				pageData.ProposerClRewards += slotData.Reward.ClReward
+				pageData.ProposerPriorityFees += slotData.Reward.ElPriorityFees
+			}
 			pageData.Slots = append(pageData.Slots, slotData)
 			blockCount++
 			haveBlock = true
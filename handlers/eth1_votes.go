@@ -0,0 +1,165 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/ethpandaops/dora/services"
+	"github.com/ethpandaops/dora/templates"
+	"github.com/ethpandaops/dora/types/models"
+	"github.com/ethpandaops/dora/utils"
+	"github.com/sirupsen/logrus"
+)
+
+// Eth1Votes will return the "eth1 votes" page using a go template
+func Eth1Votes(w http.ResponseWriter, r *http.Request) {
+	var templateFiles = append(layoutTemplateFiles,
+		"eth1_votes/eth1_votes.html",
+	)
+
+	var pageTemplate = templates.GetTemplate(templateFiles...)
+	data := InitPageData(w, r, "validators", "/validators/eth1_votes", "Eth1 Voting", templateFiles)
+
+	currentPeriod := getCurrentEth1VotingPeriod()
+	period := currentPeriod
+	urlArgs := r.URL.Query()
+	if urlArgs.Has("period") {
+		period, _ = strconv.ParseUint(urlArgs.Get("period"), 10, 64)
+		if period > currentPeriod {
+			period = currentPeriod
+		}
+	}
+
+	var pageError error
+	pageError = services.GlobalCallRateLimiter.CheckCallLimit(r, 1)
+	if pageError == nil {
+		data.Data, pageError = getEth1VotesPageData(period, currentPeriod)
+	}
+	if pageError != nil {
+		handlePageError(w, r, pageError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/html")
+	if handleTemplateError(w, r, "eth1_votes.go", "Eth1Votes", "", pageTemplate.ExecuteTemplate(w, "layout", data)) != nil {
+		return // an error has occurred and was processed
+	}
+}
+
+func getCurrentEth1VotingPeriod() uint64 {
+	chainConfig := utils.Config.Chain.Config
+	if chainConfig.EpochsPerEth1VotingPeriod == 0 {
+		return 0
+	}
+	currentEpoch := utils.EpochOfSlot(utils.TimeToSlot(uint64(time.Now().Unix())))
+	return currentEpoch / chainConfig.EpochsPerEth1VotingPeriod
+}
+
+func getEth1VotesPageData(period uint64, currentPeriod uint64) (*models.Eth1VotesPageData, error) {
+	pageData := &models.Eth1VotesPageData{}
+	pageCacheKey := fmt.Sprintf("eth1votes:%v:%v", period, currentPeriod)
+	pageRes, pageErr := services.GlobalFrontendCache.ProcessCachedPage(pageCacheKey, true, pageData, func(pageCall *services.FrontendCacheProcessingPage) interface{} {
+		pageData, cacheTimeout := buildEth1VotesPageData(period, currentPeriod)
+		pageCall.CacheTimeout = cacheTimeout
+		return pageData
+	})
+	if pageErr == nil && pageRes != nil {
+		resData, resOk := pageRes.(*models.Eth1VotesPageData)
+		if !resOk {
+			return nil, ErrInvalidPageModel
+		}
+		pageData = resData
+	}
+	return pageData, pageErr
+}
+
+func buildEth1VotesPageData(period uint64, currentPeriod uint64) (*models.Eth1VotesPageData, time.Duration) {
+	logrus.Debugf("eth1 votes page called: %v", period)
+	chainConfig := utils.Config.Chain.Config
+	pageData := &models.Eth1VotesPageData{
+		Period:        period,
+		CurrentPeriod: currentPeriod,
+		IsCurrent:     period == currentPeriod,
+		Candidates:    []*models.Eth1VotesPageDataCandidate{},
+	}
+
+	votingPeriod := services.GlobalBeaconService.GetEth1VotingPeriod(period)
+	if votingPeriod == nil {
+		return pageData, 1 * time.Minute
+	}
+
+	currentSlot := utils.TimeToSlot(uint64(time.Now().Unix()))
+	pageData.FirstSlot = votingPeriod.FirstSlot
+	pageData.LastSlot = votingPeriod.LastSlot
+	pageData.FirstEpoch = utils.EpochOfSlot(votingPeriod.FirstSlot)
+	pageData.LastEpoch = utils.EpochOfSlot(votingPeriod.LastSlot)
+	pageData.StartTime = utils.SlotToTime(votingPeriod.FirstSlot)
+	pageData.EndTime = utils.SlotToTime(votingPeriod.LastSlot + 1)
+	pageData.PeriodSlots = votingPeriod.PeriodSlots
+	pageData.ElapsedSlots = votingPeriod.PeriodSlots
+	if currentSlot <= votingPeriod.LastSlot {
+		pageData.ElapsedSlots = currentSlot - votingPeriod.FirstSlot + 1
+	}
+	pageData.BlockCount = votingPeriod.BlockCount
+	pageData.UnknownVotes = votingPeriod.UnknownVotes
+	pageData.MajorityVotes = votingPeriod.PeriodSlots/2 + 1
+	pageData.CandidateCount = uint64(len(votingPeriod.Candidates))
+
+	if votingPeriod.Majority != nil {
+		pageData.MajorityReached = true
+		pageData.MajoritySlot = *votingPeriod.Majority.MajoritySlot
+	}
+	remainingSlots := votingPeriod.PeriodSlots - pageData.ElapsedSlots
+	leadingVotes := uint64(0)
+	if len(votingPeriod.Candidates) > 0 {
+		leadingVotes = votingPeriod.Candidates[0].Votes
+	}
+	pageData.MajorityPossible = pageData.MajorityReached || leadingVotes+remainingSlots >= pageData.MajorityVotes
+
+	if eth1Data := votingPeriod.StartEth1Data; eth1Data != nil {
+		pageData.HasStartEth1Data = true
+		pageData.StartDepositRoot = eth1Data.DepositRoot[:]
+		pageData.StartDepositCount = eth1Data.DepositCount
+		pageData.StartBlockHash = eth1Data.BlockHash
+	}
+	if treeStatus := services.GlobalBeaconService.GetIndexer().GetDepositTreeStatus(); treeStatus != nil {
+		pageData.HasContractCount = true
+		pageData.ContractDepositCount = treeStatus.DepositCount
+	}
+
+	for idx, candidate := range votingPeriod.Candidates {
+		candidateData := &models.Eth1VotesPageDataCandidate{
+			DepositRoot:  candidate.DepositRoot,
+			DepositCount: candidate.DepositCount,
+			BlockHash:    candidate.BlockHash,
+			Votes:        candidate.Votes,
+			FirstSlot:    candidate.FirstSlot,
+			LastSlot:     candidate.LastSlot,
+			IsLeading:    idx == 0,
+			HasMajority:  candidate.MajoritySlot != nil,
+		}
+		if pageData.BlockCount > 0 {
+			candidateData.VotePercent = float64(candidate.Votes) * 100 / float64(pageData.BlockCount)
+		}
+		candidateData.MajorityPercent = float64(candidate.Votes) * 100 / float64(pageData.MajorityVotes)
+		if candidateData.MajorityPercent > 100 {
+			candidateData.MajorityPercent = 100
+		}
+		if candidate.BlockNumber != nil {
+			candidateData.HasBlock = true
+			candidateData.BlockNumber = *candidate.BlockNumber
+			candidateData.BlockTime = *candidate.BlockTime
+		}
+		if candidate.MajoritySlot != nil {
+			candidateData.MajoritySlot = *candidate.MajoritySlot
+		}
+		pageData.Candidates = append(pageData.Candidates, candidateData)
+	}
+
+	cacheTimeout := time.Duration(chainConfig.SecondsPerSlot) * time.Second
+	if !pageData.IsCurrent {
+		cacheTimeout = 10 * time.Minute
+	}
+	return pageData, cacheTimeout
+}
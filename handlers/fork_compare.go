@@ -0,0 +1,331 @@
+package handlers
+
+import (
+	"bytes"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethpandaops/dora/rpc"
+	"github.com/ethpandaops/dora/services"
+	"github.com/ethpandaops/dora/templates"
+	"github.com/ethpandaops/dora/types/models"
+	"github.com/ethpandaops/dora/utils"
+	"github.com/sirupsen/logrus"
+)
+
+// ForkCompare will return the "fork choice comparison" page using a go template
+func ForkCompare(w http.ResponseWriter, r *http.Request) {
+	var forkCompareTemplateFiles = append(layoutTemplateFiles,
+		"forks/compare.html",
+	)
+
+	var pageTemplate = templates.GetTemplate(forkCompareTemplateFiles...)
+	data := InitPageData(w, r, "clients", "/forks/compare", "Fork Choice Comparison", forkCompareTemplateFiles)
+
+	urlArgs := r.URL.Query()
+	divergentOnly := urlArgs.Get("d") == "1"
+
+	var pageError error
+	pageError = services.GlobalCallRateLimiter.CheckCallLimit(r, 2)
+	if pageError == nil {
+		data.Data, pageError = getForkComparePageData(divergentOnly)
+	}
+	if pageError != nil {
+		handlePageError(w, r, pageError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/html")
+	if handleTemplateError(w, r, "fork_compare.go", "ForkCompare", "", pageTemplate.ExecuteTemplate(w, "layout", data)) != nil {
+		return // an error has occurred and was processed
+	}
+}
+
+func getForkComparePageData(divergentOnly bool) (*models.ForkComparePageData, error) {
+	pageData := &models.ForkComparePageData{}
+	pageCacheKey := "forkcompare"
+	if divergentOnly {
+		pageCacheKey += ":divergent"
+	}
+	pageRes, pageErr := services.GlobalFrontendCache.ProcessCachedPage(pageCacheKey, true, pageData, func(pageCall *services.FrontendCacheProcessingPage) interface{} {
+		pageData, cacheTimeout := buildForkComparePageData(divergentOnly)
+		pageCall.CacheTimeout = cacheTimeout
+		return pageData
+	})
+	if pageErr == nil && pageRes != nil {
+		resData, resOk := pageRes.(*models.ForkComparePageData)
+		if !resOk {
+			return nil, ErrInvalidPageModel
+		}
+		pageData = resData
+	}
+	return pageData, pageErr
+}
+
+type forkCompareClientView struct {
+	forkChoice *rpc.ForkChoice
+	nodeMap    map[string]*rpc.ForkChoiceNode
+	headNode   *rpc.ForkChoiceNode
+	err        error
+}
+
+func buildForkComparePageData(divergentOnly bool) (*models.ForkComparePageData, time.Duration) {
+	logrus.Debugf("fork compare page called")
+	cacheTime := time.Duration(utils.Config.Chain.Config.SecondsPerSlot) * time.Second
+	pageData := &models.ForkComparePageData{
+		Clients:         []*models.ForkComparePageDataClient{},
+		Nodes:           []*models.ForkComparePageDataNode{},
+		FilterDivergent: divergentOnly,
+	}
+
+	// load the fork choice of all clients in parallel (the debug endpoint can be slow on busy clients)
+	clients := services.GlobalBeaconService.GetConsensusClients()
+	clientViews := make([]*forkCompareClientView, len(clients))
+	viewMutex := sync.Mutex{}
+	loadWg := sync.WaitGroup{}
+	for idx, client := range clients {
+		loadWg.Add(1)
+		go func(idx int, rpcClient *rpc.BeaconClient) {
+			defer loadWg.Done()
+			forkChoice, err := rpcClient.GetForkChoice()
+			view := &forkCompareClientView{
+				forkChoice: forkChoice,
+				err:        err,
+			}
+			viewMutex.Lock()
+			clientViews[idx] = view
+			viewMutex.Unlock()
+		}(idx, client.GetRpcClient())
+	}
+	loadDone := make(chan bool)
+	go func() {
+		loadWg.Wait()
+		close(loadDone)
+	}()
+	select {
+	case <-loadDone:
+	case <-time.After(10 * time.Second):
+	}
+
+	viewMutex.Lock()
+	defer viewMutex.Unlock()
+
+	justifiedVotes := map[string]int{}
+	finalizedVotes := map[string]int{}
+	headVotes := map[string]int{}
+	for idx, client := range clients {
+		clientData := &models.ForkComparePageDataClient{
+			Index:   int(client.GetIndex()) + 1,
+			Name:    client.GetName(),
+			Version: client.GetVersion(),
+			Status:  client.GetStatus(),
+		}
+		pageData.Clients = append(pageData.Clients, clientData)
+
+		view := clientViews[idx]
+		if view == nil {
+			clientData.Error = "timeout loading fork choice"
+			continue
+		}
+		if view.err != nil {
+			clientData.Error = view.err.Error()
+			continue
+		}
+
+		view.nodeMap = map[string]*rpc.ForkChoiceNode{}
+		for _, node := range view.forkChoice.Nodes {
+			view.nodeMap[string(node.BlockRoot)] = node
+		}
+		view.headNode = getForkChoiceHead(view.forkChoice)
+
+		clientData.Loaded = true
+		clientData.JustifiedEpoch = view.forkChoice.JustifiedCheckpoint.Epoch
+		clientData.JustifiedRoot = view.forkChoice.JustifiedCheckpoint.Root
+		clientData.FinalizedEpoch = view.forkChoice.FinalizedCheckpoint.Epoch
+		clientData.FinalizedRoot = view.forkChoice.FinalizedCheckpoint.Root
+		clientData.NodeCount = uint64(len(view.forkChoice.Nodes))
+		if view.headNode != nil {
+			clientData.HeadSlot = view.headNode.Slot
+			clientData.HeadRoot = view.headNode.BlockRoot
+			clientData.HeadWeight = view.headNode.Weight
+		}
+
+		justifiedVotes[string(clientData.JustifiedRoot)]++
+		finalizedVotes[string(clientData.FinalizedRoot)]++
+		headVotes[string(clientData.HeadRoot)]++
+		pageData.LoadedCount++
+	}
+	pageData.ClientCount = uint64(len(pageData.Clients))
+
+	// compare each client against the majority view
+	majorityJustified := getForkCompareMajority(justifiedVotes)
+	majorityFinalized := getForkCompareMajority(finalizedVotes)
+	majorityHead := getForkCompareMajority(headVotes)
+	for _, clientData := range pageData.Clients {
+		if !clientData.Loaded {
+			continue
+		}
+		clientData.JustifiedMatch = bytes.Equal(clientData.JustifiedRoot, majorityJustified)
+		clientData.FinalizedMatch = bytes.Equal(clientData.FinalizedRoot, majorityFinalized)
+		clientData.HeadMatch = bytes.Equal(clientData.HeadRoot, majorityHead)
+		if clientData.JustifiedMatch && pageData.JustifiedRoot == nil {
+			pageData.JustifiedEpoch = clientData.JustifiedEpoch
+			pageData.JustifiedRoot = clientData.JustifiedRoot
+		}
+		if clientData.FinalizedMatch && pageData.FinalizedRoot == nil {
+			pageData.FinalizedEpoch = clientData.FinalizedEpoch
+			pageData.FinalizedRoot = clientData.FinalizedRoot
+		}
+		if clientData.HeadMatch && pageData.HeadRoot == nil {
+			pageData.HeadSlot = clientData.HeadSlot
+			pageData.HeadRoot = clientData.HeadRoot
+		}
+	}
+
+	// collect the union of all nodes after the majority finalized checkpoint
+	minSlot := pageData.FinalizedEpoch * utils.Config.Chain.Config.SlotsPerEpoch
+	nodeMap := map[string]*models.ForkComparePageDataNode{}
+	for idx := range pageData.Clients {
+		view := clientViews[idx]
+		if view == nil || view.nodeMap == nil {
+			continue
+		}
+		for _, node := range view.forkChoice.Nodes {
+			if node.Slot < minSlot || nodeMap[string(node.BlockRoot)] != nil {
+				continue
+			}
+			nodeData := &models.ForkComparePageDataNode{
+				Slot:    node.Slot,
+				Root:    node.BlockRoot,
+				Weights: make([]*models.ForkComparePageDataWeight, len(pageData.Clients)),
+			}
+			nodeMap[string(node.BlockRoot)] = nodeData
+			pageData.Nodes = append(pageData.Nodes, nodeData)
+		}
+	}
+
+	for _, nodeData := range pageData.Nodes {
+		maxWeight := uint64(0)
+		validity := ""
+		for idx, clientData := range pageData.Clients {
+			weightData := &models.ForkComparePageDataWeight{
+				Loaded: clientData.Loaded,
+			}
+			nodeData.Weights[idx] = weightData
+			if !clientData.Loaded {
+				continue
+			}
+
+			node := clientViews[idx].nodeMap[string(nodeData.Root)]
+			if node == nil && nodeData.Slot < clientData.FinalizedEpoch*utils.Config.Chain.Config.SlotsPerEpoch {
+				// already pruned by the client (finalized further than the majority)
+				weightData.Pruned = true
+				continue
+			}
+			if node == nil {
+				clientData.MissingNodes++
+				nodeData.Divergent = true
+				continue
+			}
+			weightData.Present = true
+			weightData.Weight = node.Weight
+			weightData.Validity = node.Validity
+			weightData.IsHead = bytes.Equal(node.BlockRoot, clientData.HeadRoot)
+			if node.Weight > maxWeight {
+				maxWeight = node.Weight
+			}
+			if validity == "" {
+				validity = node.Validity
+			} else if validity != node.Validity {
+				nodeData.Divergent = true
+			}
+		}
+		if maxWeight > 0 {
+			for _, weightData := range nodeData.Weights {
+				if weightData.Present {
+					weightData.Deviation = float64(maxWeight-weightData.Weight) * 100 / float64(maxWeight)
+				}
+			}
+		}
+		if nodeData.Divergent {
+			pageData.DivergentNodeCount++
+		}
+	}
+
+	for _, clientData := range pageData.Clients {
+		if clientData.Loaded && (!clientData.JustifiedMatch || !clientData.FinalizedMatch || !clientData.HeadMatch || clientData.MissingNodes > 0) {
+			clientData.Divergent = true
+			pageData.DivergentCount++
+		}
+	}
+
+	if divergentOnly {
+		divergentNodes := make([]*models.ForkComparePageDataNode, 0, pageData.DivergentNodeCount)
+		for _, nodeData := range pageData.Nodes {
+			if nodeData.Divergent {
+				divergentNodes = append(divergentNodes, nodeData)
+			}
+		}
+		pageData.Nodes = divergentNodes
+	}
+	sort.Slice(pageData.Nodes, func(a, b int) bool {
+		if pageData.Nodes[a].Slot != pageData.Nodes[b].Slot {
+			return pageData.Nodes[a].Slot > pageData.Nodes[b].Slot
+		}
+		return bytes.Compare(pageData.Nodes[a].Root, pageData.Nodes[b].Root) < 0
+	})
+	pageData.NodeCount = uint64(len(pageData.Nodes))
+
+	return pageData, cacheTime
+}
+
+// getForkChoiceHead runs a simplified LMD-GHOST on the fork choice nodes of a client:
+// starting from the justified checkpoint, follow the heaviest (not invalid) child until reaching a leaf.
+func getForkChoiceHead(forkChoice *rpc.ForkChoice) *rpc.ForkChoiceNode {
+	childMap := map[string][]*rpc.ForkChoiceNode{}
+	var headNode *rpc.ForkChoiceNode
+	for _, node := range forkChoice.Nodes {
+		if node.Validity == "invalid" {
+			continue
+		}
+		childMap[string(node.ParentRoot)] = append(childMap[string(node.ParentRoot)], node)
+		if bytes.Equal(node.BlockRoot, forkChoice.JustifiedCheckpoint.Root) {
+			headNode = node
+		}
+	}
+	if headNode == nil {
+		// justified checkpoint not in the store, start from the oldest node
+		for _, node := range forkChoice.Nodes {
+			if node.Validity != "invalid" && (headNode == nil || node.Slot < headNode.Slot) {
+				headNode = node
+			}
+		}
+	}
+
+	for headNode != nil {
+		var bestChild *rpc.ForkChoiceNode
+		for _, child := range childMap[string(headNode.BlockRoot)] {
+			if bestChild == nil || child.Weight > bestChild.Weight || (child.Weight == bestChild.Weight && bytes.Compare(child.BlockRoot, bestChild.BlockRoot) > 0) {
+				bestChild = child
+			}
+		}
+		if bestChild == nil {
+			break
+		}
+		headNode = bestChild
+	}
+	return headNode
+}
+
+func getForkCompareMajority(votes map[string]int) []byte {
+	var majority []byte
+	majorityCount := 0
+	for key, count := range votes {
+		if count > majorityCount || (count == majorityCount && bytes.Compare([]byte(key), majority) > 0) {
+			majority = []byte(key)
+			majorityCount = count
+		}
+	}
+	return majority
+}
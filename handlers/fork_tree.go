@@ -0,0 +1,196 @@
+package handlers
+
+import (
+	"bytes"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/ethpandaops/dora/indexer"
+	"github.com/ethpandaops/dora/services"
+	"github.com/ethpandaops/dora/templates"
+	"github.com/ethpandaops/dora/types/models"
+	"github.com/ethpandaops/dora/utils"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	forkTreeSlotWidth   = 26
+	forkTreeBranchSpace = 40
+	forkTreePadding     = 30
+)
+
+// ForkTree will return the "fork choice tree" page using a go template
+func ForkTree(w http.ResponseWriter, r *http.Request) {
+	var forkTreeTemplateFiles = append(layoutTemplateFiles,
+		"forks/tree.html",
+	)
+
+	var pageTemplate = templates.GetTemplate(forkTreeTemplateFiles...)
+	data := InitPageData(w, r, "clients", "/forks/tree", "Fork Choice Tree", forkTreeTemplateFiles)
+
+	var pageError error
+	pageError = services.GlobalCallRateLimiter.CheckCallLimit(r, 1)
+	if pageError == nil {
+		data.Data, pageError = getForkTreePageData()
+	}
+	if pageError != nil {
+		handlePageError(w, r, pageError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/html")
+	if handleTemplateError(w, r, "fork_tree.go", "ForkTree", "", pageTemplate.ExecuteTemplate(w, "layout", data)) != nil {
+		return // an error has occurred and was processed
+	}
+}
+
+func getForkTreePageData() (*models.ForkTreePageData, error) {
+	pageData := &models.ForkTreePageData{}
+	pageCacheKey := "forktree"
+	pageRes, pageErr := services.GlobalFrontendCache.ProcessCachedPage(pageCacheKey, true, pageData, func(pageCall *services.FrontendCacheProcessingPage) interface{} {
+		pageData, cacheTimeout := buildForkTreePageData()
+		pageCall.CacheTimeout = cacheTimeout
+		return pageData
+	})
+	if pageErr == nil && pageRes != nil {
+		resData, resOk := pageRes.(*models.ForkTreePageData)
+		if !resOk {
+			return nil, ErrInvalidPageModel
+		}
+		pageData = resData
+	}
+	return pageData, pageErr
+}
+
+func buildForkTreePageData() (*models.ForkTreePageData, time.Duration) {
+	logrus.Debugf("fork tree page called")
+	cacheTime := time.Duration(utils.Config.Chain.Config.SecondsPerSlot) * time.Second
+
+	tree := services.GlobalBeaconService.GetIndexer().GetForkChoiceTree()
+	pageData := &models.ForkTreePageData{
+		FinalizedEpoch: tree.FinalizedEpoch,
+		FinalizedRoot:  tree.FinalizedRoot,
+		JustifiedEpoch: tree.JustifiedEpoch,
+		JustifiedRoot:  tree.JustifiedRoot,
+		HeadSlot:       tree.HeadSlot,
+		HeadRoot:       tree.HeadRoot,
+		VoteCounts:     tree.VoteCounts,
+		Nodes:          make([]*models.ForkTreePageDataNode, 0, len(tree.Nodes)),
+		Edges:          []*models.ForkTreePageDataEdge{},
+		EpochMarkers:   []*models.ForkTreePageDataEpoch{},
+	}
+	if len(tree.Nodes) == 0 {
+		return pageData, cacheTime
+	}
+
+	// assign a branch (row) to each node: the canonical / heaviest child continues the branch of its parent, all other children open a new branch
+	branchMap := map[*indexer.ForkChoiceNode]uint64{}
+	nextBranch := uint64(0)
+	rootNodes := []*indexer.ForkChoiceNode{}
+	for _, node := range tree.Nodes {
+		if node.Parent == nil {
+			rootNodes = append(rootNodes, node)
+			pageData.TotalWeight += node.Weight
+		}
+	}
+	sortForkTreeNodes(rootNodes)
+	for _, node := range rootNodes {
+		branchMap[node] = nextBranch
+		nextBranch++
+	}
+	for _, node := range tree.Nodes {
+		children := append([]*indexer.ForkChoiceNode{}, node.Children...)
+		sortForkTreeNodes(children)
+		for idx, child := range children {
+			if idx == 0 {
+				branchMap[child] = branchMap[node]
+			} else {
+				branchMap[child] = nextBranch
+				nextBranch++
+			}
+		}
+	}
+	pageData.BranchCount = nextBranch
+
+	minSlot := tree.Nodes[0].Block.Slot
+	maxSlot := tree.Nodes[len(tree.Nodes)-1].Block.Slot
+	if currentSlot := utils.TimeToSlot(uint64(time.Now().Unix())); currentSlot > maxSlot {
+		maxSlot = currentSlot
+	}
+	pageData.GraphWidth = 2*forkTreePadding + (maxSlot-minSlot)*forkTreeSlotWidth
+	pageData.GraphHeight = 2*forkTreePadding + (pageData.BranchCount-1)*forkTreeBranchSpace
+
+	for epoch := utils.EpochOfSlot(minSlot) + 1; epoch <= utils.EpochOfSlot(maxSlot); epoch++ {
+		pageData.EpochMarkers = append(pageData.EpochMarkers, &models.ForkTreePageDataEpoch{
+			Epoch: epoch,
+			PosX:  forkTreePadding + (epoch*utils.Config.Chain.Config.SlotsPerEpoch-minSlot)*forkTreeSlotWidth - forkTreeSlotWidth/2,
+		})
+	}
+
+	nodeDataMap := map[*indexer.ForkChoiceNode]*models.ForkTreePageDataNode{}
+	for _, node := range tree.Nodes {
+		nodeData := &models.ForkTreePageDataNode{
+			Slot:        node.Block.Slot,
+			Root:        node.Block.Root,
+			ParentRoot:  node.ParentRoot,
+			HasParent:   node.Parent != nil,
+			Canonical:   node.Canonical,
+			Orphaned:    node.Orphaned,
+			IsFinalized: bytes.Equal(node.Block.Root, tree.FinalizedRoot),
+			IsJustified: bytes.Equal(node.Block.Root, tree.JustifiedRoot),
+			VoteWeight:  node.VoteWeight,
+			Weight:      node.Weight,
+			ChildCount:  uint64(len(node.Children)),
+			Clients:     make([]*models.ForkTreePageDataClient, 0, len(node.HeadClients)),
+			Branch:      branchMap[node],
+			PosX:        forkTreePadding + (node.Block.Slot-minSlot)*forkTreeSlotWidth,
+			PosY:        forkTreePadding + branchMap[node]*forkTreeBranchSpace,
+		}
+		if pageData.TotalWeight > 0 {
+			nodeData.WeightPercent = float64(node.Weight) * 100 / float64(pageData.TotalWeight)
+		}
+		if header := node.Block.GetHeader(); header != nil {
+			nodeData.Proposer = uint64(header.Message.ProposerIndex)
+			nodeData.ProposerName = services.GlobalBeaconService.GetValidatorName(nodeData.Proposer)
+		}
+		for _, client := range node.HeadClients {
+			nodeData.Clients = append(nodeData.Clients, &models.ForkTreePageDataClient{
+				Index:  int(client.GetIndex()) + 1,
+				Name:   client.GetName(),
+				Status: client.GetStatus(),
+			})
+		}
+		if node.Orphaned {
+			pageData.OrphanCount++
+		}
+
+		if parentData := nodeDataMap[node.Parent]; parentData != nil {
+			pageData.Edges = append(pageData.Edges, &models.ForkTreePageDataEdge{
+				FromX:     parentData.PosX,
+				FromY:     parentData.PosY,
+				ToX:       nodeData.PosX,
+				ToY:       nodeData.PosY,
+				Canonical: node.Canonical,
+			})
+		}
+
+		nodeDataMap[node] = nodeData
+		pageData.Nodes = append(pageData.Nodes, nodeData)
+	}
+	pageData.NodeCount = uint64(len(pageData.Nodes))
+
+	return pageData, cacheTime
+}
+
+// sortForkTreeNodes orders sibling nodes by relevance: canonical first, then by weight & slot
+func sortForkTreeNodes(nodes []*indexer.ForkChoiceNode) {
+	sort.SliceStable(nodes, func(a, b int) bool {
+		if nodes[a].Canonical != nodes[b].Canonical {
+			return nodes[a].Canonical
+		}
+		if nodes[a].Weight != nodes[b].Weight {
+			return nodes[a].Weight > nodes[b].Weight
+		}
+		return nodes[a].Block.Slot < nodes[b].Block.Slot
+	})
+}
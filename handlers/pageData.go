@@ -114,6 +114,11 @@ func createMenuItems(active string) []types.MainMenuItem {
 				Path:  "/slots",
 				Icon:  "fa-cube",
 			},
+			{
+				Label: "Blobs",
+				Path:  "/blobs",
+				Icon:  "fa-database",
+			},
 		},
 	})
 	if len(utils.Config.MevIndexer.Relays) > 0 {
@@ -145,9 +150,25 @@ func createMenuItems(active string) []types.MainMenuItem {
 	}
 
 	clientLinks = append(clientLinks, types.NavigationLink{
+		Label: "Client Diversity",
+		Path:  "/clients/diversity",
+		Icon:  "fa-chart-pie",
+	}, types.NavigationLink{
 		Label: "Forks",
 		Path:  "/forks",
 		Icon:  "fa-code-fork",
+	}, types.NavigationLink{
+		Label: "Fork Choice Tree",
+		Path:  "/forks/tree",
+		Icon:  "fa-sitemap",
+	}, types.NavigationLink{
+		Label: "Fork Choice Comparison",
+		Path:  "/forks/compare",
+		Icon:  "fa-code-compare",
+	}, types.NavigationLink{
+		Label: "Reorgs",
+		Path:  "/reorgs",
+		Icon:  "fa-shuffle",
 	})
 
 	clientsMenu = append(clientsMenu, types.NavigationGroup{
@@ -166,6 +187,16 @@ func createMenuItems(active string) []types.MainMenuItem {
 				Path:  "/validators/activity",
 				Icon:  "fa-tachometer",
 			},
+			{
+				Label: "Validator Queues",
+				Path:  "/validators/queues",
+				Icon:  "fa-hourglass-half",
+			},
+			{
+				Label: "Sync Committees",
+				Path:  "/validators/sync_committees",
+				Icon:  "fa-users",
+			},
 		},
 	})
 	validatorMenu = append(validatorMenu, types.NavigationGroup{
@@ -175,6 +206,11 @@ func createMenuItems(active string) []types.MainMenuItem {
 				Path:  "/validators/deposits",
 				Icon:  "fa-file-signature",
 			},
+			{
+				Label: "Eth1 Voting",
+				Path:  "/validators/eth1_votes",
+				Icon:  "fa-check-to-slot",
+			},
 		},
 	})
 	validatorMenu = append(validatorMenu, types.NavigationGroup{
@@ -191,6 +227,20 @@ func createMenuItems(active string) []types.MainMenuItem {
 			},
 		},
 	})
+	validatorMenu = append(validatorMenu, types.NavigationGroup{
+		Links: []types.NavigationLink{
+			{
+				Label: "Withdrawals",
+				Path:  "/validators/withdrawals",
+				Icon:  "fa-money-bill-transfer",
+			},
+			{
+				Label: "BLS Changes",
+				Path:  "/validators/bls_changes",
+				Icon:  "fa-key",
+			},
+		},
+	})
 
 	return []types.MainMenuItem{
 		{
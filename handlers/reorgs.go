@@ -0,0 +1,193 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ethpandaops/dora/db"
+	"github.com/ethpandaops/dora/dbtypes"
+	"github.com/ethpandaops/dora/services"
+	"github.com/ethpandaops/dora/templates"
+	"github.com/ethpandaops/dora/types/models"
+	"github.com/ethpandaops/dora/utils"
+	"github.com/sirupsen/logrus"
+)
+
+// Reorgs will return the filtered "reorgs" page using a go template
+func Reorgs(w http.ResponseWriter, r *http.Request) {
+	var templateFiles = append(layoutTemplateFiles,
+		"reorgs/reorgs.html",
+		"_svg/professor.html",
+	)
+
+	var pageTemplate = templates.GetTemplate(templateFiles...)
+	data := InitPageData(w, r, "clients", "/reorgs", "Reorgs", templateFiles)
+
+	urlArgs := r.URL.Query()
+	var pageSize uint64 = 50
+	if urlArgs.Has("c") {
+		pageSize, _ = strconv.ParseUint(urlArgs.Get("c"), 10, 64)
+	}
+	var pageIdx uint64 = 1
+	if urlArgs.Has("p") {
+		pageIdx, _ = strconv.ParseUint(urlArgs.Get("p"), 10, 64)
+		if pageIdx < 1 {
+			pageIdx = 1
+		}
+	}
+
+	var minSlot uint64
+	var maxSlot uint64
+	var minDepth uint64
+
+	if urlArgs.Has("f") {
+		if urlArgs.Has("f.mins") {
+			minSlot, _ = strconv.ParseUint(urlArgs.Get("f.mins"), 10, 64)
+		}
+		if urlArgs.Has("f.maxs") {
+			maxSlot, _ = strconv.ParseUint(urlArgs.Get("f.maxs"), 10, 64)
+		}
+		if urlArgs.Has("f.mind") {
+			minDepth, _ = strconv.ParseUint(urlArgs.Get("f.mind"), 10, 64)
+		}
+	}
+	var pageError error
+	pageError = services.GlobalCallRateLimiter.CheckCallLimit(r, 2)
+	if pageError == nil {
+		data.Data, pageError = getFilteredReorgsPageData(pageIdx, pageSize, minSlot, maxSlot, minDepth)
+	}
+	if pageError != nil {
+		handlePageError(w, r, pageError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/html")
+	if handleTemplateError(w, r, "reorgs.go", "Reorgs", "", pageTemplate.ExecuteTemplate(w, "layout", data)) != nil {
+		return // an error has occurred and was processed
+	}
+}
+
+func getFilteredReorgsPageData(pageIdx uint64, pageSize uint64, minSlot uint64, maxSlot uint64, minDepth uint64) (*models.ReorgsPageData, error) {
+	pageData := &models.ReorgsPageData{}
+	pageCacheKey := fmt.Sprintf("reorgs:%v:%v:%v:%v:%v", pageIdx, pageSize, minSlot, maxSlot, minDepth)
+	pageRes, pageErr := services.GlobalFrontendCache.ProcessCachedPage(pageCacheKey, true, pageData, func(_ *services.FrontendCacheProcessingPage) interface{} {
+		return buildFilteredReorgsPageData(pageIdx, pageSize, minSlot, maxSlot, minDepth)
+	})
+	if pageErr == nil && pageRes != nil {
+		resData, resOk := pageRes.(*models.ReorgsPageData)
+		if !resOk {
+			return nil, ErrInvalidPageModel
+		}
+		pageData = resData
+	}
+	return pageData, pageErr
+}
+
+func buildFilteredReorgsPageData(pageIdx uint64, pageSize uint64, minSlot uint64, maxSlot uint64, minDepth uint64) *models.ReorgsPageData {
+	filterArgs := url.Values{}
+	if minSlot != 0 {
+		filterArgs.Add("f.mins", fmt.Sprintf("%v", minSlot))
+	}
+	if maxSlot != 0 {
+		filterArgs.Add("f.maxs", fmt.Sprintf("%v", maxSlot))
+	}
+	if minDepth != 0 {
+		filterArgs.Add("f.mind", fmt.Sprintf("%v", minDepth))
+	}
+
+	pageData := &models.ReorgsPageData{
+		FilterMinSlot:  minSlot,
+		FilterMaxSlot:  maxSlot,
+		FilterMinDepth: minDepth,
+	}
+	logrus.Debugf("reorgs page called: %v:%v [%v,%v,%v]", pageIdx, pageSize, minSlot, maxSlot, minDepth)
+	if pageIdx == 1 {
+		pageData.IsDefaultPage = true
+	}
+
+	if pageSize > 100 {
+		pageSize = 100
+	}
+	if pageSize < 1 {
+		pageSize = 1
+	}
+	pageData.PageSize = pageSize
+	pageData.TotalPages = pageIdx
+	pageData.CurrentPageIndex = pageIdx
+	if pageIdx > 1 {
+		pageData.PrevPageIndex = pageIdx - 1
+	}
+
+	// load reorgs
+	reorgFilter := &dbtypes.ReorgFilter{
+		MinSlot:  minSlot,
+		MaxSlot:  maxSlot,
+		MinDepth: minDepth,
+	}
+
+	dbReorgs, totalRows, err := db.GetReorgsFiltered((pageIdx-1)*pageSize, uint32(pageSize), reorgFilter)
+	if err != nil {
+		logrus.Warnf("error loading reorgs: %v", err)
+	}
+
+	for _, reorg := range dbReorgs {
+		reorgData := &models.ReorgsPageDataReorg{
+			SlotNumber:     reorg.SlotNumber,
+			HeadRoot:       reorg.HeadRoot,
+			Time:           utils.SlotToTime(reorg.SlotNumber),
+			OldHeadSlot:    reorg.OldHeadSlot,
+			OldHeadRoot:    reorg.OldHeadRoot,
+			Depth:          reorg.Depth,
+			DetectedTime:   time.Unix(int64(reorg.DetectedTime), 0),
+			OldHeadClients: splitReorgClients(reorg.OldHeadClients),
+			NewHeadClients: splitReorgClients(reorg.NewHeadClients),
+		}
+
+		if reorg.AncestorSlot != nil {
+			reorgData.HasAncestor = true
+			reorgData.AncestorSlot = *reorg.AncestorSlot
+			reorgData.AncestorRoot = reorg.AncestorRoot
+			reorgData.FirstAffectedSlot = *reorg.AncestorSlot + 1
+		} else if reorg.OldHeadSlot+1 > reorg.Depth {
+			reorgData.FirstAffectedSlot = reorg.OldHeadSlot + 1 - reorg.Depth
+		}
+		reorgData.LastAffectedSlot = reorg.SlotNumber
+		if reorg.OldHeadSlot > reorgData.LastAffectedSlot {
+			reorgData.LastAffectedSlot = reorg.OldHeadSlot
+		}
+
+		pageData.Reorgs = append(pageData.Reorgs, reorgData)
+	}
+	pageData.ReorgCount = uint64(len(pageData.Reorgs))
+
+	if pageData.ReorgCount > 0 {
+		pageData.FirstSlot = pageData.Reorgs[0].SlotNumber
+		pageData.LastSlot = pageData.Reorgs[pageData.ReorgCount-1].SlotNumber
+	}
+
+	pageData.TotalPages = totalRows / pageSize
+	if totalRows%pageSize > 0 {
+		pageData.TotalPages++
+	}
+	pageData.LastPageIndex = pageData.TotalPages
+	if pageIdx < pageData.TotalPages {
+		pageData.NextPageIndex = pageIdx + 1
+	}
+
+	pageData.FirstPageLink = fmt.Sprintf("/reorgs?f&%v&c=%v", filterArgs.Encode(), pageData.PageSize)
+	pageData.PrevPageLink = fmt.Sprintf("/reorgs?f&%v&c=%v&p=%v", filterArgs.Encode(), pageData.PageSize, pageData.PrevPageIndex)
+	pageData.NextPageLink = fmt.Sprintf("/reorgs?f&%v&c=%v&p=%v", filterArgs.Encode(), pageData.PageSize, pageData.NextPageIndex)
+	pageData.LastPageLink = fmt.Sprintf("/reorgs?f&%v&c=%v&p=%v", filterArgs.Encode(), pageData.PageSize, pageData.LastPageIndex)
+
+	return pageData
+}
+
+func splitReorgClients(clients string) []string {
+	if clients == "" {
+		return []string{}
+	}
+	return strings.Split(clients, ",")
+}
@@ -69,6 +69,28 @@ func Search(w http.ResponseWriter, r *http.Request) {
 				}
 				return
 			}
+
+			if txDetails := services.GlobalBeaconService.GetTransactionDetails(blockHash); txDetails != nil {
+				http.Redirect(w, r, fmt.Sprintf("/tx/0x%x", txDetails.Transaction.TxHash), http.StatusMovedPermanently)
+				return
+			}
+
+			if commitment := db.GetBlobCommitmentByVersionedHash(blockHash); commitment != nil {
+				http.Redirect(w, r, fmt.Sprintf("/blob/0x%x", blockHash), http.StatusMovedPermanently)
+				return
+			}
+		}
+	} else if len(hashQuery) == 96 {
+		commitment, err := hex.DecodeString(hashQuery)
+		if err == nil && db.GetLatestBlobAssignment(commitment) != nil {
+			http.Redirect(w, r, fmt.Sprintf("/blob/0x%x", commitment), http.StatusMovedPermanently)
+			return
+		}
+	} else if len(hashQuery) == 40 {
+		address, err := hex.DecodeString(hashQuery)
+		if err == nil {
+			http.Redirect(w, r, fmt.Sprintf("/address/0x%x", address), http.StatusMovedPermanently)
+			return
 		}
 	}
 
@@ -333,6 +355,30 @@ func SearchAhead(w http.ResponseWriter, r *http.Request) {
 				}
 			}
 		}
+	case "transactions":
+		if len(search) != 64 {
+			break
+		}
+		txHash, decodeErr := hex.DecodeString(search)
+		if decodeErr != nil {
+			break
+		}
+		transactions := &dbtypes.SearchAheadTransactionsResult{}
+		err = db.ReaderDb.Select(transactions, `
+			SELECT tx_hash, block_number
+			FROM el_transactions
+			WHERE tx_hash = $1
+			LIMIT 1`, txHash)
+		if err == nil {
+			model := make([]models.SearchAheadTransactionsResult, len(*transactions))
+			for i, entry := range *transactions {
+				model[i] = models.SearchAheadTransactionsResult{
+					TxHash:      fmt.Sprintf("0x%x", entry.TxHash),
+					BlockNumber: entry.BlockNumber,
+				}
+			}
+			result = model
+		}
 	case "graffiti":
 		graffiti := &dbtypes.SearchAheadGraffitiResult{}
 		err = db.ReaderDb.Select(graffiti, db.EngineQuery(map[dbtypes.DBEngineType]string{
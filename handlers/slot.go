@@ -14,11 +14,13 @@ import (
 
 	"github.com/attestantio/go-eth2-client/spec"
 	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/gorilla/mux"
 	"github.com/juliangruber/go-intersect"
 	"github.com/sirupsen/logrus"
 
+	"github.com/ethpandaops/dora/blobstore"
 	"github.com/ethpandaops/dora/db"
 	"github.com/ethpandaops/dora/dbtypes"
 	"github.com/ethpandaops/dora/rpc"
@@ -41,6 +43,7 @@ func Slot(w http.ResponseWriter, r *http.Request) {
 		"slot/voluntary_exits.html",
 		"slot/slashings.html",
 		"slot/blobs.html",
+		"slot/execution_requests.html",
 	)
 	var notfoundTemplateFiles = append(layoutTemplateFiles,
 		"slot/notfound.html",
@@ -103,6 +106,7 @@ func Slot(w http.ResponseWriter, r *http.Request) {
 			}
 			if blobModel != nil {
 				blobModel.KzgProof = blobData.Proof
+				blobModel.Pruned = blobData.Pruned
 				if blobData.Blob != nil {
 					blobModel.HaveData = true
 					blobModel.Blob = *blobData.Blob
@@ -153,6 +157,7 @@ func SlotBlob(w http.ResponseWriter, r *http.Request) {
 	result := &models.SlotPageBlobDetails{
 		KzgCommitment: fmt.Sprintf("%x", blobData.Commitment),
 		KzgProof:      fmt.Sprintf("%x", blobData.Proof),
+		Pruned:        blobData.Pruned,
 	}
 	if blobData.Blob != nil {
 		result.Blob = fmt.Sprintf("%x", *blobData.Blob)
@@ -291,6 +296,9 @@ func buildSlotPageData(blockSlot int64, blockRoot []byte, loadDuties bool) (*mod
 		pageData.ProposerName = services.GlobalBeaconService.GetValidatorName(pageData.Proposer)
 		pageData.Block = getSlotPageBlockData(blockData, assignments, loadDuties)
 
+		blockRewards := services.GlobalBeaconService.GetBlockRewards(blockData.Root)
+		pageData.Block.Reward = buildSlotReward(blockRewards.ClReward, blockRewards.ClRewardAttestations, blockRewards.ClRewardSync, blockRewards.ClRewardSlashings, blockRewards.ElPriorityFees)
+
 		// check mev block
 		if pageData.Block.ExecutionData != nil {
 			mevBlock := db.GetMevBlockByBlockHash(pageData.Block.ExecutionData.BlockHash)
@@ -350,7 +358,16 @@ func getSlotPageBlockData(blockData *services.CombinedBlockResponse, assignments
 		DenyDutyLoading:        !utils.Config.Frontend.AllowDutyLoading,
 	}
 
+	// verify the voted deposit root against the deposit tree (unknown if the deposit tree is not synchronized that far)
+	if depositRoot := services.GlobalBeaconService.GetIndexer().GetDepositTreeRoot(eth1Data.DepositCount); depositRoot != nil {
+		pageData.Eth1dataRootChecked = true
+		pageData.Eth1dataRootValid = bytes.Equal(depositRoot, eth1Data.DepositRoot[:])
+	}
+
 	epoch := utils.EpochOfSlot(uint64(blockData.Header.Message.Slot))
+	if utils.Config.Chain.Config.EpochsPerEth1VotingPeriod > 0 {
+		pageData.Eth1dataVotingPeriod = epoch / utils.Config.Chain.Config.EpochsPerEth1VotingPeriod
+	}
 	assignmentsMap := make(map[uint64]*rpc.EpochAssignments)
 	assignmentsLoaded := make(map[uint64]bool)
 	assignmentsMap[epoch] = assignments
@@ -612,14 +629,91 @@ func getSlotPageBlockData(blockData *services.CombinedBlockResponse, assignments
 			blobData := &models.SlotPageBlob{
 				Index:         uint64(i),
 				KzgCommitment: blobKzgCommitments[i][:],
+				VersionedHash: blobstore.GetBlobVersionedHash(blobKzgCommitments[i][:]),
 			}
 			pageData.Blobs[i] = blobData
 		}
 	}
 
+	if utils.IsElectraEpoch(epoch) {
+		getSlotPageExecutionRequests(pageData, uint64(blockData.Header.Message.Slot))
+	}
+
 	return pageData
 }
 
+func getSlotPageExecutionRequests(pageData *models.SlotPageBlockData, slot uint64) {
+	validatorSetRsp := services.GlobalBeaconService.GetCachedValidatorPubkeyMap()
+	getValidator := func(pubkey []byte) (uint64, string, bool) {
+		validator := validatorSetRsp[phase0.BLSPubKey(pubkey)]
+		if validator == nil {
+			return 0, "", false
+		}
+		return uint64(validator.Index), services.GlobalBeaconService.GetValidatorName(uint64(validator.Index)), true
+	}
+
+	depositRequests, _ := services.GlobalBeaconService.GetDepositRequestsByFilter(&dbtypes.DepositRequestFilter{
+		MinSlot:      slot,
+		MaxSlot:      slot,
+		WithOrphaned: 1,
+	}, 0, uint32(utils.Config.Chain.Config.MaxDepositRequestsPerPayload))
+	pageData.DepositRequests = make([]*models.SlotPageDepositRequest, 0)
+	for _, depositRequest := range depositRequests {
+		if !bytes.Equal(depositRequest.SlotRoot, pageData.BlockRoot) {
+			continue
+		}
+		pageData.DepositRequests = append(pageData.DepositRequests, &models.SlotPageDepositRequest{
+			Index:                 depositRequest.DepositIndex,
+			PublicKey:             depositRequest.PublicKey,
+			Withdrawalcredentials: depositRequest.WithdrawalCredentials,
+			Amount:                depositRequest.Amount,
+			Signature:             depositRequest.Signature,
+		})
+	}
+	pageData.DepositRequestsCount = uint64(len(pageData.DepositRequests))
+
+	withdrawalRequests, _ := services.GlobalBeaconService.GetWithdrawalRequestsByFilter(&dbtypes.WithdrawalRequestFilter{
+		MinSlot:      slot,
+		MaxSlot:      slot,
+		WithOrphaned: 1,
+	}, 0, uint32(utils.Config.Chain.Config.MaxWithdrawalRequestsPerPayload))
+	pageData.WithdrawalRequests = make([]*models.SlotPageWithdrawalRequest, 0)
+	for _, withdrawalRequest := range withdrawalRequests {
+		if !bytes.Equal(withdrawalRequest.SlotRoot, pageData.BlockRoot) {
+			continue
+		}
+		requestData := &models.SlotPageWithdrawalRequest{
+			SourceAddress:   withdrawalRequest.SourceAddress,
+			ValidatorPubkey: withdrawalRequest.ValidatorPubkey,
+			Amount:          withdrawalRequest.Amount,
+		}
+		requestData.ValidatorIndex, requestData.ValidatorName, requestData.ValidatorValid = getValidator(withdrawalRequest.ValidatorPubkey)
+		pageData.WithdrawalRequests = append(pageData.WithdrawalRequests, requestData)
+	}
+	pageData.WithdrawalRequestsCount = uint64(len(pageData.WithdrawalRequests))
+
+	consolidationRequests, _ := services.GlobalBeaconService.GetConsolidationRequestsByFilter(&dbtypes.ConsolidationRequestFilter{
+		MinSlot:      slot,
+		MaxSlot:      slot,
+		WithOrphaned: 1,
+	}, 0, uint32(utils.Config.Chain.Config.MaxConsolidationRequestsPerPayload))
+	pageData.ConsolidationRequests = make([]*models.SlotPageConsolidationRequest, 0)
+	for _, consolidationRequest := range consolidationRequests {
+		if !bytes.Equal(consolidationRequest.SlotRoot, pageData.BlockRoot) {
+			continue
+		}
+		requestData := &models.SlotPageConsolidationRequest{
+			SourceAddress: consolidationRequest.SourceAddress,
+			SourcePubkey:  consolidationRequest.SourcePubkey,
+			TargetPubkey:  consolidationRequest.TargetPubkey,
+		}
+		requestData.SourceIndex, requestData.SourceName, requestData.SourceValid = getValidator(consolidationRequest.SourcePubkey)
+		requestData.TargetIndex, requestData.TargetName, requestData.TargetValid = getValidator(consolidationRequest.TargetPubkey)
+		pageData.ConsolidationRequests = append(pageData.ConsolidationRequests, requestData)
+	}
+	pageData.ConsolidationRequestsCount = uint64(len(pageData.ConsolidationRequests))
+}
+
 func getSlotPageTransactions(pageData *models.SlotPageBlockData, tranactions []bellatrix.Transaction) {
 	pageData.Transactions = make([]*models.SlotPageTransaction, 0)
 	sigLookupBytes := []types.TxSignatureBytes{}
@@ -697,3 +791,30 @@ func getSlotPageTransactions(pageData *models.SlotPageBlockData, tranactions []b
 		}
 	}
 }
+
+// buildSlotReward combines the proposer rewards of a block (returns nil if neither cl rewards nor priority fees are known)
+func buildSlotReward(clReward, clRewardAttestations, clRewardSync, clRewardSlashings, elPriorityFees *uint64) *models.SlotReward {
+	if clReward == nil && elPriorityFees == nil {
+		return nil
+	}
+	reward := &models.SlotReward{}
+	if clReward != nil {
+		reward.WithClReward = true
+		reward.ClReward = *clReward
+		if clRewardAttestations != nil {
+			reward.ClRewardAttestations = *clRewardAttestations
+		}
+		if clRewardSync != nil {
+			reward.ClRewardSync = *clRewardSync
+		}
+		if clRewardSlashings != nil {
+			reward.ClRewardSlashings = *clRewardSlashings
+		}
+	}
+	if elPriorityFees != nil {
+		reward.WithPriorityFees = true
+		reward.ElPriorityFees = *elPriorityFees
+	}
+	reward.Total = reward.ClReward + reward.ElPriorityFees
+	return reward
+}
@@ -157,6 +157,7 @@ func buildSlotsPageData(firstSlot uint64, pageSize uint64) (*models.SlotsPageDat
 				ProposerSlashingCount: dbSlot.ProposerSlashingCount,
 				AttesterSlashingCount: dbSlot.AttesterSlashingCount,
 				SyncParticipation:     float64(dbSlot.SyncParticipation) * 100,
+				Reward:                buildSlotReward(dbSlot.ClReward, dbSlot.ClRewardAttestations, dbSlot.ClRewardSync, dbSlot.ClRewardSlashings, dbSlot.ElPriorityFees),
 				EthTransactionCount:   dbSlot.EthTransactionCount,
 				Graffiti:              dbSlot.Graffiti,
 				BlockRoot:             dbSlot.Root,
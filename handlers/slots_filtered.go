@@ -9,6 +9,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethpandaops/dora/dbtypes"
 	"github.com/ethpandaops/dora/services"
 	"github.com/ethpandaops/dora/templates"
@@ -43,6 +44,7 @@ func SlotsFiltered(w http.ResponseWriter, r *http.Request) {
 
 	var graffiti string
 	var extradata string
+	var feeRecipient string
 	var proposer string
 	var pname string
 	var withOrphaned uint64
@@ -55,6 +57,9 @@ func SlotsFiltered(w http.ResponseWriter, r *http.Request) {
 		if urlArgs.Has("f.extra") {
 			extradata = urlArgs.Get("f.extra")
 		}
+		if urlArgs.Has("f.feerecipient") {
+			feeRecipient = urlArgs.Get("f.feerecipient")
+		}
 		if urlArgs.Has("f.proposer") {
 			proposer = urlArgs.Get("f.proposer")
 		}
@@ -74,7 +79,7 @@ func SlotsFiltered(w http.ResponseWriter, r *http.Request) {
 	var pageError error
 	pageError = services.GlobalCallRateLimiter.CheckCallLimit(r, 2)
 	if pageError == nil {
-		data.Data, pageError = getFilteredSlotsPageData(pageIdx, pageSize, graffiti, extradata, proposer, pname, uint8(withOrphaned), uint8(withMissing), displayColumns)
+		data.Data, pageError = getFilteredSlotsPageData(pageIdx, pageSize, graffiti, extradata, feeRecipient, proposer, pname, uint8(withOrphaned), uint8(withMissing), displayColumns)
 	}
 	if pageError != nil {
 		handlePageError(w, r, pageError)
@@ -86,11 +91,11 @@ func SlotsFiltered(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-func getFilteredSlotsPageData(pageIdx uint64, pageSize uint64, graffiti string, extradata string, proposer string, pname string, withOrphaned uint8, withMissing uint8, displayColumns string) (*models.SlotsFilteredPageData, error) {
+func getFilteredSlotsPageData(pageIdx uint64, pageSize uint64, graffiti string, extradata string, feeRecipient string, proposer string, pname string, withOrphaned uint8, withMissing uint8, displayColumns string) (*models.SlotsFilteredPageData, error) {
 	pageData := &models.SlotsFilteredPageData{}
-	pageCacheKey := fmt.Sprintf("slots_filtered:%v:%v:%v:%v:%v:%v:%v:%v:%v", pageIdx, pageSize, graffiti, extradata, proposer, pname, withOrphaned, withMissing, displayColumns)
+	pageCacheKey := fmt.Sprintf("slots_filtered:%v:%v:%v:%v:%v:%v:%v:%v:%v:%v", pageIdx, pageSize, graffiti, extradata, feeRecipient, proposer, pname, withOrphaned, withMissing, displayColumns)
 	pageRes, pageErr := services.GlobalFrontendCache.ProcessCachedPage(pageCacheKey, true, pageData, func(_ *services.FrontendCacheProcessingPage) interface{} {
-		return buildFilteredSlotsPageData(pageIdx, pageSize, graffiti, extradata, proposer, pname, withOrphaned, withMissing, displayColumns)
+		return buildFilteredSlotsPageData(pageIdx, pageSize, graffiti, extradata, feeRecipient, proposer, pname, withOrphaned, withMissing, displayColumns)
 	})
 	if pageErr == nil && pageRes != nil {
 		resData, resOk := pageRes.(*models.SlotsFilteredPageData)
@@ -102,7 +107,7 @@ func getFilteredSlotsPageData(pageIdx uint64, pageSize uint64, graffiti string,
 	return pageData, pageErr
 }
 
-func buildFilteredSlotsPageData(pageIdx uint64, pageSize uint64, graffiti string, extradata string, proposer string, pname string, withOrphaned uint8, withMissing uint8, displayColumns string) *models.SlotsFilteredPageData {
+func buildFilteredSlotsPageData(pageIdx uint64, pageSize uint64, graffiti string, extradata string, feeRecipient string, proposer string, pname string, withOrphaned uint8, withMissing uint8, displayColumns string) *models.SlotsFilteredPageData {
 	filterArgs := url.Values{}
 	if graffiti != "" {
 		filterArgs.Add("f.graffiti", graffiti)
@@ -110,6 +115,9 @@ func buildFilteredSlotsPageData(pageIdx uint64, pageSize uint64, graffiti string
 	if extradata != "" {
 		filterArgs.Add("f.extra", extradata)
 	}
+	if feeRecipient != "" {
+		filterArgs.Add("f.feerecipient", feeRecipient)
+	}
 	if proposer != "" {
 		filterArgs.Add("f.proposer", proposer)
 	}
@@ -167,6 +175,7 @@ func buildFilteredSlotsPageData(pageIdx uint64, pageSize uint64, graffiti string
 	pageData := &models.SlotsFilteredPageData{
 		FilterGraffiti:     graffiti,
 		FilterExtraData:    extradata,
+		FilterFeeRecipient: feeRecipient,
 		FilterProposer:     proposer,
 		FilterProposerName: pname,
 		FilterWithOrphaned: withOrphaned,
@@ -212,6 +221,7 @@ func buildFilteredSlotsPageData(pageIdx uint64, pageSize uint64, graffiti string
 	blockFilter := &dbtypes.BlockFilter{
 		Graffiti:     graffiti,
 		ExtraData:    extradata,
+		FeeRecipient: common.FromHex(feeRecipient),
 		ProposerName: pname,
 		WithOrphaned: withOrphaned,
 		WithMissing:  withMissing,
@@ -0,0 +1,136 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/ethpandaops/dora/services"
+	"github.com/ethpandaops/dora/templates"
+	"github.com/ethpandaops/dora/types/models"
+	"github.com/ethpandaops/dora/utils"
+	"github.com/sirupsen/logrus"
+)
+
+// SyncCommittees will return the "sync_committees" page using a go template
+func SyncCommittees(w http.ResponseWriter, r *http.Request) {
+	var templateFiles = append(layoutTemplateFiles,
+		"sync_committees/sync_committees.html",
+	)
+
+	var pageTemplate = templates.GetTemplate(templateFiles...)
+	data := InitPageData(w, r, "validators", "/validators/sync_committees", "Sync Committees", templateFiles)
+
+	currentPeriod := uint64(utils.TimeToEpoch(time.Now())) / utils.Config.Chain.Config.EpochsPerSyncCommitteePeriod
+	period := currentPeriod
+	urlArgs := r.URL.Query()
+	if urlArgs.Has("p") {
+		period, _ = strconv.ParseUint(urlArgs.Get("p"), 10, 64)
+	}
+	if period > currentPeriod+1 {
+		period = currentPeriod + 1
+	}
+	altairPeriod := utils.Config.Chain.Config.AltairForkEpoch / utils.Config.Chain.Config.EpochsPerSyncCommitteePeriod
+	if period < altairPeriod {
+		period = altairPeriod
+	}
+
+	var pageError error
+	pageError = services.GlobalCallRateLimiter.CheckCallLimit(r, 2)
+	if pageError == nil {
+		data.Data, pageError = getSyncCommitteesPageData(period, currentPeriod)
+	}
+	if pageError != nil {
+		handlePageError(w, r, pageError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/html")
+	if handleTemplateError(w, r, "sync_committees.go", "SyncCommittees", "", pageTemplate.ExecuteTemplate(w, "layout", data)) != nil {
+		return // an error has occurred and was processed
+	}
+}
+
+func getSyncCommitteesPageData(period uint64, currentPeriod uint64) (*models.SyncCommitteesPageData, error) {
+	pageData := &models.SyncCommitteesPageData{}
+	pageCacheKey := fmt.Sprintf("sync_committees:%v:%v", period, currentPeriod)
+	pageRes, pageErr := services.GlobalFrontendCache.ProcessCachedPage(pageCacheKey, true, pageData, func(pageCall *services.FrontendCacheProcessingPage) interface{} {
+		pageData, cacheTimeout := buildSyncCommitteesPageData(period, currentPeriod)
+		pageCall.CacheTimeout = cacheTimeout
+		return pageData
+	})
+	if pageErr == nil && pageRes != nil {
+		resData, resOk := pageRes.(*models.SyncCommitteesPageData)
+		if !resOk {
+			return nil, ErrInvalidPageModel
+		}
+		pageData = resData
+	}
+	return pageData, pageErr
+}
+
+func buildSyncCommitteesPageData(period uint64, currentPeriod uint64) (*models.SyncCommitteesPageData, time.Duration) {
+	logrus.Debugf("sync committees page called: %v", period)
+	epochsPerPeriod := utils.Config.Chain.Config.EpochsPerSyncCommitteePeriod
+	altairPeriod := utils.Config.Chain.Config.AltairForkEpoch / epochsPerPeriod
+
+	pageData := &models.SyncCommitteesPageData{
+		Period:        period,
+		CurrentPeriod: currentPeriod,
+		FirstEpoch:    period * epochsPerPeriod,
+		LastEpoch:     (period+1)*epochsPerPeriod - 1,
+		IsCurrent:     period == currentPeriod,
+		IsNext:        period == currentPeriod+1,
+		HasPrevPeriod: period > altairPeriod,
+		HasNextPeriod: period <= currentPeriod,
+	}
+	pageData.StartTs = utils.EpochToTime(pageData.FirstEpoch)
+	pageData.EndTs = utils.EpochToTime(pageData.LastEpoch + 1)
+	if pageData.HasPrevPeriod {
+		pageData.PrevPeriod = period - 1
+	}
+	if pageData.HasNextPeriod {
+		pageData.NextPeriod = period + 1
+	}
+
+	members := services.GlobalBeaconService.GetSyncCommitteeMembers(period)
+	pageData.Members = make([]*models.SyncCommitteesPageDataMember, 0, len(members))
+	if len(members) > 0 {
+		var memberStats []*services.SyncCommitteeMemberStats
+		if !pageData.IsNext {
+			firstSlot := pageData.FirstEpoch * utils.Config.Chain.Config.SlotsPerEpoch
+			lastSlot := (pageData.LastEpoch+1)*utils.Config.Chain.Config.SlotsPerEpoch - 1
+			memberStats = services.GlobalBeaconService.GetSyncCommitteeMemberStats(members, firstSlot, lastSlot)
+		}
+
+		totalSigned := uint64(0)
+		totalDuties := uint64(0)
+		for idx, validator := range members {
+			member := &models.SyncCommitteesPageDataMember{
+				Position: uint64(idx),
+				Index:    validator,
+				Name:     services.GlobalBeaconService.GetValidatorName(validator),
+			}
+			if memberStats != nil {
+				member.Signed = memberStats[idx].Signed
+				member.Missed = memberStats[idx].Missed
+				if member.Signed+member.Missed > 0 {
+					member.Participation = float64(member.Signed) * 100 / float64(member.Signed+member.Missed)
+				}
+				totalSigned += member.Signed
+				totalDuties += member.Signed + member.Missed
+				pageData.SlotCount = member.Signed + member.Missed
+			}
+			pageData.Members = append(pageData.Members, member)
+		}
+		if totalDuties > 0 {
+			pageData.Participation = float64(totalSigned) * 100 / float64(totalDuties)
+		}
+	}
+	pageData.MemberCount = uint64(len(pageData.Members))
+
+	if period < currentPeriod {
+		return pageData, 1 * time.Hour
+	}
+	return pageData, 1 * time.Minute
+}
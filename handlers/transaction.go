@@ -0,0 +1,166 @@
+package handlers
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/sirupsen/logrus"
+
+	"github.com/ethpandaops/dora/services"
+	"github.com/ethpandaops/dora/templates"
+	"github.com/ethpandaops/dora/types"
+	"github.com/ethpandaops/dora/types/models"
+	"github.com/ethpandaops/dora/utils"
+)
+
+// Transaction will return the "transaction" page using a go template
+func Transaction(w http.ResponseWriter, r *http.Request) {
+	var transactionTemplateFiles = append(layoutTemplateFiles,
+		"transaction/transaction.html",
+	)
+	var notfoundTemplateFiles = append(layoutTemplateFiles,
+		"transaction/notfound.html",
+	)
+
+	vars := mux.Vars(r)
+	hashStr := strings.Replace(vars["hash"], "0x", "", -1)
+	txHash, err := hex.DecodeString(hashStr)
+	if err != nil || len(txHash) != 32 {
+		data := InitPageData(w, r, "blockchain", "/tx", "Transaction not found", notfoundTemplateFiles)
+		w.Header().Set("Content-Type", "text/html")
+		if handleTemplateError(w, r, "transaction.go", "Transaction", "invalidHash", templates.GetTemplate(notfoundTemplateFiles...).ExecuteTemplate(w, "layout", data)) != nil {
+			return // an error has occurred and was processed
+		}
+		return
+	}
+
+	var pageData *models.TransactionPageData
+	var pageError error
+	pageError = services.GlobalCallRateLimiter.CheckCallLimit(r, 1)
+	if pageError == nil {
+		pageData, pageError = getTransactionPageData(txHash)
+	}
+	if pageError != nil {
+		handlePageError(w, r, pageError)
+		return
+	}
+	if pageData == nil {
+		data := InitPageData(w, r, "blockchain", "/tx", "Transaction not found", notfoundTemplateFiles)
+		w.Header().Set("Content-Type", "text/html")
+		if handleTemplateError(w, r, "transaction.go", "Transaction", "notFound", templates.GetTemplate(notfoundTemplateFiles...).ExecuteTemplate(w, "layout", data)) != nil {
+			return // an error has occurred and was processed
+		}
+		return
+	}
+
+	data := InitPageData(w, r, "blockchain", "/tx", fmt.Sprintf("Transaction 0x%x", txHash), transactionTemplateFiles)
+	data.Data = pageData
+	w.Header().Set("Content-Type", "text/html")
+	if handleTemplateError(w, r, "transaction.go", "Transaction", "", templates.GetTemplate(transactionTemplateFiles...).ExecuteTemplate(w, "layout", data)) != nil {
+		return // an error has occurred and was processed
+	}
+}
+
+func getTransactionPageData(txHash []byte) (*models.TransactionPageData, error) {
+	pageData := &models.TransactionPageData{}
+	pageCacheKey := fmt.Sprintf("tx:%x", txHash)
+	pageRes, pageErr := services.GlobalFrontendCache.ProcessCachedPage(pageCacheKey, true, pageData, func(pageCall *services.FrontendCacheProcessingPage) interface{} {
+		pageData, cacheTimeout := buildTransactionPageData(txHash)
+		pageCall.CacheTimeout = cacheTimeout
+		return pageData
+	})
+	if pageErr == nil && pageRes != nil {
+		resData, resOk := pageRes.(*models.TransactionPageData)
+		if !resOk {
+			return nil, ErrInvalidPageModel
+		}
+		pageData = resData
+	}
+	return pageData, pageErr
+}
+
+func buildTransactionPageData(txHash []byte) (*models.TransactionPageData, time.Duration) {
+	logrus.Debugf("transaction page called: 0x%x", txHash)
+	chainConfig := utils.Config.Chain.Config
+
+	txDetails := services.GlobalBeaconService.GetTransactionDetails(txHash)
+	if txDetails == nil {
+		return nil, time.Duration(chainConfig.SecondsPerSlot) * time.Second
+	}
+
+	tx := txDetails.Transaction
+	pageData := &models.TransactionPageData{
+		TxHash:          tx.TxHash,
+		IsIndexed:       txDetails.IsIndexed,
+		IsPending:       txDetails.IsPending,
+		HasBlock:        txDetails.HasBlock,
+		From:            tx.TxFrom,
+		To:              tx.TxTo,
+		ContractAddress: tx.ContractAddress,
+		Value:           getTransactionEthValue(tx.Value),
+		Type:            tx.TxType,
+		Nonce:           tx.Nonce,
+		GasLimit:        tx.GasLimit,
+		Data:            txDetails.Data,
+		DataLen:         tx.DataLen,
+	}
+	if txDetails.HasBlock {
+		pageData.BlockNumber = tx.BlockNumber
+		pageData.BlockHash = tx.BlockHash
+		pageData.BlockTime = time.Unix(int64(tx.BlockTime), 0)
+		pageData.TxIndex = tx.TxIndex
+	}
+	if txDetails.Slot != nil {
+		pageData.HasSlot = true
+		pageData.Slot = *txDetails.Slot
+		pageData.SlotRoot = txDetails.SlotRoot
+		pageData.Orphaned = txDetails.Orphaned
+		if tx.BlockTime == 0 {
+			pageData.BlockTime = utils.SlotToTime(pageData.Slot)
+		}
+	}
+	if tx.Status != nil {
+		pageData.HasReceipt = true
+		pageData.Success = *tx.Status == 1
+	}
+	if tx.GasUsed != nil {
+		pageData.GasUsed = *tx.GasUsed
+	}
+
+	// check call fn signature
+	if len(tx.MethodSig) == 4 {
+		sigBytes := types.TxSignatureBytes(tx.MethodSig)
+		pageData.FuncBytes = fmt.Sprintf("0x%x", tx.MethodSig)
+		sigLookup := services.GlobalTxSignaturesService.LookupSignatures([]types.TxSignatureBytes{sigBytes})[sigBytes]
+		if sigLookup != nil {
+			pageData.FuncSigStatus = uint64(sigLookup.Status)
+			if sigLookup.Status == types.TxSigStatusFound {
+				pageData.FuncSig = sigLookup.Signature
+				pageData.FuncName = sigLookup.Name
+			} else {
+				pageData.FuncName = "call?"
+			}
+		}
+	} else {
+		pageData.FuncSigStatus = 10
+		pageData.FuncName = "transfer"
+	}
+
+	cacheTimeout := time.Duration(chainConfig.SecondsPerSlot) * time.Second
+	if txDetails.IsIndexed {
+		cacheTimeout = 30 * time.Minute
+	}
+	return pageData, cacheTimeout
+}
+
+// getTransactionEthValue converts the big-endian wei amount of a transaction to ETH
+func getTransactionEthValue(value []byte) float64 {
+	txValue, _ := new(big.Int).SetBytes(value).Float64()
+	ethFloat, _ := utils.ETH.Float64()
+	return txValue / ethFloat
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/gorilla/mux"
 	"github.com/sirupsen/logrus"
 
+	"github.com/ethpandaops/dora/db"
 	"github.com/ethpandaops/dora/dbtypes"
 	"github.com/ethpandaops/dora/services"
 	"github.com/ethpandaops/dora/templates"
@@ -26,6 +27,11 @@ func Validator(w http.ResponseWriter, r *http.Request) {
 	var validatorTemplateFiles = append(layoutTemplateFiles,
 		"validator/validator.html",
 		"validator/recentBlocks.html",
+		"validator/recentWithdrawals.html",
+		"validator/recentAttestations.html",
+		"validator/balanceHistory.html",
+		"validator/syncDuties.html",
+		"validator/recentRewards.html",
 		"_svg/timeline.html",
 	)
 	var notfoundTemplateFiles = append(layoutTemplateFiles,
@@ -35,29 +41,8 @@ func Validator(w http.ResponseWriter, r *http.Request) {
 	var pageTemplate = templates.GetTemplate(validatorTemplateFiles...)
 	data := InitPageData(w, r, "validators", "/validator", "Validator", validatorTemplateFiles)
 
-	validatorSetRsp := services.GlobalBeaconService.GetCachedValidatorSet()
-	var validator *v1.Validator
-	if validatorSetRsp != nil {
-		vars := mux.Vars(r)
-		idxOrPubKey := strings.Replace(vars["idxOrPubKey"], "0x", "", -1)
-		validatorPubKey, err := hex.DecodeString(idxOrPubKey)
-		if err != nil || len(validatorPubKey) != 48 {
-			// search by index^
-			validatorIndex, err := strconv.ParseUint(vars["idxOrPubKey"], 10, 64)
-			if err == nil && validatorIndex < uint64(len(validatorSetRsp)) {
-				validator = validatorSetRsp[phase0.ValidatorIndex(validatorIndex)]
-			}
-		} else {
-			// search by pubkey
-			for _, val := range validatorSetRsp {
-				if bytes.Equal(val.Validator.PublicKey[:], validatorPubKey) {
-					validator = val
-					break
-				}
-			}
-		}
-	}
-
+	vars := mux.Vars(r)
+	validator := getValidatorByIndexOrPubKey(vars["idxOrPubKey"])
 	if validator == nil {
 		data := InitPageData(w, r, "blockchain", "/validator", "Validator not found", notfoundTemplateFiles)
 		w.Header().Set("Content-Type", "text/html")
@@ -82,6 +67,29 @@ func Validator(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+func getValidatorByIndexOrPubKey(idxOrPubKey string) *v1.Validator {
+	validatorSetRsp := services.GlobalBeaconService.GetCachedValidatorSet()
+	if validatorSetRsp == nil {
+		return nil
+	}
+	validatorPubKey, err := hex.DecodeString(strings.Replace(idxOrPubKey, "0x", "", -1))
+	if err != nil || len(validatorPubKey) != 48 {
+		// search by index
+		validatorIndex, err := strconv.ParseUint(idxOrPubKey, 10, 64)
+		if err == nil && validatorIndex < uint64(len(validatorSetRsp)) {
+			return validatorSetRsp[phase0.ValidatorIndex(validatorIndex)]
+		}
+	} else {
+		// search by pubkey
+		for _, val := range validatorSetRsp {
+			if bytes.Equal(val.Validator.PublicKey[:], validatorPubKey) {
+				return val
+			}
+		}
+	}
+	return nil
+}
+
 func getValidatorPageData(validatorIndex uint64) (*models.ValidatorPageData, error) {
 	pageData := &models.ValidatorPageData{}
 	pageCacheKey := fmt.Sprintf("validator:%v", validatorIndex)
@@ -163,6 +171,18 @@ func buildValidatorPageData(validatorIndex uint64) (*models.ValidatorPageData, t
 		pageData.WithdrawAddress = validator.Validator.WithdrawalCredentials[12:]
 	}
 
+	// load bls to execution change
+	blsChanges, _ := services.GlobalBeaconService.GetBLSChangesByFilter(&dbtypes.BLSChangeFilter{
+		ValidatorIndex: &validatorIndex,
+		WithOrphaned:   0,
+	}, 0, 1)
+	if len(blsChanges) > 0 {
+		pageData.ShowBLSChange = true
+		pageData.BLSChangeSlot = blsChanges[0].SlotNumber
+		pageData.BLSChangeTs = utils.SlotToTime(blsChanges[0].SlotNumber)
+		pageData.BLSChangeAddress = blsChanges[0].Address
+	}
+
 	// load latest blocks
 	pageData.RecentBlocks = make([]*models.ValidatorPageDataBlocks, 0)
 	blocksData := services.GlobalBeaconService.GetDbBlocksByFilter(&dbtypes.BlockFilter{
@@ -186,6 +206,7 @@ func buildValidatorPageData(validatorIndex uint64) (*models.ValidatorPageData, t
 		if blockData.Block != nil {
 			blockEntry.Graffiti = blockData.Block.Graffiti
 			blockEntry.BlockRoot = fmt.Sprintf("0x%x", blockData.Block.Root)
+			blockEntry.Reward = buildSlotReward(blockData.Block.ClReward, blockData.Block.ClRewardAttestations, blockData.Block.ClRewardSync, blockData.Block.ClRewardSlashings, blockData.Block.ElPriorityFees)
 			if blockData.Block.EthBlockNumber != nil {
 				blockEntry.WithEthBlock = true
 				blockEntry.EthBlock = *blockData.Block.EthBlockNumber
@@ -195,5 +216,297 @@ func buildValidatorPageData(validatorIndex uint64) (*models.ValidatorPageData, t
 	}
 	pageData.RecentBlockCount = uint64(len(pageData.RecentBlocks))
 
+	// load latest withdrawals
+	pageData.RecentWithdrawals = make([]*models.ValidatorPageDataWithdrawal, 0)
+	withdrawals, _ := services.GlobalBeaconService.GetWithdrawalsByFilter(&dbtypes.WithdrawalFilter{
+		ValidatorIndex: &validatorIndex,
+		WithOrphaned:   1,
+	}, 0, 10)
+	for _, withdrawal := range withdrawals {
+		pageData.RecentWithdrawals = append(pageData.RecentWithdrawals, &models.ValidatorPageDataWithdrawal{
+			Epoch:    utils.EpochOfSlot(withdrawal.SlotNumber),
+			Slot:     withdrawal.SlotNumber,
+			SlotRoot: withdrawal.SlotRoot,
+			Ts:       utils.SlotToTime(withdrawal.SlotNumber),
+			Orphaned: withdrawal.Orphaned,
+			Index:    withdrawal.WithdrawalIndex,
+			Address:  withdrawal.Address,
+			Amount:   withdrawal.Amount,
+			Type:     uint8(withdrawal.Type),
+		})
+	}
+	pageData.RecentWithdrawalCount = uint64(len(pageData.RecentWithdrawals))
+
+	// load attestation history
+	pageData.AttestationHistory = make([]*models.ValidatorPageDataAttestation, 0)
+	pageData.RecentAttestations = make([]*models.ValidatorPageDataAttestation, 0)
+	currentEpoch := utils.TimeToEpoch(time.Now())
+	attestations := services.GlobalBeaconService.GetValidatorAttestations(validatorIndex, 100)
+	for i := len(attestations) - 1; i >= 0; i-- {
+		attestation := attestations[i]
+		outcome := attestation.GetOutcome()
+		attestationEntry := &models.ValidatorPageDataAttestation{
+			Epoch:             attestation.Epoch,
+			Ts:                utils.EpochToTime(attestation.Epoch),
+			HeadCorrect:       outcome.IsHeadCorrect(),
+			TargetCorrect:     outcome.IsTargetCorrect(),
+			SourceCorrect:     outcome.IsIncluded(),
+			InclusionDistance: outcome.InclusionDistance(),
+		}
+		if !outcome.HasDuty() {
+			attestationEntry.Status = 0
+		} else if outcome.IsIncluded() {
+			attestationEntry.Status = 1
+		} else if int64(attestation.Epoch) >= currentEpoch-1 {
+			// attestation might still get included
+			attestationEntry.Status = 3
+		} else {
+			attestationEntry.Status = 2
+		}
+
+		if attestationEntry.Status == 1 || attestationEntry.Status == 2 {
+			pageData.AttestationDutyCount++
+			if attestationEntry.Status == 1 {
+				pageData.AttestationIncludedCount++
+			}
+			if attestationEntry.TargetCorrect {
+				pageData.AttestationTargetCount++
+			}
+			if attestationEntry.HeadCorrect {
+				pageData.AttestationHeadCount++
+			}
+		}
+		pageData.AttestationHistory = append(pageData.AttestationHistory, attestationEntry)
+	}
+	for i := len(pageData.AttestationHistory) - 1; i >= 0 && len(pageData.RecentAttestations) < 10; i-- {
+		if pageData.AttestationHistory[i].Status != 0 {
+			pageData.RecentAttestations = append(pageData.RecentAttestations, pageData.AttestationHistory[i])
+		}
+	}
+	pageData.RecentAttestationCount = uint64(len(pageData.RecentAttestations))
+	if pageData.AttestationDutyCount > 0 {
+		pageData.AttestationIncludedRate = float64(pageData.AttestationIncludedCount) * 100 / float64(pageData.AttestationDutyCount)
+		pageData.AttestationTargetRate = float64(pageData.AttestationTargetCount) * 100 / float64(pageData.AttestationDutyCount)
+		pageData.AttestationHeadRate = float64(pageData.AttestationHeadCount) * 100 / float64(pageData.AttestationDutyCount)
+	}
+
+	// load sync committee duties
+	buildValidatorSyncDuties(pageData)
+
+	// load balance history & income
+	if utils.Config.Indexer.BalanceSnapshotInterval > 0 {
+		buildValidatorBalanceHistory(pageData)
+	}
+
+	// load attestation & sync committee rewards
+	if utils.Config.Indexer.EnableRewardsHistory {
+		buildValidatorRecentRewards(pageData)
+	}
+
 	return pageData, 10 * time.Minute
 }
+
+var validatorIncomePeriods = []struct {
+	name    string
+	seconds uint64
+}{
+	{"1 day", 86400},
+	{"7 days", 7 * 86400},
+	{"30 days", 30 * 86400},
+	{"365 days", 365 * 86400},
+}
+
+func buildValidatorBalanceHistory(pageData *models.ValidatorPageData) {
+	epochSeconds := utils.Config.Chain.Config.SecondsPerSlot * utils.Config.Chain.Config.SlotsPerEpoch
+	currentEpoch := uint64(utils.TimeToEpoch(time.Now()))
+	maxPeriodEpochs := validatorIncomePeriods[len(validatorIncomePeriods)-1].seconds / epochSeconds
+
+	// include one snapshot interval before the longest period, so the period start can be matched
+	minEpoch := uint64(0)
+	if currentEpoch > maxPeriodEpochs+utils.Config.Indexer.BalanceSnapshotInterval {
+		minEpoch = currentEpoch - maxPeriodEpochs - utils.Config.Indexer.BalanceSnapshotInterval
+	}
+	history := services.GlobalBeaconService.GetValidatorBalanceHistory(pageData.Index, minEpoch)
+	if len(history) < 2 {
+		return
+	}
+
+	pageData.ShowBalanceHistory = true
+	pageData.Income = make([]*models.ValidatorPageDataIncome, 0, len(validatorIncomePeriods))
+	for _, period := range validatorIncomePeriods {
+		periodEpochs := period.seconds / epochSeconds
+		startEpoch := uint64(0)
+		if currentEpoch > periodEpochs {
+			startEpoch = currentEpoch - periodEpochs
+		}
+		// use the latest snapshot before the period start as reference point
+		refEpoch := history[0].Epoch
+		for _, point := range history {
+			if point.Epoch > startEpoch {
+				break
+			}
+			refEpoch = point.Epoch
+		}
+
+		income := services.GlobalBeaconService.GetValidatorIncome(pageData.Index, pageData.PublicKey, history, refEpoch)
+		if income == nil {
+			continue
+		}
+		pageData.Income = append(pageData.Income, &models.ValidatorPageDataIncome{
+			Period:       period.name,
+			StartEpoch:   income.StartEpoch,
+			StartTs:      utils.EpochToTime(income.StartEpoch),
+			StartBalance: income.StartBalance,
+			EndBalance:   income.EndBalance,
+			Withdrawn:    income.Withdrawn,
+			Deposited:    income.Deposited,
+			Rewards:      income.Rewards,
+			Penalties:    income.Penalties,
+		})
+		incomeEntry := pageData.Income[len(pageData.Income)-1]
+		if income.Rewards >= income.Penalties {
+			incomeEntry.NetIncome = income.Rewards - income.Penalties
+		} else {
+			incomeEntry.NetIncome = income.Penalties - income.Rewards
+			incomeEntry.NetNegative = true
+		}
+		if income.StartEpoch == history[0].Epoch {
+			// longer periods would start from the same point
+			break
+		}
+	}
+
+	// build balance chart (svg polylines on a 1000x200 canvas)
+	pageData.BalanceChartStartTs = utils.EpochToTime(history[0].Epoch)
+	pageData.BalanceChartEndTs = utils.EpochToTime(history[len(history)-1].Epoch)
+	pageData.BalanceChartMin = history[0].Balance
+	pageData.BalanceChartMax = history[0].Balance
+	for _, point := range history {
+		for _, balance := range []uint64{point.Balance, point.EffectiveBalance} {
+			if balance < pageData.BalanceChartMin {
+				pageData.BalanceChartMin = balance
+			}
+			if balance > pageData.BalanceChartMax {
+				pageData.BalanceChartMax = balance
+			}
+		}
+	}
+	epochRange := float64(history[len(history)-1].Epoch - history[0].Epoch)
+	balanceRange := float64(pageData.BalanceChartMax - pageData.BalanceChartMin)
+	if balanceRange == 0 {
+		balanceRange = 1
+	}
+	var balancePoints, effBalancePoints strings.Builder
+	for idx, point := range history {
+		if idx > 0 {
+			balancePoints.WriteString(" ")
+			effBalancePoints.WriteString(" ")
+		}
+		x := float64(point.Epoch-history[0].Epoch) / epochRange * 1000
+		fmt.Fprintf(&balancePoints, "%.1f,%.1f", x, 195-float64(point.Balance-pageData.BalanceChartMin)/balanceRange*190)
+		fmt.Fprintf(&effBalancePoints, "%.1f,%.1f", x, 195-float64(point.EffectiveBalance-pageData.BalanceChartMin)/balanceRange*190)
+	}
+	pageData.BalanceChartPoints = balancePoints.String()
+	pageData.EffBalanceChartPoints = effBalancePoints.String()
+}
+
+func buildValidatorSyncDuties(pageData *models.ValidatorPageData) {
+	epochsPerPeriod := utils.Config.Chain.Config.EpochsPerSyncCommitteePeriod
+	currentPeriod := uint64(utils.TimeToEpoch(time.Now())) / epochsPerPeriod
+	if (currentPeriod+1)*epochsPerPeriod < utils.Config.Chain.Config.AltairForkEpoch {
+		return
+	}
+
+	// collect committee positions per period (current & next period might not be persisted yet)
+	periodPositions := map[uint64][]uint64{}
+	periods := []uint64{}
+	for _, period := range []uint64{currentPeriod + 1, currentPeriod} {
+		for position, validator := range services.GlobalBeaconService.GetSyncCommitteeMembers(period) {
+			if validator == pageData.Index {
+				if periodPositions[period] == nil {
+					periods = append(periods, period)
+				}
+				periodPositions[period] = append(periodPositions[period], uint64(position))
+			}
+		}
+	}
+	for _, assignment := range db.GetSyncAssignmentsForValidator(pageData.Index, 50) {
+		if assignment.Period >= currentPeriod {
+			continue
+		}
+		if periodPositions[assignment.Period] == nil {
+			if len(periods) >= 10 {
+				break
+			}
+			periods = append(periods, assignment.Period)
+		}
+		periodPositions[assignment.Period] = append(periodPositions[assignment.Period], uint64(assignment.Index))
+	}
+
+	pageData.SyncDuties = make([]*models.ValidatorPageDataSyncDuty, 0, len(periods))
+	for _, period := range periods {
+		syncDuty := &models.ValidatorPageDataSyncDuty{
+			Period:     period,
+			FirstEpoch: period * epochsPerPeriod,
+			LastEpoch:  (period+1)*epochsPerPeriod - 1,
+			Positions:  uint64(len(periodPositions[period])),
+			IsCurrent:  period == currentPeriod,
+			IsNext:     period > currentPeriod,
+		}
+		syncDuty.StartTs = utils.EpochToTime(syncDuty.FirstEpoch)
+
+		if !syncDuty.IsNext {
+			firstSlot := syncDuty.FirstEpoch * utils.Config.Chain.Config.SlotsPerEpoch
+			lastSlot := (syncDuty.LastEpoch+1)*utils.Config.Chain.Config.SlotsPerEpoch - 1
+			for _, bits := range services.GlobalBeaconService.GetSyncParticipations(firstSlot, lastSlot) {
+				for _, position := range periodPositions[period] {
+					if int(position/8) >= len(bits) {
+						continue
+					}
+					if utils.BitAtVector(bits, int(position)) {
+						syncDuty.Signed++
+					} else {
+						syncDuty.Missed++
+					}
+				}
+			}
+			if syncDuty.Signed+syncDuty.Missed > 0 {
+				syncDuty.Participation = float64(syncDuty.Signed) * 100 / float64(syncDuty.Signed+syncDuty.Missed)
+			}
+		}
+
+		pageData.SyncDuties = append(pageData.SyncDuties, syncDuty)
+	}
+	pageData.SyncDutyCount = uint64(len(pageData.SyncDuties))
+}
+
+func buildValidatorRecentRewards(pageData *models.ValidatorPageData) {
+	currentEpoch := uint64(utils.TimeToEpoch(time.Now()))
+	rewards, err := db.GetValidatorRewards(pageData.Index, 0, currentEpoch, 10)
+	if err != nil {
+		return
+	}
+
+	pageData.RecentRewards = make([]*models.ValidatorPageDataReward, 0, len(rewards))
+	for _, reward := range rewards {
+		attestationReward := reward.GetAttestationReward()
+		syncReward := reward.GetSyncReward()
+		rewardEntry := &models.ValidatorPageDataReward{
+			Epoch: reward.Epoch,
+			Ts:    utils.EpochToTime(reward.Epoch),
+		}
+		rewardEntry.AttestationReward, rewardEntry.AttestationNegative = splitSignedGwei(attestationReward)
+		rewardEntry.SyncReward, rewardEntry.SyncNegative = splitSignedGwei(syncReward)
+		rewardEntry.TotalReward, rewardEntry.TotalNegative = splitSignedGwei(attestationReward + syncReward)
+		pageData.RecentRewards = append(pageData.RecentRewards, rewardEntry)
+	}
+	pageData.RecentRewardCount = uint64(len(pageData.RecentRewards))
+}
+
+// splitSignedGwei splits a signed gwei amount into its absolute value and a negative flag for display
+func splitSignedGwei(amount int64) (uint64, bool) {
+	if amount < 0 {
+		return uint64(-amount), true
+	}
+	return uint64(amount), false
+}
@@ -0,0 +1,179 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/ethpandaops/dora/db"
+	"github.com/ethpandaops/dora/dbtypes"
+	"github.com/ethpandaops/dora/services"
+	"github.com/ethpandaops/dora/templates"
+	"github.com/ethpandaops/dora/types/models"
+	"github.com/ethpandaops/dora/utils"
+	"github.com/sirupsen/logrus"
+)
+
+var validatorQueuesRanges = map[string]time.Duration{
+	"1d":  24 * time.Hour,
+	"7d":  7 * 24 * time.Hour,
+	"30d": 30 * 24 * time.Hour,
+}
+
+const validatorQueuesListLimit = 100
+
+// ValidatorQueues will return the "validator queues" page using a go template
+func ValidatorQueues(w http.ResponseWriter, r *http.Request) {
+	var templateFiles = append(layoutTemplateFiles,
+		"validator_queues/validator_queues.html",
+	)
+
+	var pageTemplate = templates.GetTemplate(templateFiles...)
+	data := InitPageData(w, r, "validators", "/validators/queues", "Validator Queues", templateFiles)
+
+	urlArgs := r.URL.Query()
+	rangeName := urlArgs.Get("range")
+	if validatorQueuesRanges[rangeName] == 0 {
+		rangeName = "7d"
+	}
+
+	var pageError error
+	pageError = services.GlobalCallRateLimiter.CheckCallLimit(r, 1)
+	if pageError == nil {
+		data.Data, pageError = getValidatorQueuesPageData(rangeName)
+	}
+	if pageError != nil {
+		handlePageError(w, r, pageError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/html")
+	if handleTemplateError(w, r, "validator_queues.go", "ValidatorQueues", "", pageTemplate.ExecuteTemplate(w, "layout", data)) != nil {
+		return // an error has occurred and was processed
+	}
+}
+
+func getValidatorQueuesPageData(rangeName string) (*models.ValidatorQueuesPageData, error) {
+	pageData := &models.ValidatorQueuesPageData{}
+	pageCacheKey := fmt.Sprintf("validatorqueues:%v", rangeName)
+	pageRes, pageErr := services.GlobalFrontendCache.ProcessCachedPage(pageCacheKey, true, pageData, func(pageCall *services.FrontendCacheProcessingPage) interface{} {
+		pageData, cacheTimeout := buildValidatorQueuesPageData(rangeName)
+		pageCall.CacheTimeout = cacheTimeout
+		return pageData
+	})
+	if pageErr == nil && pageRes != nil {
+		resData, resOk := pageRes.(*models.ValidatorQueuesPageData)
+		if !resOk {
+			return nil, ErrInvalidPageModel
+		}
+		pageData = resData
+	}
+	return pageData, pageErr
+}
+
+func buildValidatorQueuesPageData(rangeName string) (*models.ValidatorQueuesPageData, time.Duration) {
+	logrus.Debugf("validator queues page called: %v", rangeName)
+	pageData := &models.ValidatorQueuesPageData{
+		ActivationQueue: []*models.ValidatorQueuesPageDataValidator{},
+		ExitQueue:       []*models.ValidatorQueuesPageDataValidator{},
+		ListLimit:       validatorQueuesListLimit,
+		Range:           rangeName,
+	}
+
+	queues := services.GlobalBeaconService.GetValidatorQueues()
+	if queues != nil {
+		pageData.Epoch = queues.Epoch
+		pageData.ActiveCount = queues.ActiveCount
+		pageData.TotalActiveBalance = queues.TotalActiveBalance
+		pageData.BalanceChurn = queues.BalanceChurn
+		pageData.ActivationChurn = queues.ActivationChurn
+		pageData.ExitChurn = queues.ExitChurn
+		pageData.PendingCount = queues.PendingCount
+		pageData.ActivationCount = uint64(len(queues.ActivationQueue))
+		pageData.ActivationAmount = queues.ActivationAmount
+		pageData.ExitCount = uint64(len(queues.ExitQueue))
+		pageData.ExitAmount = queues.ExitAmount
+		pageData.NextExitEpoch = queues.NextExitEpoch
+		pageData.NextExitTime = utils.EpochToTime(queues.NextExitEpoch)
+
+		if activationCount := len(queues.ActivationQueue); activationCount > 0 {
+			pageData.ActivationWaitEpoch = queues.ActivationQueue[activationCount-1].EstimatedEpoch
+			pageData.ActivationWaitTime = utils.EpochToTime(pageData.ActivationWaitEpoch)
+		}
+
+		for idx, entry := range queues.ActivationQueue {
+			if idx >= validatorQueuesListLimit {
+				break
+			}
+			pageData.ActivationQueue = append(pageData.ActivationQueue, buildValidatorQueuesPageDataValidator(entry))
+		}
+		for idx, entry := range queues.ExitQueue {
+			if idx >= validatorQueuesListLimit {
+				break
+			}
+			pageData.ExitQueue = append(pageData.ExitQueue, buildValidatorQueuesPageDataValidator(entry))
+		}
+	}
+
+	epochDuration := time.Duration(utils.Config.Chain.Config.SecondsPerSlot*utils.Config.Chain.Config.SlotsPerEpoch) * time.Second
+	maxEpoch := utils.EpochOfSlot(utils.TimeToSlot(uint64(time.Now().Unix())))
+	minEpoch := uint64(0)
+	if rangeEpochs := uint64(validatorQueuesRanges[rangeName] / epochDuration); maxEpoch > rangeEpochs {
+		minEpoch = maxEpoch - rangeEpochs
+	}
+	history, err := db.GetValidatorQueueStats(minEpoch, maxEpoch)
+	if err != nil {
+		logrus.Warnf("validator queues page: error loading queue history: %v", err)
+	} else if len(history) > 0 {
+		buildValidatorQueuesChart(pageData, history)
+	}
+
+	return pageData, epochDuration
+}
+
+func buildValidatorQueuesPageDataValidator(entry *services.ValidatorQueueEntry) *models.ValidatorQueuesPageDataValidator {
+	validator := entry.Validator
+	return &models.ValidatorQueuesPageDataValidator{
+		Position:         entry.Position,
+		Index:            uint64(validator.Index),
+		Name:             services.GlobalBeaconService.GetValidatorName(uint64(validator.Index)),
+		PublicKey:        validator.Validator.PublicKey[:],
+		EffectiveBalance: uint64(validator.Validator.EffectiveBalance),
+		EligibilityEpoch: uint64(validator.Validator.ActivationEligibilityEpoch),
+		Scheduled:        entry.Scheduled,
+		EstimatedEpoch:   entry.EstimatedEpoch,
+		EstimatedTime:    utils.EpochToTime(entry.EstimatedEpoch),
+	}
+}
+
+// buildValidatorQueuesChart builds the activation & exit queue length lines over the persisted history.
+func buildValidatorQueuesChart(pageData *models.ValidatorQueuesPageData, history []*dbtypes.ValidatorQueueStats) {
+	pageData.HistoryMinEpoch = history[0].Epoch
+	pageData.HistoryMaxEpoch = history[len(history)-1].Epoch
+	pageData.HistoryCount = uint64(len(history))
+
+	maxValue := uint64(1)
+	for _, stat := range history {
+		if stat.ActivationCount > maxValue {
+			maxValue = stat.ActivationCount
+		}
+		if stat.ExitCount > maxValue {
+			maxValue = stat.ExitCount
+		}
+	}
+	pageData.HistoryMaxValue = maxValue
+
+	epochSpan := float64(pageData.HistoryMaxEpoch - pageData.HistoryMinEpoch)
+	if epochSpan == 0 {
+		epochSpan = 1
+	}
+	activationPoints := make([]string, len(history))
+	exitPoints := make([]string, len(history))
+	for idx, stat := range history {
+		posX := float64(stat.Epoch-pageData.HistoryMinEpoch) * 1000 / epochSpan
+		activationPoints[idx] = fmt.Sprintf("%.1f,%.1f", posX, 200-float64(stat.ActivationCount)*200/float64(maxValue))
+		exitPoints[idx] = fmt.Sprintf("%.1f,%.1f", posX, 200-float64(stat.ExitCount)*200/float64(maxValue))
+	}
+	pageData.ActivationChart = strings.Join(activationPoints, " ")
+	pageData.ExitChart = strings.Join(exitPoints, " ")
+}
@@ -131,6 +131,7 @@ func buildValidatorSlotsPageData(validator uint64, pageIdx uint64, pageSize uint
 			slotData.ProposerSlashingCount = dbBlock.ProposerSlashingCount
 			slotData.AttesterSlashingCount = dbBlock.AttesterSlashingCount
 			slotData.SyncParticipation = float64(dbBlock.SyncParticipation) * 100
+			slotData.Reward = buildSlotReward(dbBlock.ClReward, dbBlock.ClRewardAttestations, dbBlock.ClRewardSync, dbBlock.ClRewardSlashings, dbBlock.ElPriorityFees)
 			slotData.EthTransactionCount = dbBlock.EthTransactionCount
 			slotData.Graffiti = dbBlock.Graffiti
 			slotData.BlockRoot = dbBlock.Root
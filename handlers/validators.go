@@ -87,6 +87,7 @@ func Validators(w http.ResponseWriter, r *http.Request) {
 			logrus.WithError(err).Error("error encoding index data")
 			http.Error(w, "Internal server error", http.StatusServiceUnavailable)
 		}
+		return
 	}
 
 	w.Header().Set("Content-Type", "text/html")
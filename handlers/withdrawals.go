@@ -0,0 +1,269 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	v1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethpandaops/dora/dbtypes"
+	"github.com/ethpandaops/dora/services"
+	"github.com/ethpandaops/dora/templates"
+	"github.com/ethpandaops/dora/types/models"
+	"github.com/ethpandaops/dora/utils"
+	"github.com/sirupsen/logrus"
+)
+
+// Withdrawals will return the filtered "withdrawals" page using a go template
+func Withdrawals(w http.ResponseWriter, r *http.Request) {
+	var templateFiles = append(layoutTemplateFiles,
+		"withdrawals/withdrawals.html",
+		"_svg/professor.html",
+	)
+
+	var pageTemplate = templates.GetTemplate(templateFiles...)
+	data := InitPageData(w, r, "validators", "/validators/withdrawals", "Withdrawals", templateFiles)
+
+	urlArgs := r.URL.Query()
+	var pageSize uint64 = 50
+	if urlArgs.Has("c") {
+		pageSize, _ = strconv.ParseUint(urlArgs.Get("c"), 10, 64)
+	}
+	var pageIdx uint64 = 1
+	if urlArgs.Has("p") {
+		pageIdx, _ = strconv.ParseUint(urlArgs.Get("p"), 10, 64)
+		if pageIdx < 1 {
+			pageIdx = 1
+		}
+	}
+
+	var minSlot uint64
+	var maxSlot uint64
+	var minIndex uint64
+	var maxIndex uint64
+	var vname string
+	var address string
+	var minAmount uint64
+	var maxAmount uint64
+	var withType uint64
+	var withOrphaned uint64
+
+	if urlArgs.Has("f") {
+		if urlArgs.Has("f.mins") {
+			minSlot, _ = strconv.ParseUint(urlArgs.Get("f.mins"), 10, 64)
+		}
+		if urlArgs.Has("f.maxs") {
+			maxSlot, _ = strconv.ParseUint(urlArgs.Get("f.maxs"), 10, 64)
+		}
+		if urlArgs.Has("f.mini") {
+			minIndex, _ = strconv.ParseUint(urlArgs.Get("f.mini"), 10, 64)
+		}
+		if urlArgs.Has("f.maxi") {
+			maxIndex, _ = strconv.ParseUint(urlArgs.Get("f.maxi"), 10, 64)
+		}
+		if urlArgs.Has("f.vname") {
+			vname = urlArgs.Get("f.vname")
+		}
+		if urlArgs.Has("f.address") {
+			address = urlArgs.Get("f.address")
+		}
+		if urlArgs.Has("f.mina") {
+			minAmount, _ = strconv.ParseUint(urlArgs.Get("f.mina"), 10, 64)
+		}
+		if urlArgs.Has("f.maxa") {
+			maxAmount, _ = strconv.ParseUint(urlArgs.Get("f.maxa"), 10, 64)
+		}
+		if urlArgs.Has("f.type") {
+			withType, _ = strconv.ParseUint(urlArgs.Get("f.type"), 10, 64)
+		}
+		if urlArgs.Has("f.orphaned") {
+			withOrphaned, _ = strconv.ParseUint(urlArgs.Get("f.orphaned"), 10, 64)
+		}
+	} else {
+		withOrphaned = 1
+	}
+	var pageError error
+	pageError = services.GlobalCallRateLimiter.CheckCallLimit(r, 2)
+	if pageError == nil {
+		data.Data, pageError = getFilteredWithdrawalsPageData(pageIdx, pageSize, minSlot, maxSlot, minIndex, maxIndex, vname, address, minAmount, maxAmount, uint8(withType), uint8(withOrphaned))
+	}
+	if pageError != nil {
+		handlePageError(w, r, pageError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/html")
+	if handleTemplateError(w, r, "withdrawals.go", "Withdrawals", "", pageTemplate.ExecuteTemplate(w, "layout", data)) != nil {
+		return // an error has occurred and was processed
+	}
+}
+
+func getFilteredWithdrawalsPageData(pageIdx uint64, pageSize uint64, minSlot uint64, maxSlot uint64, minIndex uint64, maxIndex uint64, vname string, address string, minAmount uint64, maxAmount uint64, withType uint8, withOrphaned uint8) (*models.WithdrawalsPageData, error) {
+	pageData := &models.WithdrawalsPageData{}
+	pageCacheKey := fmt.Sprintf("withdrawals:%v:%v:%v:%v:%v:%v:%v:%v:%v:%v:%v:%v", pageIdx, pageSize, minSlot, maxSlot, minIndex, maxIndex, vname, address, minAmount, maxAmount, withType, withOrphaned)
+	pageRes, pageErr := services.GlobalFrontendCache.ProcessCachedPage(pageCacheKey, true, pageData, func(_ *services.FrontendCacheProcessingPage) interface{} {
+		return buildFilteredWithdrawalsPageData(pageIdx, pageSize, minSlot, maxSlot, minIndex, maxIndex, vname, address, minAmount, maxAmount, withType, withOrphaned)
+	})
+	if pageErr == nil && pageRes != nil {
+		resData, resOk := pageRes.(*models.WithdrawalsPageData)
+		if !resOk {
+			return nil, ErrInvalidPageModel
+		}
+		pageData = resData
+	}
+	return pageData, pageErr
+}
+
+func buildFilteredWithdrawalsPageData(pageIdx uint64, pageSize uint64, minSlot uint64, maxSlot uint64, minIndex uint64, maxIndex uint64, vname string, address string, minAmount uint64, maxAmount uint64, withType uint8, withOrphaned uint8) *models.WithdrawalsPageData {
+	filterArgs := url.Values{}
+	if minSlot != 0 {
+		filterArgs.Add("f.mins", fmt.Sprintf("%v", minSlot))
+	}
+	if maxSlot != 0 {
+		filterArgs.Add("f.maxs", fmt.Sprintf("%v", maxSlot))
+	}
+	if minIndex != 0 {
+		filterArgs.Add("f.mini", fmt.Sprintf("%v", minIndex))
+	}
+	if maxIndex != 0 {
+		filterArgs.Add("f.maxi", fmt.Sprintf("%v", maxIndex))
+	}
+	if vname != "" {
+		filterArgs.Add("f.vname", vname)
+	}
+	if address != "" {
+		filterArgs.Add("f.address", address)
+	}
+	if minAmount != 0 {
+		filterArgs.Add("f.mina", fmt.Sprintf("%v", minAmount))
+	}
+	if maxAmount != 0 {
+		filterArgs.Add("f.maxa", fmt.Sprintf("%v", maxAmount))
+	}
+	if withType != 0 {
+		filterArgs.Add("f.type", fmt.Sprintf("%v", withType))
+	}
+	if withOrphaned != 0 {
+		filterArgs.Add("f.orphaned", fmt.Sprintf("%v", withOrphaned))
+	}
+
+	pageData := &models.WithdrawalsPageData{
+		FilterMinSlot:       minSlot,
+		FilterMaxSlot:       maxSlot,
+		FilterMinIndex:      minIndex,
+		FilterMaxIndex:      maxIndex,
+		FilterValidatorName: vname,
+		FilterAddress:       address,
+		FilterMinAmount:     minAmount,
+		FilterMaxAmount:     maxAmount,
+		FilterWithType:      withType,
+		FilterWithOrphaned:  withOrphaned,
+	}
+	logrus.Debugf("withdrawals page called: %v:%v [%v,%v,%v,%v,%v,%v,%v,%v,%v]", pageIdx, pageSize, minSlot, maxSlot, minIndex, maxIndex, vname, address, minAmount, maxAmount, withType)
+	if pageIdx == 1 {
+		pageData.IsDefaultPage = true
+	}
+
+	if pageSize > 100 {
+		pageSize = 100
+	}
+	pageData.PageSize = pageSize
+	pageData.TotalPages = pageIdx
+	pageData.CurrentPageIndex = pageIdx
+	if pageIdx > 1 {
+		pageData.PrevPageIndex = pageIdx - 1
+	}
+
+	// load withdrawals
+	withdrawalFilter := &dbtypes.WithdrawalFilter{
+		MinSlot:       minSlot,
+		MaxSlot:       maxSlot,
+		MinIndex:      minIndex,
+		MaxIndex:      maxIndex,
+		ValidatorName: vname,
+		Address:       common.FromHex(address),
+		MinAmount:     minAmount,
+		MaxAmount:     maxAmount,
+		WithType:      dbtypes.WithdrawalType(withType),
+		WithOrphaned:  withOrphaned,
+	}
+
+	dbWithdrawals, totalRows := services.GlobalBeaconService.GetWithdrawalsByFilter(withdrawalFilter, pageIdx-1, uint32(pageSize))
+
+	validatorSetRsp := services.GlobalBeaconService.GetCachedValidatorSet()
+	validatorActivityMap, validatorActivityMax := services.GlobalBeaconService.GetValidatorActivity()
+
+	for _, withdrawal := range dbWithdrawals {
+		withdrawalData := &models.WithdrawalsPageDataWithdrawal{
+			SlotNumber:      withdrawal.SlotNumber,
+			SlotRoot:        withdrawal.SlotRoot,
+			Time:            utils.SlotToTime(withdrawal.SlotNumber),
+			Orphaned:        withdrawal.Orphaned,
+			Index:           withdrawal.WithdrawalIndex,
+			ValidatorIndex:  withdrawal.ValidatorIndex,
+			ValidatorName:   services.GlobalBeaconService.GetValidatorName(withdrawal.ValidatorIndex),
+			Address:         withdrawal.Address,
+			Amount:          withdrawal.Amount,
+			Type:            uint8(withdrawal.Type),
+			ValidatorStatus: "",
+		}
+
+		validator := validatorSetRsp[phase0.ValidatorIndex(withdrawal.ValidatorIndex)]
+		if validator == nil {
+			withdrawalData.ValidatorStatus = "Unknown"
+		} else {
+			if strings.HasPrefix(validator.Status.String(), "pending") {
+				withdrawalData.ValidatorStatus = "Pending"
+			} else if validator.Status == v1.ValidatorStateActiveOngoing {
+				withdrawalData.ValidatorStatus = "Active"
+				withdrawalData.ShowUpcheck = true
+			} else if validator.Status == v1.ValidatorStateActiveExiting {
+				withdrawalData.ValidatorStatus = "Exiting"
+				withdrawalData.ShowUpcheck = true
+			} else if validator.Status == v1.ValidatorStateActiveSlashed {
+				withdrawalData.ValidatorStatus = "Slashed"
+				withdrawalData.ShowUpcheck = true
+			} else if validator.Status == v1.ValidatorStateExitedUnslashed {
+				withdrawalData.ValidatorStatus = "Exited"
+			} else if validator.Status == v1.ValidatorStateExitedSlashed {
+				withdrawalData.ValidatorStatus = "Slashed"
+			} else if validator.Status == v1.ValidatorStateWithdrawalPossible || validator.Status == v1.ValidatorStateWithdrawalDone {
+				withdrawalData.ValidatorStatus = "Withdrawn"
+			} else {
+				withdrawalData.ValidatorStatus = validator.Status.String()
+			}
+
+			if withdrawalData.ShowUpcheck {
+				withdrawalData.UpcheckActivity = validatorActivityMap[uint64(validator.Index)]
+				withdrawalData.UpcheckMaximum = uint8(validatorActivityMax)
+			}
+		}
+
+		pageData.Withdrawals = append(pageData.Withdrawals, withdrawalData)
+	}
+	pageData.WithdrawalCount = uint64(len(pageData.Withdrawals))
+
+	if pageData.WithdrawalCount > 0 {
+		pageData.FirstIndex = pageData.Withdrawals[0].SlotNumber
+		pageData.LastIndex = pageData.Withdrawals[pageData.WithdrawalCount-1].SlotNumber
+	}
+
+	pageData.TotalPages = totalRows / pageSize
+	if totalRows%pageSize > 0 {
+		pageData.TotalPages++
+	}
+	pageData.LastPageIndex = pageData.TotalPages
+	if pageIdx < pageData.TotalPages {
+		pageData.NextPageIndex = pageIdx + 1
+	}
+
+	pageData.FirstPageLink = fmt.Sprintf("/validators/withdrawals?f&%v&c=%v", filterArgs.Encode(), pageData.PageSize)
+	pageData.PrevPageLink = fmt.Sprintf("/validators/withdrawals?f&%v&c=%v&p=%v", filterArgs.Encode(), pageData.PageSize, pageData.PrevPageIndex)
+	pageData.NextPageLink = fmt.Sprintf("/validators/withdrawals?f&%v&c=%v&p=%v", filterArgs.Encode(), pageData.PageSize, pageData.NextPageIndex)
+	pageData.LastPageLink = fmt.Sprintf("/validators/withdrawals?f&%v&c=%v&p=%v", filterArgs.Encode(), pageData.PageSize, pageData.LastPageIndex)
+
+	return pageData
+}
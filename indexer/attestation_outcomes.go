@@ -0,0 +1,127 @@
+package indexer
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	"github.com/ethpandaops/dora/db"
+	"github.com/ethpandaops/dora/dbtypes"
+	"github.com/ethpandaops/dora/utils"
+	"github.com/jmoiron/sqlx"
+)
+
+// buildEpochAttestationOutcomes builds the compact attestation outcome array for an epoch.
+// The array holds one dbtypes.AttestationOutcome byte per validator index (up to the highest index with a duty).
+// The blockMap needs to contain the canonical blocks of the epoch and the following epoch.
+func buildEpochAttestationOutcomes(blockMap map[uint64]*CacheBlock, epoch uint64, epochStats *EpochStats, targetRoot []byte, awaitDutiesLoaded bool) []byte {
+	t1 := time.Now()
+
+	if awaitDutiesLoaded {
+		epochStats.dutiesMutex.RLock()
+		defer epochStats.dutiesMutex.RUnlock()
+	}
+	if epochStats.attestorAssignments == nil {
+		return nil
+	}
+
+	maxIndex := uint64(0)
+	for _, validators := range epochStats.attestorAssignments {
+		for _, validatorIdx := range validators {
+			if validatorIdx > maxIndex {
+				maxIndex = validatorIdx
+			}
+		}
+	}
+	outcomes := make([]byte, maxIndex+1)
+	for _, validators := range epochStats.attestorAssignments {
+		for _, validatorIdx := range validators {
+			outcomes[validatorIdx] = byte(dbtypes.AttestationOutcomeDuty)
+		}
+	}
+
+	firstSlot := epoch * utils.Config.Chain.Config.SlotsPerEpoch
+	lastSlot := firstSlot + (2 * utils.Config.Chain.Config.SlotsPerEpoch) - 1
+
+	// canonical head root for each slot (root of the block at the slot or the latest block before it)
+	headRoots := make(map[uint64][]byte)
+	var headRoot []byte
+	for slot := firstSlot; slot <= lastSlot; slot++ {
+		if block := blockMap[slot]; block != nil {
+			if headRoot == nil {
+				headRoot = block.GetParentRoot()
+				for s := firstSlot; s < slot; s++ {
+					headRoots[s] = headRoot
+				}
+			}
+			headRoot = block.Root
+		}
+		headRoots[slot] = headRoot
+	}
+
+	for slot := firstSlot; slot <= lastSlot; slot++ {
+		block := blockMap[slot]
+		if block == nil {
+			continue
+		}
+		blockBody := block.GetBlockBody()
+		if blockBody == nil {
+			continue
+		}
+		attestations, err := blockBody.Attestations()
+		if err != nil {
+			continue
+		}
+
+		for _, att := range attestations {
+			attSlot := uint64(att.Data.Slot)
+			if utils.EpochOfSlot(attSlot) != epoch || attSlot >= slot {
+				continue
+			}
+
+			attKey := fmt.Sprintf("%v-%v", attSlot, uint64(att.Data.Index))
+			voteValidators := epochStats.attestorAssignments[attKey]
+			if len(voteValidators) == 0 {
+				continue
+			}
+
+			outcome := dbtypes.AttestationOutcomeIncluded
+			if bytes.Equal(att.Data.Target.Root[:], targetRoot) {
+				outcome |= dbtypes.AttestationOutcomeTargetCorrect
+			}
+			if attHead := headRoots[attSlot]; attHead != nil && bytes.Equal(att.Data.BeaconBlockRoot[:], attHead) {
+				outcome |= dbtypes.AttestationOutcomeHeadCorrect
+			}
+			distance := slot - attSlot
+			if distance > 15 {
+				distance = 15
+			}
+			outcome |= dbtypes.AttestationOutcome(distance << 4)
+
+			for bitIdx, validatorIdx := range voteValidators {
+				if !utils.BitAtVector(att.AggregationBits, bitIdx) {
+					continue
+				}
+				if dbtypes.AttestationOutcome(outcomes[validatorIdx]).IsIncluded() {
+					// keep first inclusion
+					continue
+				}
+				outcomes[validatorIdx] = byte(outcome | dbtypes.AttestationOutcomeDuty)
+			}
+		}
+	}
+
+	logger.Debugf("built epoch %v attestation outcomes in %v", epoch, time.Since(t1))
+	return outcomes
+}
+
+func persistEpochAttestationOutcomes(epoch uint64, blockMap map[uint64]*CacheBlock, epochStats *EpochStats, targetRoot []byte, tx *sqlx.Tx) error {
+	if utils.Config.Indexer.DisableAttestationHistory {
+		return nil
+	}
+	outcomes := buildEpochAttestationOutcomes(blockMap, epoch, epochStats, targetRoot, true)
+	if outcomes == nil {
+		return nil
+	}
+	return db.InsertAttestationOutcomes(epoch, outcomes, tx)
+}
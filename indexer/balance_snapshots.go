@@ -0,0 +1,42 @@
+package indexer
+
+import (
+	"encoding/binary"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/ethpandaops/dora/db"
+	"github.com/ethpandaops/dora/dbtypes"
+	"github.com/ethpandaops/dora/utils"
+)
+
+func isBalanceSnapshotEpoch(epoch uint64) bool {
+	interval := utils.Config.Indexer.BalanceSnapshotInterval
+	return interval > 0 && epoch%interval == 0
+}
+
+// buildBalanceSnapshot packs the balances & effective balances of all validators into the compact snapshot format (see dbtypes.BalanceSnapshot).
+func buildBalanceSnapshot(epoch uint64, validators []*phase0.Validator, balances []phase0.Gwei) *dbtypes.BalanceSnapshot {
+	snapshot := &dbtypes.BalanceSnapshot{
+		Epoch:             epoch,
+		Balances:          make([]byte, len(balances)*8),
+		EffectiveBalances: make([]byte, len(validators)*2),
+	}
+	for idx, balance := range balances {
+		binary.BigEndian.PutUint64(snapshot.Balances[idx*8:], uint64(balance))
+	}
+	for idx, validator := range validators {
+		binary.BigEndian.PutUint16(snapshot.EffectiveBalances[idx*2:], uint16(validator.EffectiveBalance/1000000000))
+	}
+	return snapshot
+}
+
+func persistBalanceSnapshot(epochStats *EpochStats, tx *sqlx.Tx) error {
+	epochStats.stateStatsMutex.RLock()
+	defer epochStats.stateStatsMutex.RUnlock()
+	if epochStats.stateStats == nil || epochStats.stateStats.BalanceSnapshot == nil {
+		return nil
+	}
+	return db.InsertBalanceSnapshot(epochStats.stateStats.BalanceSnapshot, tx)
+}
@@ -0,0 +1,158 @@
+package indexer
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/ethpandaops/dora/db"
+	"github.com/ethpandaops/dora/dbtypes"
+	"github.com/ethpandaops/dora/utils"
+)
+
+const blobPrunerBatchSize = 100
+
+// startPruner starts the background pruner if a retention policy is configured.
+// The pruner drops blob payloads from the persistence backend, commitments & assignments are kept.
+func (store *BlobStore) startPruner() {
+	retention := utils.Config.BlobStore.Retention
+	if store.backend == nil {
+		return
+	}
+	if retention.Epochs == 0 && retention.MaxSizeMB == 0 && len(store.keepSenders) == 0 {
+		return
+	}
+
+	go store.runPrunerLoop()
+}
+
+func (store *BlobStore) runPrunerLoop() {
+	defer utils.HandleSubroutinePanic("runBlobPrunerLoop")
+
+	loopInterval := utils.Config.BlobStore.Retention.PruneInterval
+	if loopInterval == 0 {
+		loopInterval = 10 * time.Minute
+	}
+
+	for {
+		time.Sleep(loopInterval)
+		logger_blobs.Debugf("run blob pruner logic")
+
+		prunedCount, err := store.runPruner()
+		if err != nil {
+			logger_blobs.Errorf("blob pruner error: %v", err)
+		}
+		if prunedCount > 0 {
+			logger_blobs.Infof("pruned %v blob payloads", prunedCount)
+		}
+	}
+}
+
+func (store *BlobStore) runPruner() (uint64, error) {
+	retention := utils.Config.BlobStore.Retention
+	prunedCount := uint64(0)
+
+	// drop payloads of blobs from senders that are not on the retention list
+	if len(store.keepSenders) > 0 {
+		count, err := store.pruneCandidates(0, store.keepSenders, 0)
+		prunedCount += count
+		if err != nil {
+			return prunedCount, err
+		}
+	}
+
+	// drop payloads of blobs older than the retention period
+	if retention.Epochs > 0 {
+		currentEpoch := utils.EpochOfSlot(utils.TimeToSlot(uint64(time.Now().Unix())))
+		if currentEpoch > retention.Epochs {
+			retainSlot := (currentEpoch - retention.Epochs) * utils.Config.Chain.Config.SlotsPerEpoch
+			count, err := store.pruneCandidates(retainSlot, nil, 0)
+			prunedCount += count
+			if err != nil {
+				return prunedCount, err
+			}
+		}
+	}
+
+	// drop the oldest payloads until the stored payloads fit into the size limit
+	if retention.MaxSizeMB > 0 {
+		storedSize, err := db.GetStoredBlobSize()
+		if err != nil {
+			return prunedCount, fmt.Errorf("could not get stored blob size: %w", err)
+		}
+		maxSize := retention.MaxSizeMB * 1024 * 1024
+		if storedSize > maxSize {
+			count, err := store.pruneCandidates(0, nil, storedSize-maxSize)
+			prunedCount += count
+			if err != nil {
+				return prunedCount, err
+			}
+		}
+	}
+
+	return prunedCount, nil
+}
+
+// pruneCandidates prunes batches of candidates until there are no more candidates left or (if set) at least pruneSize bytes have been pruned.
+func (store *BlobStore) pruneCandidates(maxSlot uint64, keepSenders [][]byte, pruneSize uint64) (uint64, error) {
+	prunedCount := uint64(0)
+	prunedSize := uint64(0)
+
+	for {
+		candidates, err := db.GetBlobPruneCandidates(maxSlot, keepSenders, blobPrunerBatchSize)
+		if err != nil {
+			return prunedCount, fmt.Errorf("could not get blob prune candidates: %w", err)
+		}
+		if len(candidates) == 0 {
+			return prunedCount, nil
+		}
+
+		if pruneSize > 0 {
+			for idx, candidate := range candidates {
+				prunedSize += uint64(candidate.Size)
+				if prunedSize >= pruneSize {
+					candidates = candidates[:idx+1]
+					break
+				}
+			}
+		}
+
+		count, err := store.pruneBlobs(candidates)
+		prunedCount += count
+		if err != nil {
+			return prunedCount, err
+		}
+
+		if len(candidates) < blobPrunerBatchSize || (pruneSize > 0 && prunedSize >= pruneSize) {
+			return prunedCount, nil
+		}
+	}
+}
+
+// pruneBlobs deletes the payloads of the given blobs and marks them as pruned.
+// Blobs that were deleted before a failing deletion are marked as pruned too.
+func (store *BlobStore) pruneBlobs(candidates []*dbtypes.BlobPruneCandidate) (uint64, error) {
+	var pruneErr error
+	commitments := make([][]byte, 0, len(candidates))
+	for _, candidate := range candidates {
+		err := store.backend.Delete(&dbtypes.Blob{Commitment: candidate.Commitment})
+		if err != nil {
+			pruneErr = fmt.Errorf("could not delete blob from %v backend: %w", store.backend.Name(), err)
+			break
+		}
+
+		commitments = append(commitments, candidate.Commitment)
+	}
+
+	if len(commitments) > 0 {
+		err := db.RunDBTransaction(func(tx *sqlx.Tx) error {
+			return db.SetBlobsPruned(commitments, tx)
+		})
+		if err != nil {
+			return 0, fmt.Errorf("could not mark blobs as pruned: %w", err)
+		}
+	}
+
+	return uint64(len(commitments)), pruneErr
+}
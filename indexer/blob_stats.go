@@ -0,0 +1,55 @@
+package indexer
+
+import (
+	"github.com/ethereum/go-ethereum/consensus/misc/eip4844"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/ethpandaops/dora/db"
+	"github.com/ethpandaops/dora/dbtypes"
+	"github.com/ethpandaops/dora/utils"
+)
+
+// buildBlobStats aggregates the blob count, blob gas usage & blob base fee of the canonical blocks in an epoch
+func buildBlobStats(epoch uint64, blockMap map[uint64]*CacheBlock) *dbtypes.BlobStats {
+	firstSlot := epoch * utils.Config.Chain.Config.SlotsPerEpoch
+	lastSlot := firstSlot + utils.Config.Chain.Config.SlotsPerEpoch - 1
+
+	stats := &dbtypes.BlobStats{
+		Epoch: epoch,
+	}
+	blobBaseFeeSum := uint64(0)
+	for slot := firstSlot; slot <= lastSlot; slot++ {
+		block := blockMap[slot]
+		if block == nil {
+			continue
+		}
+		blockBody := block.GetBlockBody()
+		if blockBody == nil {
+			continue
+		}
+		blobGasUsed, excessBlobGas, err := GetExecutionBlobGas(blockBody)
+		if err != nil {
+			continue
+		}
+		blobCommitments, _ := blockBody.BlobKZGCommitments()
+
+		stats.BlockCount++
+		stats.BlobCount += uint64(len(blobCommitments))
+		stats.BlobGasUsed += blobGasUsed
+		if blobBaseFee := eip4844.CalcBlobFee(excessBlobGas); blobBaseFee.IsUint64() {
+			blobBaseFeeSum += blobBaseFee.Uint64()
+		}
+	}
+	if stats.BlockCount > 0 {
+		stats.BlobBaseFee = blobBaseFeeSum / stats.BlockCount
+	}
+	return stats
+}
+
+func persistBlobStats(epoch uint64, blockMap map[uint64]*CacheBlock, tx *sqlx.Tx) error {
+	stats := buildBlobStats(epoch, blockMap)
+	if stats.BlockCount == 0 {
+		return nil
+	}
+	return db.InsertBlobStats(stats, tx)
+}
@@ -2,17 +2,16 @@ package indexer
 
 import (
 	"bytes"
-	"crypto/sha256"
+	"errors"
 	"fmt"
-	"os"
-	"path"
-	"strings"
 
 	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/jmoiron/sqlx"
 	"github.com/sirupsen/logrus"
 
-	"github.com/ethpandaops/dora/aws"
+	"github.com/ethpandaops/dora/blobstore"
 	"github.com/ethpandaops/dora/db"
 	"github.com/ethpandaops/dora/dbtypes"
 	"github.com/ethpandaops/dora/utils"
@@ -20,59 +19,93 @@ import (
 
 var logger_blobs = logrus.StandardLogger().WithField("module", "blobstore")
 
-const (
-	blobPersistenceModeNone uint64 = 0
-	blobPersistenceModeDb   uint64 = 1
-	blobPersistenceModeFs   uint64 = 2
-	blobPersistenceModeAws  uint64 = 3
-)
-
 type BlobStore struct {
-	mode    uint64
-	s3Store *aws.S3Store
+	backend     blobstore.BlobBackend
+	keepSenders [][]byte
 }
 
 type BlobAssignment struct {
-	Root []byte
-	Slot uint64
-	Blob *deneb.BlobSidecar
+	Root     []byte
+	Slot     uint64
+	Blob     *deneb.BlobSidecar
+	TxHash   []byte
+	TxSender []byte
 }
 
 func newBlobStore() *BlobStore {
 	store := &BlobStore{}
 
-	switch utils.Config.BlobStore.PersistenceMode {
-	case "none":
-		store.mode = blobPersistenceModeNone
-	case "db":
-		store.mode = blobPersistenceModeDb
-	case "fs":
-		if utils.Config.BlobStore.Fs.Path == "" {
-			logger_blobs.Errorf("cannot init blobstore with 'fs' engine: missing path")
-			break
+	backend, err := blobstore.NewBlobBackend(utils.Config.BlobStore.PersistenceMode)
+	if err != nil {
+		logger_blobs.Errorf("cannot init blobstore with '%v' engine: %v", utils.Config.BlobStore.PersistenceMode, err)
+	} else if backend != nil {
+		store.backend = backend
+		if tieredBackend, isTiered := backend.(*blobstore.TieredBackend); isTiered {
+			tieredBackend.StartHotTierCleanup()
 		}
-		os.Mkdir(utils.Config.BlobStore.Fs.Path, 0755)
-		store.mode = blobPersistenceModeFs
-	case "aws":
-		s3store, err := aws.NewS3Store(utils.Config.BlobStore.Aws.AccessKey, utils.Config.BlobStore.Aws.SecretKey, utils.Config.BlobStore.Aws.S3Region, utils.Config.BlobStore.Aws.S3Bucket)
-		if err != nil {
-			logger_blobs.Errorf("cannot init blobstore with 'aws' engine: %v", err)
-			break
+	}
+
+	for _, address := range utils.Config.BlobStore.Retention.Addresses {
+		if !common.IsHexAddress(address) {
+			logger_blobs.Warnf("ignoring invalid blob retention address: %v", address)
+			continue
 		}
-		store.mode = blobPersistenceModeAws
-		store.s3Store = s3store
+		store.keepSenders = append(store.keepSenders, common.HexToAddress(address).Bytes())
 	}
 	return store
 }
 
-func (store *BlobStore) getBlobName(blob *dbtypes.Blob) string {
-	blobName := utils.Config.BlobStore.NameTemplate
-	blobName = strings.ReplaceAll(blobName, "{commitment}", fmt.Sprintf("%x", blob.Commitment))
+// isRetainedSender checks if the payloads of blobs from the given sender are kept by the retention policy
+func (store *BlobStore) isRetainedSender(sender []byte) bool {
+	if len(store.keepSenders) == 0 {
+		return true
+	}
+	for _, keepSender := range store.keepSenders {
+		if bytes.Equal(keepSender, sender) {
+			return true
+		}
+	}
+	return false
+}
+
+// buildBlobAssignments assigns the blob sidecars of a block to the blob transactions of its execution payload
+func buildBlobAssignments(block *CacheBlock, blobSidecars []*deneb.BlobSidecar) []*BlobAssignment {
+	type blobTxRef struct {
+		hash   []byte
+		sender []byte
+	}
+	blobTxRefs := map[common.Hash]*blobTxRef{}
+	executionTransactions, _ := block.GetBlockBody().ExecutionTransactions()
+	for _, txBytes := range executionTransactions {
+		tx := &ethtypes.Transaction{}
+		if err := tx.UnmarshalBinary(txBytes); err != nil || tx.Type() != ethtypes.BlobTxType {
+			continue
+		}
+		txRef := &blobTxRef{
+			hash: tx.Hash().Bytes(),
+		}
+		if txSender, err := ethtypes.Sender(ethtypes.LatestSignerForChainID(tx.ChainId()), tx); err == nil {
+			txRef.sender = txSender.Bytes()
+		}
+		for _, blobHash := range tx.BlobHashes() {
+			blobTxRefs[blobHash] = txRef
+		}
+	}
 
-	versionedHash := sha256.Sum256(blob.Commitment)
-	versionedHash[0] = 0x01
-	blobName = strings.ReplaceAll(blobName, "{hash}", fmt.Sprintf("%x", versionedHash))
-	return blobName
+	blobs := make([]*BlobAssignment, 0, len(blobSidecars))
+	for _, blobSidecar := range blobSidecars {
+		blob := &BlobAssignment{
+			Slot: block.Slot,
+			Root: block.Root,
+			Blob: blobSidecar,
+		}
+		if txRef := blobTxRefs[common.BytesToHash(blobstore.GetBlobVersionedHash(blobSidecar.KZGCommitment[:]))]; txRef != nil {
+			blob.TxHash = txRef.hash
+			blob.TxSender = txRef.sender
+		}
+		blobs = append(blobs, blob)
+	}
+	return blobs
 }
 
 func (store *BlobStore) saveBlob(blob *BlobAssignment, tx *sqlx.Tx) error {
@@ -82,26 +115,22 @@ func (store *BlobStore) saveBlob(blob *BlobAssignment, tx *sqlx.Tx) error {
 		Size:       uint32(len(blob.Blob.Blob)),
 	}
 	dbBlobAssignment := &dbtypes.BlobAssignment{
-		Root:       blob.Root[:],
-		Commitment: blob.Blob.KZGCommitment[:],
-		Slot:       uint64(blob.Slot),
+		Root:          blob.Root[:],
+		Commitment:    blob.Blob.KZGCommitment[:],
+		Slot:          uint64(blob.Slot),
+		BlobIndex:     uint64(blob.Blob.Index),
+		VersionedHash: blobstore.GetBlobVersionedHash(blob.Blob.KZGCommitment[:]),
+		TxHash:        blob.TxHash,
+		TxSender:      blob.TxSender,
 	}
-	blobName := store.getBlobName(dbBlob)
-
-	switch store.mode {
-	case blobPersistenceModeDb:
-		blobData := blob.Blob.Blob[:]
-		dbBlob.Blob = &blobData
-	case blobPersistenceModeFs:
-		blobFile := path.Join(utils.Config.BlobStore.Fs.Path, blobName)
-		err := os.WriteFile(blobFile, blob.Blob.Blob[:], 0644)
-		if err != nil {
-			return fmt.Errorf("could not save blob to file '%v': %w", blobFile, err)
-		}
-	case blobPersistenceModeAws:
-		err := store.s3Store.Upload(blobName, blob.Blob.Blob[:])
-		if err != nil {
-			return fmt.Errorf("could not upload blob to s3 '%v': %w", blobName, err)
+	if store.backend != nil {
+		if !store.isRetainedSender(blob.TxSender) {
+			dbBlob.Pruned = true
+		} else {
+			err := store.backend.Store(dbBlob, blob.Blob.Blob[:])
+			if err != nil {
+				return fmt.Errorf("could not save blob to %v backend: %w", store.backend.Name(), err)
+			}
 		}
 	}
 
@@ -119,33 +148,20 @@ func (store *BlobStore) saveBlob(blob *BlobAssignment, tx *sqlx.Tx) error {
 
 func (store *BlobStore) LoadBlob(commitment []byte, blockroot []byte, client *ConsensusClient) (*dbtypes.Blob, error) {
 	dbBlob := db.GetBlob(commitment, true)
-	if dbBlob != nil {
-		blobName := store.getBlobName(dbBlob)
-
-		if dbBlob.Blob == nil {
-			switch store.mode {
-			case blobPersistenceModeFs:
-				blobFile := path.Join(utils.Config.BlobStore.Fs.Path, blobName)
-				data, err := os.ReadFile(blobFile)
-				if err != nil {
-					logger_blobs.Warnf("cannot load blob from fs (%v): %v", blobFile, err)
-				} else {
-					dbBlob.Blob = &data
-				}
-			case blobPersistenceModeAws:
-				data, err := store.s3Store.Download(blobName)
-				if err != nil {
-					logger_blobs.Warnf("cannot load blob from aws (%v): %v", blobName, err)
-				} else {
-					dbBlob.Blob = &data
-				}
-			}
+	if dbBlob != nil && dbBlob.Blob == nil && !dbBlob.Pruned && store.backend != nil {
+		data, err := store.backend.Load(dbBlob)
+		if errors.Is(err, blobstore.ErrBlobNotFound) {
+			logger_blobs.Debugf("blob not found in %v backend (0x%x)", store.backend.Name(), commitment)
+		} else if err != nil {
+			logger_blobs.Warnf("cannot load blob from %v backend (0x%x): %v", store.backend.Name(), commitment, err)
+		} else {
+			dbBlob.Blob = &data
 		}
 	}
 
 	if (dbBlob == nil || dbBlob.Blob == nil) && client != nil {
 		if blockroot == nil {
-			latestAssignment := db.GetLatestBlobAssignment(dbBlob.Commitment)
+			latestAssignment := db.GetLatestBlobAssignment(commitment)
 			if latestAssignment != nil {
 				blockroot = latestAssignment.Root
 			}
@@ -0,0 +1,166 @@
+package indexer
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/ethpandaops/dora/dbtypes"
+	"github.com/ethpandaops/dora/rpc"
+	"github.com/ethpandaops/dora/utils"
+)
+
+// blockDataBackfillMaxDuration limits the time the finalization waits for the back-fill of optional block data
+const blockDataBackfillMaxDuration = 30 * time.Second
+
+// getBlockPriorityFees sums up the priority fees (tips) paid to the fee recipient of the execution payload (in gwei).
+// The gas used per transaction is not part of the beacon block, so the receipts are loaded from an execution client.
+func getBlockPriorityFees(executionClient *ExecutionClient, blockBody *spec.VersionedSignedBeaconBlock) (*uint64, error) {
+	executionHash, err := blockBody.ExecutionBlockHash()
+	if err != nil || executionHash == (phase0.Hash32{}) {
+		return nil, nil // pre-merge block
+	}
+	baseFee, err := GetExecutionBaseFee(blockBody)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	receipts, err := executionClient.rpcClient.GetBlockReceipts(ctx, common.Hash(executionHash))
+	if err != nil {
+		return nil, fmt.Errorf("error loading receipts of execution block 0x%x from %v: %v", executionHash, executionClient.clientName, err)
+	}
+
+	priorityFees := new(big.Int)
+	for _, receipt := range receipts {
+		if receipt.EffectiveGasPrice == nil || receipt.EffectiveGasPrice.Cmp(baseFee) <= 0 {
+			continue
+		}
+		tip := new(big.Int).Sub(receipt.EffectiveGasPrice, baseFee)
+		priorityFees.Add(priorityFees, tip.Mul(tip, new(big.Int).SetUint64(receipt.GasUsed)))
+	}
+	priorityFeesGwei := priorityFees.Div(priorityFees, big.NewInt(1000000000)).Uint64()
+	return &priorityFeesGwei, nil
+}
+
+// loadBlockPriorityFees loads the priority fees of a cached block from any ready execution client
+func (indexer *Indexer) loadBlockPriorityFees(block *CacheBlock) {
+	if block.GetPriorityFees() != nil {
+		return
+	}
+	blockBody := block.GetBlockBody()
+	if blockBody == nil {
+		return
+	}
+	executionClient := indexer.GetReadyElClient(false, block.Root, nil)
+	if executionClient == nil {
+		return
+	}
+
+	priorityFees, err := getBlockPriorityFees(executionClient, blockBody)
+	if err != nil {
+		logger.Debugf("error loading priority fees of block %v [0x%x]: %v", block.Slot, block.Root, err)
+		return
+	}
+
+	block.mutex.Lock()
+	block.priorityFees = priorityFees
+	block.mutex.Unlock()
+}
+
+// loadRewards loads the consensus layer proposer rewards of the block from the given client.
+// The rewards endpoint is not supported by all clients, so failed attempts are remembered and only retried when forced (back-fill on finalization).
+func (block *CacheBlock) loadRewards(client *ConsensusClient, force bool) {
+	block.mutex.Lock()
+	if block.rewards != nil || (block.rewardsAttempted && !force) {
+		block.mutex.Unlock()
+		return
+	}
+	block.rewardsAttempted = true
+	block.mutex.Unlock()
+
+	if client.rewardsUnsupported.Load() {
+		return
+	}
+
+	rewardsRsp, err := client.rpcClient.GetBlockRewardsByBlockroot(block.Root)
+	if err != nil {
+		logger.WithField("client", client.clientName).Debugf("could not load rewards of block %v [0x%x]: %v", block.Slot, block.Root, err)
+		return
+	}
+	if rewardsRsp == nil {
+		// the client does not serve the rewards endpoint, don't retry it for every block
+		if !client.rewardsUnsupported.Swap(true) {
+			logger.WithField("client", client.clientName).Infof("block rewards endpoint not supported, skipping block rewards from this client")
+		}
+		return
+	}
+
+	block.mutex.Lock()
+	block.rewards = rewardsRsp
+	block.mutex.Unlock()
+}
+
+// GetRewards returns the consensus layer proposer rewards of the block (nil if not loaded)
+func (block *CacheBlock) GetRewards() *rpc.BlockRewards {
+	block.mutex.RLock()
+	defer block.mutex.RUnlock()
+	return block.rewards
+}
+
+// GetPriorityFees returns the execution layer priority fees of the block in gwei (nil if not loaded)
+func (block *CacheBlock) GetPriorityFees() *uint64 {
+	block.mutex.RLock()
+	defer block.mutex.RUnlock()
+	return block.priorityFees
+}
+
+func applyBlockRewards(dbBlock *dbtypes.Slot, rewards *rpc.BlockRewards, priorityFees *uint64) {
+	if rewards != nil {
+		slashingRewards := rewards.ProposerSlashings + rewards.AttesterSlashings
+		dbBlock.ClReward = &rewards.Total
+		dbBlock.ClRewardAttestations = &rewards.Attestations
+		dbBlock.ClRewardSync = &rewards.SyncAggregate
+		dbBlock.ClRewardSlashings = &slashingRewards
+	}
+	dbBlock.ElPriorityFees = priorityFees
+}
+
+// backfillBlockData loads missing execution requests, rewards & priority fees of blocks restored from the unfinalized db or with failed loading attempts.
+// Already loaded data is skipped by the loaders, so only missing data is requested.
+// The blocks are loaded in parallel and the finalization waits at most blockDataBackfillMaxDuration, data loaded later is not persisted.
+func (indexer *Indexer) backfillBlockData(client *ConsensusClient, epoch uint64, blocks map[uint64]*CacheBlock) {
+	var wg sync.WaitGroup
+	for _, block := range blocks {
+		wg.Add(1)
+		go func(block *CacheBlock) {
+			defer wg.Done()
+			defer utils.HandleSubroutinePanic("backfillBlockData")
+
+			if client != nil {
+				block.loadExecutionRequests(client, true)
+				block.loadRewards(client, true)
+			}
+			indexer.loadBlockPriorityFees(block)
+		}(block)
+	}
+
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(blockDataBackfillMaxDuration):
+		logger.Warnf("back-fill of block data for epoch %v took too long, skipping missing block data", epoch)
+	}
+}
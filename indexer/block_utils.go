@@ -2,6 +2,7 @@ package indexer
 
 import (
 	"errors"
+	"math/big"
 
 	"github.com/attestantio/go-eth2-client/spec"
 )
@@ -30,3 +31,76 @@ func GetExecutionExtraData(v *spec.VersionedSignedBeaconBlock) ([]byte, error) {
 		return nil, errors.New("unknown version")
 	}
 }
+
+func GetExecutionFeeRecipient(v *spec.VersionedSignedBeaconBlock) ([]byte, error) {
+	switch v.Version {
+	case spec.DataVersionBellatrix:
+		if v.Bellatrix == nil || v.Bellatrix.Message == nil || v.Bellatrix.Message.Body == nil || v.Bellatrix.Message.Body.ExecutionPayload == nil {
+			return nil, errors.New("no bellatrix block")
+		}
+
+		return v.Bellatrix.Message.Body.ExecutionPayload.FeeRecipient[:], nil
+	case spec.DataVersionCapella:
+		if v.Capella == nil || v.Capella.Message == nil || v.Capella.Message.Body == nil || v.Capella.Message.Body.ExecutionPayload == nil {
+			return nil, errors.New("no capella block")
+		}
+
+		return v.Capella.Message.Body.ExecutionPayload.FeeRecipient[:], nil
+	case spec.DataVersionDeneb:
+		if v.Deneb == nil || v.Deneb.Message == nil || v.Deneb.Message.Body == nil || v.Deneb.Message.Body.ExecutionPayload == nil {
+			return nil, errors.New("no denb block")
+		}
+
+		return v.Deneb.Message.Body.ExecutionPayload.FeeRecipient[:], nil
+	default:
+		return nil, errors.New("unknown version")
+	}
+}
+
+func GetExecutionBaseFee(v *spec.VersionedSignedBeaconBlock) (*big.Int, error) {
+	switch v.Version {
+	case spec.DataVersionBellatrix:
+		if v.Bellatrix == nil || v.Bellatrix.Message == nil || v.Bellatrix.Message.Body == nil || v.Bellatrix.Message.Body.ExecutionPayload == nil {
+			return nil, errors.New("no bellatrix block")
+		}
+
+		return baseFeeFromLittleEndian(v.Bellatrix.Message.Body.ExecutionPayload.BaseFeePerGas), nil
+	case spec.DataVersionCapella:
+		if v.Capella == nil || v.Capella.Message == nil || v.Capella.Message.Body == nil || v.Capella.Message.Body.ExecutionPayload == nil {
+			return nil, errors.New("no capella block")
+		}
+
+		return baseFeeFromLittleEndian(v.Capella.Message.Body.ExecutionPayload.BaseFeePerGas), nil
+	case spec.DataVersionDeneb:
+		if v.Deneb == nil || v.Deneb.Message == nil || v.Deneb.Message.Body == nil || v.Deneb.Message.Body.ExecutionPayload == nil || v.Deneb.Message.Body.ExecutionPayload.BaseFeePerGas == nil {
+			return nil, errors.New("no denb block")
+		}
+
+		return v.Deneb.Message.Body.ExecutionPayload.BaseFeePerGas.ToBig(), nil
+	default:
+		return nil, errors.New("unknown version")
+	}
+}
+
+func baseFeeFromLittleEndian(baseFee [32]byte) *big.Int {
+	bigEndian := make([]byte, 32)
+	for i := 0; i < 32; i++ {
+		bigEndian[i] = baseFee[31-i]
+	}
+	return new(big.Int).SetBytes(bigEndian)
+}
+
+func GetExecutionBlobGas(v *spec.VersionedSignedBeaconBlock) (blobGasUsed uint64, excessBlobGas uint64, err error) {
+	switch v.Version {
+	case spec.DataVersionBellatrix, spec.DataVersionCapella:
+		return 0, 0, errors.New("no blob gas before deneb")
+	case spec.DataVersionDeneb:
+		if v.Deneb == nil || v.Deneb.Message == nil || v.Deneb.Message.Body == nil || v.Deneb.Message.Body.ExecutionPayload == nil {
+			return 0, 0, errors.New("no denb block")
+		}
+
+		return v.Deneb.Message.Body.ExecutionPayload.BlobGasUsed, v.Deneb.Message.Body.ExecutionPayload.ExcessBlobGas, nil
+	default:
+		return 0, 0, errors.New("unknown version")
+	}
+}
@@ -110,6 +110,14 @@ func (cache *indexerCache) setFinalizedHead(finalizedEpoch int64, finalizedRoot
 		case cache.triggerChan <- true:
 		default:
 		}
+
+		cache.indexer.eventDispatcher.fire(&IndexerEvent{
+			Type:           FinalizedCheckpointEventType,
+			Epoch:          uint64(finalizedEpoch),
+			FinalizedRoot:  finalizedRoot,
+			JustifiedEpoch: uint64(cache.justifiedEpoch),
+			JustifiedRoot:  cache.justifiedRoot,
+		})
 	}
 }
 
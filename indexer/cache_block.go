@@ -7,6 +7,8 @@ import (
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/ethpandaops/dora/db"
 	"github.com/ethpandaops/dora/dbtypes"
+	"github.com/ethpandaops/dora/rpc"
+	"github.com/ethpandaops/dora/utils"
 )
 
 type CacheBlock struct {
@@ -18,6 +20,11 @@ type CacheBlock struct {
 	isInFinalizedDb   bool
 	header            *phase0.SignedBeaconBlockHeader
 	block             *spec.VersionedSignedBeaconBlock
+	executionRequests *rpc.ExecutionRequests
+	requestsAttempted bool
+	rewards           *rpc.BlockRewards
+	rewardsAttempted  bool
+	priorityFees      *uint64
 	Refs              struct {
 		ExecutionHash   []byte
 		ExecutionNumber uint64
@@ -163,6 +170,39 @@ func (block *CacheBlock) GetBlockBody() *spec.VersionedSignedBeaconBlock {
 	return block.block
 }
 
+func (block *CacheBlock) GetExecutionRequests() *rpc.ExecutionRequests {
+	block.mutex.RLock()
+	defer block.mutex.RUnlock()
+	return block.executionRequests
+}
+
+// loadExecutionRequests loads the execution requests of an electra block from the given client.
+// The requests are not part of the block body we get from the client library, so they need a separate call.
+// Failed attempts are remembered and only retried when forced (back-fill on finalization).
+func (block *CacheBlock) loadExecutionRequests(client *ConsensusClient, force bool) {
+	if !utils.IsElectraEpoch(utils.EpochOfSlot(block.Slot)) {
+		return
+	}
+
+	block.mutex.Lock()
+	if block.executionRequests != nil || (block.requestsAttempted && !force) {
+		block.mutex.Unlock()
+		return
+	}
+	block.requestsAttempted = true
+	block.mutex.Unlock()
+
+	requestsRsp, err := client.rpcClient.GetBlockExecutionRequestsByBlockroot(block.Root)
+	if err != nil {
+		logger.WithField("client", client.clientName).Warnf("could not load execution requests of block %v [0x%x]: %v", block.Slot, block.Root, err)
+		return
+	}
+
+	block.mutex.Lock()
+	block.executionRequests = requestsRsp
+	block.mutex.Unlock()
+}
+
 func (block *CacheBlock) IsCanonical(indexer *Indexer, head []byte) bool {
 	if head == nil {
 		_, head = indexer.GetCanonicalHead()
@@ -173,26 +173,29 @@ func (cache *indexerCache) processFinalizedEpoch(epoch uint64) error {
 			if err != nil {
 				return fmt.Errorf("cannot load blobs for block 0x%x: %v", block.Root, err)
 			}
-			for _, blob := range blobRsp {
-				blobs = append(blobs, &BlobAssignment{
-					Slot: slot,
-					Root: block.Root,
-					Blob: blob,
-				})
-			}
+			blobs = append(blobs, buildBlobAssignments(block, blobRsp)...)
 		}
 	}
 	if len(blobs) > 0 {
 		logger.Infof("epoch %v blobs: %v blob sidecars in %v blocks", epoch, len(blobs), slotsWithBlobs)
 	}
 
+	// back-fill execution requests, rewards & priority fees (optional)
+	cache.indexer.backfillBlockData(client, epoch, canonicalMap)
+
 	// append next epoch blocks (needed for vote aggregation)
 	for slot, block := range cache.getCanonicalBlockMap(epoch+1, nil) {
 		canonicalMap[slot] = block
 	}
 
+	// load attestation & sync committee rewards (optional)
+	var epochRewards *dbtypes.EpochRewards
+	if epochStats != nil {
+		epochRewards = loadEpochRewards(client, epoch, canonicalMap, epochStats)
+	}
+
 	// store canonical blocks to db and remove from cache
-	return db.RunDBTransaction(func(tx *sqlx.Tx) error {
+	err := db.RunDBTransaction(func(tx *sqlx.Tx) error {
 		if len(blobs) > 0 {
 			for _, blob := range blobs {
 				err := cache.indexer.BlobStore.saveBlob(blob, tx)
@@ -203,6 +206,12 @@ func (cache *indexerCache) processFinalizedEpoch(epoch uint64) error {
 			}
 		}
 
+		err := persistBlobStats(epoch, canonicalMap, tx)
+		if err != nil {
+			logger.Errorf("error persisting blob stats to db: %v", err)
+			return err
+		}
+
 		if epochStats != nil {
 			// calculate votes
 			epochVotes := aggregateEpochVotes(canonicalMap, epoch, epochStats, epochTarget, false, true)
@@ -214,12 +223,42 @@ func (cache *indexerCache) processFinalizedEpoch(epoch uint64) error {
 			logger.Infof("epoch %v votes: head %v + %v = %v", epoch, epochVotes.currentEpoch.headVoteAmount, epochVotes.nextEpoch.headVoteAmount, epochVotes.currentEpoch.headVoteAmount+epochVotes.nextEpoch.headVoteAmount)
 			logger.Infof("epoch %v votes: total %v + %v = %v", epoch, epochVotes.currentEpoch.totalVoteAmount, epochVotes.nextEpoch.totalVoteAmount, epochVotes.currentEpoch.totalVoteAmount+epochVotes.nextEpoch.totalVoteAmount)
 
-			err := persistEpochData(epoch, canonicalMap, epochStats, epochVotes, tx)
+			err := persistEpochData(epoch, canonicalMap, epochStats, epochVotes, cache.indexer.GetCachedValidatorSet(), tx)
 			if err != nil {
 				logger.Errorf("error persisting epoch data to db: %v", err)
 				return err
 			}
 
+			err = persistEpochAttestationOutcomes(epoch, canonicalMap, epochStats, epochTarget, tx)
+			if err != nil {
+				logger.Errorf("error persisting attestation outcomes to db: %v", err)
+				return err
+			}
+
+			err = persistBalanceSnapshot(epochStats, tx)
+			if err != nil {
+				logger.Errorf("error persisting balance snapshot to db: %v", err)
+				return err
+			}
+
+			err = persistValidatorQueueStats(epochStats, tx)
+			if err != nil {
+				logger.Errorf("error persisting validator queue stats to db: %v", err)
+				return err
+			}
+
+			err = persistClientDiversity(epoch, canonicalMap, epochStats, tx)
+			if err != nil {
+				logger.Errorf("error persisting client diversity to db: %v", err)
+				return err
+			}
+
+			err = persistEpochRewards(epochRewards, tx)
+			if err != nil {
+				logger.Errorf("error persisting epoch rewards to db: %v", err)
+				return err
+			}
+
 			if len(epochStats.syncAssignments) > 0 {
 				err = persistSyncAssignments(epoch, epochStats, tx)
 				if err != nil {
@@ -242,7 +281,7 @@ func (cache *indexerCache) processFinalizedEpoch(epoch uint64) error {
 					continue
 				}
 
-				err := persistBlockData(block, nil, nil, false, tx)
+				err := persistBlockData(block, nil, nil, cache.indexer.GetCachedValidatorSet(), false, tx)
 				if err != nil {
 					logger.Errorf("error while persisting slot: %v", err)
 				}
@@ -252,6 +291,15 @@ func (cache *indexerCache) processFinalizedEpoch(epoch uint64) error {
 
 		return nil
 	})
+	if err != nil {
+		return err
+	}
+
+	if epochStats != nil {
+		cache.indexer.eventDispatcher.fire(buildFinalizedEpochEvent(epoch, canonicalMap, epochStats))
+		cache.indexer.depositIndexer.setFinalizedEth1Data(epochStats.GetEth1Data())
+	}
+	return nil
 }
 
 func (cache *indexerCache) processOrphanedBlocks(processedEpoch int64) error {
@@ -300,7 +348,7 @@ func (cache *indexerCache) processOrphanedBlocks(processedEpoch int64) error {
 				db.InsertOrphanedBlock(block.buildOrphanedBlock(), tx)
 			}
 
-			err := persistBlockData(block, cache.getEpochStats(utils.EpochOfSlot(block.Slot), nil), nil, !isCanonical, tx)
+			err := persistBlockData(block, cache.getEpochStats(utils.EpochOfSlot(block.Slot), nil), nil, cache.indexer.GetCachedValidatorSet(), !isCanonical, tx)
 			if err != nil {
 				logger.Errorf("error while persisting orphaned slot: %v", err)
 			}
@@ -406,7 +454,7 @@ func (cache *indexerCache) processCachePersistence() error {
 					}
 
 					// insert child objects as orphaned (we don't know if they're canonical yet)
-					err = persistBlockChildObjects(block, nil, true, tx)
+					err = persistBlockChildObjects(block, nil, cache.indexer.GetCachedValidatorSet(), true, tx)
 					if err != nil {
 						return err
 					}
@@ -0,0 +1,203 @@
+package indexer
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"sync"
+
+	"github.com/jmoiron/sqlx"
+	"gopkg.in/yaml.v3"
+
+	"github.com/ethpandaops/dora/config"
+	"github.com/ethpandaops/dora/db"
+	"github.com/ethpandaops/dora/dbtypes"
+	"github.com/ethpandaops/dora/types"
+	"github.com/ethpandaops/dora/utils"
+)
+
+type clientDiversityRule struct {
+	client     string
+	layer      string
+	source     string
+	expression *regexp.Regexp
+}
+
+var clientDiversityRules []*clientDiversityRule
+var clientDiversityRulesOnce sync.Once
+
+// getClientDiversityRules returns the compiled rule set (custom rules from the config, followed by the rules file or the default rule set)
+func getClientDiversityRules() []*clientDiversityRule {
+	clientDiversityRulesOnce.Do(func() {
+		ruleConfigs := []types.ClientDiversityRule{}
+		ruleConfigs = append(ruleConfigs, utils.Config.ClientDiversity.Rules...)
+
+		var defaultRules []types.ClientDiversityRule
+		var err error
+		if utils.Config.ClientDiversity.RulesFile != "" {
+			var rulesYaml []byte
+			rulesYaml, err = os.ReadFile(utils.Config.ClientDiversity.RulesFile)
+			if err == nil {
+				err = yaml.Unmarshal(rulesYaml, &defaultRules)
+			}
+		} else {
+			err = yaml.Unmarshal([]byte(config.ClientDiversityRulesYml), &defaultRules)
+		}
+		if err != nil {
+			logger.Errorf("error loading client diversity rules: %v", err)
+		}
+		ruleConfigs = append(ruleConfigs, defaultRules...)
+
+		clientDiversityRules = make([]*clientDiversityRule, 0, len(ruleConfigs))
+		for _, ruleConfig := range ruleConfigs {
+			if ruleConfig.Layer != "cl" && ruleConfig.Layer != "el" {
+				logger.Warnf("invalid client diversity rule for %v: unknown layer '%v'", ruleConfig.Client, ruleConfig.Layer)
+				continue
+			}
+			if ruleConfig.Source != "graffiti" && ruleConfig.Source != "extra" {
+				logger.Warnf("invalid client diversity rule for %v: unknown source '%v'", ruleConfig.Client, ruleConfig.Source)
+				continue
+			}
+			expression, err := regexp.Compile(ruleConfig.Pattern)
+			if err != nil {
+				logger.Warnf("invalid client diversity rule for %v: %v", ruleConfig.Client, err)
+				continue
+			}
+			clientDiversityRules = append(clientDiversityRules, &clientDiversityRule{
+				client:     ruleConfig.Client,
+				layer:      ruleConfig.Layer,
+				source:     ruleConfig.Source,
+				expression: expression,
+			})
+		}
+		logger.Infof("loaded %v client diversity rules", len(clientDiversityRules))
+	})
+	return clientDiversityRules
+}
+
+// ClassifyProposerClients returns the cl & el client of a block proposer based on the block graffiti & execution extra data (empty if unknown)
+func ClassifyProposerClients(graffiti []byte, extraData []byte) (string, string) {
+	graffitiText := utils.GraffitiToString(graffiti)
+	extraText := utils.GraffitiToString(extraData)
+	clClient := ""
+	elClient := ""
+	for _, rule := range getClientDiversityRules() {
+		if (rule.layer == "cl" && clClient != "") || (rule.layer == "el" && elClient != "") {
+			continue
+		}
+
+		text := graffitiText
+		if rule.source == "extra" {
+			text = extraText
+		}
+		if text == "" || !rule.expression.MatchString(text) {
+			continue
+		}
+
+		if rule.layer == "cl" {
+			clClient = rule.client
+		} else {
+			elClient = rule.client
+		}
+		if clClient != "" && elClient != "" {
+			break
+		}
+	}
+	return clClient, elClient
+}
+
+// buildClientDiversity aggregates the proposed & missed slots of an epoch per client combination.
+// Missed slots are attributed to the clients of the latest canonical block of the proposer.
+func buildClientDiversity(epoch uint64, blockMap map[uint64]*CacheBlock, epochStats *EpochStats) []*dbtypes.ClientDiversity {
+	statsMap := map[string]*dbtypes.ClientDiversity{}
+	getStats := func(clClient, elClient string) *dbtypes.ClientDiversity {
+		key := fmt.Sprintf("%v:%v", clClient, elClient)
+		stats := statsMap[key]
+		if stats == nil {
+			stats = &dbtypes.ClientDiversity{
+				Epoch:    epoch,
+				ClClient: clClient,
+				ElClient: elClient,
+			}
+			statsMap[key] = stats
+		}
+		return stats
+	}
+
+	firstSlot := epoch * utils.Config.Chain.Config.SlotsPerEpoch
+	lastSlot := firstSlot + utils.Config.Chain.Config.SlotsPerEpoch - 1
+	proposerBlocks := map[uint64]*CacheBlock{}
+	missedProposers := map[uint64][]uint64{}
+	for slot := firstSlot; slot <= lastSlot; slot++ {
+		block := blockMap[slot]
+		if block == nil {
+			if epochStats != nil && epochStats.proposerAssignments != nil {
+				proposer := epochStats.proposerAssignments[slot]
+				missedProposers[proposer] = append(missedProposers[proposer], slot)
+			}
+			continue
+		}
+
+		blockBody := block.GetBlockBody()
+		if blockBody == nil {
+			continue
+		}
+		graffiti, _ := blockBody.Graffiti()
+		extraData, _ := GetExecutionExtraData(blockBody)
+		clClient, elClient := ClassifyProposerClients(graffiti[:], extraData)
+		getStats(clClient, elClient).ProposedCount++
+		proposerBlocks[uint64(block.header.Message.ProposerIndex)] = block
+	}
+
+	if len(missedProposers) > 0 {
+		proposerClients := map[uint64][2]string{}
+		lookupProposers := []uint64{}
+		for proposer := range missedProposers {
+			if block := proposerBlocks[proposer]; block != nil {
+				blockBody := block.GetBlockBody()
+				graffiti, _ := blockBody.Graffiti()
+				extraData, _ := GetExecutionExtraData(blockBody)
+				clClient, elClient := ClassifyProposerClients(graffiti[:], extraData)
+				proposerClients[proposer] = [2]string{clClient, elClient}
+			} else {
+				lookupProposers = append(lookupProposers, proposer)
+			}
+		}
+		if len(lookupProposers) > 0 {
+			latestBlocks, err := db.GetLatestProposerBlocks(lookupProposers)
+			if err != nil {
+				logger.Warnf("error loading latest blocks of missed slot proposers: %v", err)
+			}
+			for _, latestBlock := range latestBlocks {
+				clClient, elClient := ClassifyProposerClients(latestBlock.Graffiti, latestBlock.EthBlockExtra)
+				proposerClients[latestBlock.Proposer] = [2]string{clClient, elClient}
+			}
+		}
+
+		for proposer, slots := range missedProposers {
+			clients := proposerClients[proposer]
+			getStats(clients[0], clients[1]).MissedCount += uint64(len(slots))
+		}
+	}
+
+	stats := make([]*dbtypes.ClientDiversity, 0, len(statsMap))
+	for _, stat := range statsMap {
+		stats = append(stats, stat)
+	}
+	sort.Slice(stats, func(a, b int) bool {
+		if stats[a].ClClient != stats[b].ClClient {
+			return stats[a].ClClient < stats[b].ClClient
+		}
+		return stats[a].ElClient < stats[b].ElClient
+	})
+	return stats
+}
+
+func persistClientDiversity(epoch uint64, blockMap map[uint64]*CacheBlock, epochStats *EpochStats, tx *sqlx.Tx) error {
+	if utils.Config.ClientDiversity.Disabled {
+		return nil
+	}
+	stats := buildClientDiversity(epoch, blockMap, epochStats)
+	return db.InsertClientDiversity(epoch, stats, tx)
+}
@@ -0,0 +1,37 @@
+package indexer
+
+import (
+	"testing"
+
+	"github.com/ethpandaops/dora/types"
+	"github.com/ethpandaops/dora/utils"
+)
+
+func TestClassifyProposerClients(t *testing.T) {
+	if utils.Config == nil {
+		utils.Config = &types.Config{}
+	}
+
+	tests := []struct {
+		graffiti string
+		extra    string
+		clClient string
+		elClient string
+	}{
+		{graffiti: "GE1a2bLH3c4d", extra: "", clClient: "Lighthouse", elClient: "Geth"},
+		{graffiti: "NMabcdTK1234", extra: "", clClient: "Teku", elClient: "Nethermind"},
+		{graffiti: "GE1234PM5678", extra: "Nethermind v1.26.0", clClient: "Prysm", elClient: "Geth"},
+		{graffiti: "Lighthouse/v5.2.1", extra: "geth go1.22.5 linux", clClient: "Lighthouse", elClient: "Geth"},
+		{graffiti: "nethermind-lodestar", extra: "", clClient: "Lodestar", elClient: "Nethermind"},
+		{graffiti: "prysm + reth", extra: "", clClient: "Prysm", elClient: "Reth"},
+		{graffiti: "", extra: "reth/v1.0.3/linux", clClient: "", elClient: "Reth"},
+		{graffiti: "hello world", extra: "", clClient: "", elClient: ""},
+		{graffiti: "", extra: "", clClient: "", elClient: ""},
+	}
+	for _, test := range tests {
+		clClient, elClient := ClassifyProposerClients([]byte(test.graffiti), []byte(test.extra))
+		if clClient != test.clClient || elClient != test.elClient {
+			t.Errorf("ClassifyProposerClients(%q, %q) = (%q, %q), expected (%q, %q)", test.graffiti, test.extra, clClient, elClient, test.clClient, test.elClient)
+		}
+	}
+}
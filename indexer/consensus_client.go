@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"fmt"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	v1 "github.com/attestantio/go-eth2-client/api/v1"
@@ -27,6 +28,7 @@ type ConsensusClient struct {
 	indexerCache        *indexerCache
 	cacheMutex          sync.RWMutex
 	lastClientError     error
+	lastClientErrorTime time.Time
 	lastHeadRefresh     time.Time
 	lastStreamEvent     time.Time
 	isSynchronizing     bool
@@ -43,6 +45,7 @@ type ConsensusClient struct {
 	lastJustifiedRoot   []byte
 	lastPeerUpdateEpoch int64
 	peers               []*v1.Peer
+	rewardsUnsupported  atomic.Bool
 }
 
 func newConsensusClient(clientIdx uint16, clientName string, rpcClient *rpc.BeaconClient, indexerCache *indexerCache, archive bool, priority int, skipValidators bool) *ConsensusClient {
@@ -110,6 +113,14 @@ func (client *ConsensusClient) GetLastClientError() string {
 	return client.lastClientError.Error()
 }
 
+func (client *ConsensusClient) GetLastClientErrorTime() time.Time {
+	return client.lastClientErrorTime
+}
+
+func (client *ConsensusClient) GetRetryCounter() uint64 {
+	return client.retryCounter
+}
+
 func (client *ConsensusClient) GetNodePeers() []*v1.Peer {
 	if client.peers == nil {
 		return []*v1.Peer{}
@@ -166,6 +177,7 @@ func (client *ConsensusClient) runConsensusClientLoop() {
 		}
 
 		client.lastClientError = err
+		client.lastClientErrorTime = time.Now()
 		client.retryCounter++
 		waitTime := 10
 		skipLog := false
@@ -455,6 +467,19 @@ func (client *ConsensusClient) prefillCache(finalizedSlot uint64, latestHeader *
 }
 
 func (client *ConsensusClient) ensureBlock(block *CacheBlock, header *phase0.SignedBeaconBlockHeader) error {
+	err := client.ensureBlockData(block, header)
+	if err != nil {
+		return err
+	}
+
+	// load optional block data outside of the block lock, failures are not fatal
+	block.loadExecutionRequests(client, false)
+	block.loadRewards(client, false)
+
+	return nil
+}
+
+func (client *ConsensusClient) ensureBlockData(block *CacheBlock, header *phase0.SignedBeaconBlockHeader) error {
 	// ensure the cached block is loaded (header & block body), load missing parts
 	block.mutex.Lock()
 	defer block.mutex.Unlock()
@@ -481,6 +506,9 @@ func (client *ConsensusClient) ensureBlock(block *CacheBlock, header *phase0.Sig
 		}
 		block.block = blockRsp
 		block.parseBlockRefs()
+
+		// the priority fees need the execution receipts, load them in background
+		go client.indexerCache.indexer.loadBlockPriorityFees(block)
 	}
 
 	// set seen flag
@@ -590,6 +618,7 @@ func (client *ConsensusClient) setHeadBlock(root []byte, slot uint64) error {
 	client.lastHeadRoot = root
 	client.cacheMutex.Unlock()
 
+	client.indexerCache.indexer.checkHeadUpdate()
 	return nil
 }
 
@@ -612,6 +641,13 @@ func (client *ConsensusClient) processBlockEvent(evt *v1.BlockEvent) error {
 	if err != nil {
 		return err
 	}
+	if isNewBlock {
+		client.indexerCache.indexer.eventDispatcher.fire(&IndexerEvent{
+			Type:  BlockEventType,
+			Block: currentBlock,
+		})
+	}
+
 	client.setHeadBlock(evt.Block[:], uint64(evt.Slot))
 	return nil
 }
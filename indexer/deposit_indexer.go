@@ -8,8 +8,10 @@ import (
 	"log"
 	"math/big"
 	"strings"
+	"sync"
 	"time"
 
+	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
@@ -27,6 +29,7 @@ import (
 
 type DepositIndexer struct {
 	indexer             *Indexer
+	stateMutex          sync.Mutex
 	state               *dbtypes.DepositIndexerState
 	batchSize           int
 	depositContract     common.Address
@@ -34,6 +37,13 @@ type DepositIndexer struct {
 	depositEventTopic   []byte
 	depositSigDomain    zrnt_common.BLSDomain
 	unfinalizedDeposits map[uint64]map[common.Hash]bool
+
+	depositTreeMutex  sync.RWMutex
+	depositTree       *depositTree
+	treeCheckpoints   map[uint64]*depositTreeCheckpoint
+	treeStatus        DepositTreeStatus
+	finalizedEth1Data *phase0.ETH1Data
+	snapshotCache     *DepositTreeSnapshot
 }
 
 const depositContractAbi = `[{"inputs":[],"stateMutability":"nonpayable","type":"constructor"},{"anonymous":false,"inputs":[{"indexed":false,"internalType":"bytes","name":"pubkey","type":"bytes"},{"indexed":false,"internalType":"bytes","name":"withdrawal_credentials","type":"bytes"},{"indexed":false,"internalType":"bytes","name":"amount","type":"bytes"},{"indexed":false,"internalType":"bytes","name":"signature","type":"bytes"},{"indexed":false,"internalType":"bytes","name":"index","type":"bytes"}],"name":"DepositEvent","type":"event"},{"inputs":[{"internalType":"bytes","name":"pubkey","type":"bytes"},{"internalType":"bytes","name":"withdrawal_credentials","type":"bytes"},{"internalType":"bytes","name":"signature","type":"bytes"},{"internalType":"bytes32","name":"deposit_data_root","type":"bytes32"}],"name":"deposit","outputs":[],"stateMutability":"payable","type":"function"},{"inputs":[],"name":"get_deposit_count","outputs":[{"internalType":"bytes","name":"","type":"bytes"}],"stateMutability":"view","type":"function"},{"inputs":[],"name":"get_deposit_root","outputs":[{"internalType":"bytes32","name":"","type":"bytes32"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"bytes4","name":"interfaceId","type":"bytes4"}],"name":"supportsInterface","outputs":[{"internalType":"bool","name":"","type":"bool"}],"stateMutability":"pure","type":"function"}]`
@@ -62,6 +72,7 @@ func newDepositIndexer(indexer *Indexer) *DepositIndexer {
 		depositEventTopic:   depositEventTopic[:],
 		depositSigDomain:    depositSigDomain,
 		unfinalizedDeposits: map[uint64]map[common.Hash]bool{},
+		treeCheckpoints:     map[uint64]*depositTreeCheckpoint{},
 	}
 
 	go ds.runDepositIndexerLoop()
@@ -88,6 +99,13 @@ func (ds *DepositIndexer) runDepositIndexer() error {
 	if ds.state == nil {
 		ds.loadState()
 	}
+	if ds.depositTree == nil {
+		err := ds.loadDepositTree()
+		if err != nil {
+			ds.setState(nil)
+			return err
+		}
+	}
 
 	finalizedEpoch, finalizedRoot, _, _ := ds.indexer.GetFinalizationCheckpoints()
 	if finalizedEpoch < 0 {
@@ -116,8 +134,19 @@ func (ds *DepositIndexer) runDepositIndexer() error {
 	if finalizedBlockNumber > ds.state.FinalBlock {
 		err := ds.processFinalizedBlocks(finalizedBlockNumber)
 		if err != nil {
+			// reload state & deposit tree from db, as they might be ahead of the persisted deposits
+			ds.setState(nil)
+			ds.depositTreeMutex.Lock()
+			ds.depositTree = nil
+			ds.depositTreeMutex.Unlock()
 			return err
 		}
+		ds.pruneDepositTree(finalizedBlockNumber)
+
+		err = ds.verifyContractDepositRoot(finalizedBlockNumber)
+		if err != nil {
+			logger.Warnf("could not verify deposit tree against deposit contract: %v", err)
+		}
 	}
 
 	ds.processRecentBlocks()
@@ -128,7 +157,25 @@ func (ds *DepositIndexer) runDepositIndexer() error {
 func (ds *DepositIndexer) loadState() {
 	syncState := dbtypes.DepositIndexerState{}
 	db.GetExplorerState("indexer.depositstate", &syncState)
-	ds.state = &syncState
+	ds.setState(&syncState)
+}
+
+// setState replaces the indexer state, the state is only modified from the indexer loop.
+func (ds *DepositIndexer) setState(state *dbtypes.DepositIndexerState) {
+	ds.stateMutex.Lock()
+	defer ds.stateMutex.Unlock()
+	ds.state = state
+}
+
+// getState returns a copy of the indexer state, safe to call from any goroutine.
+func (ds *DepositIndexer) getState() *dbtypes.DepositIndexerState {
+	ds.stateMutex.Lock()
+	defer ds.stateMutex.Unlock()
+	if ds.state == nil {
+		return nil
+	}
+	state := *ds.state
+	return &state
 }
 
 func (ds *DepositIndexer) processFinalizedBlocks(finalizedBlockNumber uint64) error {
@@ -219,6 +266,11 @@ func (ds *DepositIndexer) processFinalizedBlocks(finalizedBlockNumber uint64) er
 		if len(depositTxs) > 0 {
 			logger.Infof("crawled deposits for block %v - %v: %v deposits", ds.state.FinalBlock, toBlock, len(depositTxs))
 
+			err = ds.appendDepositTree(depositTxs)
+			if err != nil {
+				return fmt.Errorf("could not append deposits to deposit tree: %v", err)
+			}
+
 			depositCount := len(depositTxs)
 			for depositIdx := 0; depositIdx < depositCount; depositIdx += 500 {
 				endIdx := depositIdx + 500
@@ -398,10 +450,13 @@ func (ds *DepositIndexer) persistFinalizedDepositTxs(toBlockNumber uint64, depos
 			}
 		}
 
+		ds.stateMutex.Lock()
 		ds.state.FinalBlock = toBlockNumber
 		if toBlockNumber > ds.state.HeadBlock {
 			ds.state.HeadBlock = toBlockNumber
 		}
+		ds.depositTree.applyToState(ds.state)
+		ds.stateMutex.Unlock()
 
 		err := db.SetExplorerState("indexer.depositstate", ds.state, tx)
 		if err != nil {
@@ -0,0 +1,354 @@
+package indexer
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"math/big"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/ethpandaops/dora/db"
+	"github.com/ethpandaops/dora/dbtypes"
+	"github.com/ethpandaops/dora/utils"
+)
+
+type depositTreeCheckpoint struct {
+	tree        *depositTree
+	blockNumber uint64
+}
+
+// DepositTreeStatus holds the state of the deposit tree and the results of the latest verifications
+// against the deposit contract (get_deposit_root) and the eth1 data of the finalized beacon state.
+type DepositTreeStatus struct {
+	DepositCount    uint64
+	DepositRoot     []byte
+	FinalBlock      uint64
+	ContractChecked bool
+	ContractBlock   uint64
+	ContractCount   uint64
+	ContractRoot    []byte
+	ContractMatch   bool
+	Eth1DataChecked bool
+	Eth1DataCount   uint64
+	Eth1DataRoot    []byte
+	Eth1DataMatch   bool
+}
+
+// DepositTreeSnapshot is a EIP-4881 deposit tree snapshot
+type DepositTreeSnapshot struct {
+	Finalized            [][]byte
+	DepositRoot          []byte
+	DepositCount         uint64
+	ExecutionBlockHash   []byte
+	ExecutionBlockHeight uint64
+}
+
+// loadDepositTree restores the deposit tree from the indexer state or rebuilds it from the deposits in the db
+func (ds *DepositIndexer) loadDepositTree() error {
+	depositTree := newDepositTreeFromState(ds.state)
+	if depositTree == nil || (ds.state.TreeBranch == nil && ds.state.FinalBlock > 0) {
+		logger.Infof("rebuilding deposit tree from db")
+		var err error
+		depositTree, err = ds.buildDepositTreeFromDb(math.MaxUint64, true)
+		if err != nil {
+			return fmt.Errorf("error rebuilding deposit tree: %v", err)
+		}
+
+		ds.stateMutex.Lock()
+		depositTree.applyToState(ds.state)
+		ds.stateMutex.Unlock()
+		err = db.RunDBTransaction(func(tx *sqlx.Tx) error {
+			return db.SetExplorerState("indexer.depositstate", ds.state, tx)
+		})
+		if err != nil {
+			return fmt.Errorf("error while updating deposit state: %v", err)
+		}
+		logger.Infof("rebuilt deposit tree from db: %v deposits", depositTree.count)
+	}
+
+	ds.depositTreeMutex.Lock()
+	defer ds.depositTreeMutex.Unlock()
+	ds.depositTree = depositTree
+	ds.updateTreeStatus()
+	return nil
+}
+
+// buildDepositTreeFromDb builds the deposit tree from the canonical deposits in the db (up to maxCount deposits).
+// With backfill set, the deposit roots of the deposits are stored to the db if missing.
+func (ds *DepositIndexer) buildDepositTreeFromDb(maxCount uint64, backfill bool) (*depositTree, error) {
+	depositTree := newDepositTree()
+	for depositTree.count < maxCount {
+		depositTxs, err := db.GetCanonicalDepositTxs(depositTree.count, 10000)
+		if err != nil {
+			return nil, err
+		}
+		if len(depositTxs) == 0 {
+			break
+		}
+
+		backfillTxs := []*dbtypes.DepositTx{}
+		for _, depositTx := range depositTxs {
+			if depositTree.count >= maxCount {
+				break
+			}
+			if depositTx.Index != depositTree.count {
+				return nil, fmt.Errorf("deposit %v missing in db", depositTree.count)
+			}
+			depositTree.push(getDepositDataRoot(depositTx))
+			if backfill && depositTx.DepositRoot == nil {
+				depositRoot := depositTree.getRoot()
+				depositTx.DepositRoot = depositRoot[:]
+				backfillTxs = append(backfillTxs, depositTx)
+			}
+		}
+
+		for depositIdx := 0; depositIdx < len(backfillTxs); depositIdx += 500 {
+			endIdx := depositIdx + 500
+			if endIdx > len(backfillTxs) {
+				endIdx = len(backfillTxs)
+			}
+			err = ds.persistRecentDepositTxs(backfillTxs[depositIdx:endIdx])
+			if err != nil {
+				return nil, fmt.Errorf("could not persist deposit roots: %v", err)
+			}
+		}
+	}
+	if maxCount != math.MaxUint64 && depositTree.count < maxCount {
+		return nil, fmt.Errorf("deposit tree not synchronized up to deposit %v", maxCount)
+	}
+	return depositTree, nil
+}
+
+// appendDepositTree appends the finalized deposits to the deposit tree, sets their deposit roots and keeps a checkpoint per block
+func (ds *DepositIndexer) appendDepositTree(depositTxs []*dbtypes.DepositTx) error {
+	ds.depositTreeMutex.Lock()
+	defer ds.depositTreeMutex.Unlock()
+
+	for idx, depositTx := range depositTxs {
+		if depositTx.Index != ds.depositTree.count {
+			return fmt.Errorf("unexpected deposit index %v (expected %v)", depositTx.Index, ds.depositTree.count)
+		}
+		ds.depositTree.push(getDepositDataRoot(depositTx))
+		depositRoot := ds.depositTree.getRoot()
+		depositTx.DepositRoot = depositRoot[:]
+
+		if idx == len(depositTxs)-1 || depositTxs[idx+1].BlockNumber != depositTx.BlockNumber {
+			ds.treeCheckpoints[ds.depositTree.count] = &depositTreeCheckpoint{
+				tree:        ds.depositTree.clone(),
+				blockNumber: depositTx.BlockNumber,
+			}
+		}
+	}
+	return nil
+}
+
+// pruneDepositTree drops checkpoints that are too old to become the finalized eth1 data of the beacon chain
+func (ds *DepositIndexer) pruneDepositTree(finalBlock uint64) {
+	ds.depositTreeMutex.Lock()
+	defer ds.depositTreeMutex.Unlock()
+
+	// keep checkpoints within the eth1 follow distance plus two eth1 voting periods (converted from slots to eth1 blocks)
+	chainConfig := utils.Config.Chain.Config
+	secondsPerEth1Block := chainConfig.SecondsPerEth1Block
+	if secondsPerEth1Block == 0 {
+		secondsPerEth1Block = 14
+	}
+	votingPeriodBlocks := chainConfig.EpochsPerEth1VotingPeriod * chainConfig.SlotsPerEpoch * chainConfig.SecondsPerSlot / secondsPerEth1Block
+	window := chainConfig.Eth1FollowDistance + 2*votingPeriodBlocks
+	for count, checkpoint := range ds.treeCheckpoints {
+		if checkpoint.blockNumber+window < finalBlock || (ds.finalizedEth1Data != nil && count < ds.finalizedEth1Data.DepositCount) {
+			delete(ds.treeCheckpoints, count)
+		}
+	}
+	ds.updateTreeStatus()
+}
+
+func (ds *DepositIndexer) updateTreeStatus() {
+	depositRoot := ds.depositTree.getRoot()
+	ds.treeStatus.DepositCount = ds.depositTree.count
+	ds.treeStatus.DepositRoot = depositRoot[:]
+	ds.treeStatus.FinalBlock = ds.state.FinalBlock
+}
+
+// verifyContractDepositRoot compares the deposit tree with get_deposit_count & get_deposit_root of the deposit contract at the given block
+func (ds *DepositIndexer) verifyContractDepositRoot(blockNumber uint64) error {
+	client := ds.indexer.GetReadyElClient(false, nil, nil)
+	if client == nil {
+		return fmt.Errorf("no ready execution client found")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	callContract := func(method string) ([]interface{}, error) {
+		callData, err := ds.depositContractAbi.Pack(method)
+		if err != nil {
+			return nil, err
+		}
+		result, err := client.GetRpcClient().GetEthClient().CallContract(ctx, ethereum.CallMsg{
+			To:   &ds.depositContract,
+			Data: callData,
+		}, big.NewInt(0).SetUint64(blockNumber))
+		if err != nil {
+			return nil, err
+		}
+		return ds.depositContractAbi.Unpack(method, result)
+	}
+
+	countRes, err := callContract("get_deposit_count")
+	if err != nil {
+		return fmt.Errorf("error calling get_deposit_count: %v", err)
+	}
+	rootRes, err := callContract("get_deposit_root")
+	if err != nil {
+		return fmt.Errorf("error calling get_deposit_root: %v", err)
+	}
+	contractCount := binary.LittleEndian.Uint64(countRes[0].([]byte))
+	contractRoot := rootRes[0].([32]byte)
+
+	ds.depositTreeMutex.Lock()
+	defer ds.depositTreeMutex.Unlock()
+
+	treeRoot := ds.depositTree.getRoot()
+	ds.treeStatus.ContractChecked = true
+	ds.treeStatus.ContractBlock = blockNumber
+	ds.treeStatus.ContractCount = contractCount
+	ds.treeStatus.ContractRoot = contractRoot[:]
+	ds.treeStatus.ContractMatch = contractCount == ds.depositTree.count && contractRoot == treeRoot
+	if !ds.treeStatus.ContractMatch {
+		logger.Errorf("deposit tree mismatch at block %v: contract has %v deposits (root 0x%x), tree has %v deposits (root 0x%x)", blockNumber, contractCount, contractRoot, ds.depositTree.count, treeRoot)
+	}
+	return nil
+}
+
+// setFinalizedEth1Data updates the eth1 data of the latest finalized beacon state and verifies its deposit root against the deposit tree
+func (ds *DepositIndexer) setFinalizedEth1Data(eth1Data *phase0.ETH1Data) {
+	if eth1Data == nil {
+		return
+	}
+
+	ds.depositTreeMutex.Lock()
+	defer ds.depositTreeMutex.Unlock()
+	if ds.finalizedEth1Data != nil && ds.finalizedEth1Data.DepositCount > eth1Data.DepositCount {
+		return
+	}
+	ds.finalizedEth1Data = eth1Data
+
+	depositRoot := ds.getDepositRoot(eth1Data.DepositCount)
+	ds.treeStatus.Eth1DataCount = eth1Data.DepositCount
+	ds.treeStatus.Eth1DataRoot = eth1Data.DepositRoot[:]
+	ds.treeStatus.Eth1DataChecked = depositRoot != nil
+	ds.treeStatus.Eth1DataMatch = bytes.Equal(depositRoot, eth1Data.DepositRoot[:])
+	if ds.treeStatus.Eth1DataChecked && !ds.treeStatus.Eth1DataMatch {
+		logger.Errorf("deposit tree mismatch in finalized eth1 data: deposit root 0x%x for %v deposits, tree root 0x%x", eth1Data.DepositRoot, eth1Data.DepositCount, depositRoot)
+	}
+}
+
+// getDepositRoot returns the deposit root after the given number of deposits (nil if unknown), expects depositTreeMutex to be held
+func (ds *DepositIndexer) getDepositRoot(depositCount uint64) []byte {
+	var depositRoot [32]byte
+	if checkpoint := ds.treeCheckpoints[depositCount]; checkpoint != nil {
+		depositRoot = checkpoint.tree.getRoot()
+	} else if depositCount == 0 {
+		depositRoot = newDepositTree().getRoot()
+	} else if ds.depositTree != nil && ds.depositTree.count == depositCount {
+		depositRoot = ds.depositTree.getRoot()
+	} else {
+		return db.GetDepositTreeRoot(depositCount)
+	}
+	return depositRoot[:]
+}
+
+func (ds *DepositIndexer) getDepositTreeStatus() *DepositTreeStatus {
+	ds.depositTreeMutex.RLock()
+	defer ds.depositTreeMutex.RUnlock()
+	if ds.depositTree == nil {
+		return nil
+	}
+	status := ds.treeStatus
+	return &status
+}
+
+// getDepositSnapshot returns the EIP-4881 snapshot of the deposit tree at the eth1 data of the latest finalized beacon state.
+// The tree rebuild & execution block lookup are done without holding the deposit tree lock, the lock is only taken to read & store the results.
+func (ds *DepositIndexer) getDepositSnapshot() (*DepositTreeSnapshot, error) {
+	ds.depositTreeMutex.RLock()
+	eth1Data := ds.finalizedEth1Data
+	if eth1Data == nil || ds.depositTree == nil {
+		ds.depositTreeMutex.RUnlock()
+		return nil, fmt.Errorf("deposit tree not ready")
+	}
+	if ds.snapshotCache != nil && ds.snapshotCache.DepositCount == eth1Data.DepositCount && bytes.Equal(ds.snapshotCache.ExecutionBlockHash, eth1Data.BlockHash) {
+		snapshot := ds.snapshotCache
+		ds.depositTreeMutex.RUnlock()
+		return snapshot, nil
+	}
+
+	var snapshotTree *depositTree
+	if checkpoint := ds.treeCheckpoints[eth1Data.DepositCount]; checkpoint != nil {
+		snapshotTree = checkpoint.tree
+	} else if ds.depositTree.count == eth1Data.DepositCount {
+		snapshotTree = ds.depositTree.clone()
+	}
+	treeCount := ds.depositTree.count
+	ds.depositTreeMutex.RUnlock()
+
+	rebuiltTree := false
+	if snapshotTree == nil {
+		if treeCount < eth1Data.DepositCount {
+			return nil, fmt.Errorf("deposit tree not synchronized up to deposit %v", eth1Data.DepositCount)
+		}
+
+		var err error
+		snapshotTree, err = ds.buildDepositTreeFromDb(eth1Data.DepositCount, false)
+		if err != nil {
+			return nil, err
+		}
+		rebuiltTree = true
+	}
+
+	depositRoot := snapshotTree.getRoot()
+	if !bytes.Equal(depositRoot[:], eth1Data.DepositRoot[:]) {
+		return nil, fmt.Errorf("deposit tree root 0x%x does not match finalized eth1 data deposit root 0x%x", depositRoot, eth1Data.DepositRoot)
+	}
+
+	client := ds.indexer.GetReadyElClient(false, nil, nil)
+	if client == nil {
+		return nil, fmt.Errorf("no ready execution client found")
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	header, err := client.GetRpcClient().GetEthClient().HeaderByHash(ctx, common.BytesToHash(eth1Data.BlockHash))
+	if err != nil {
+		return nil, fmt.Errorf("could not load eth1 data block 0x%x: %v", eth1Data.BlockHash, err)
+	}
+
+	snapshot := &DepositTreeSnapshot{
+		Finalized:            [][]byte{},
+		DepositRoot:          depositRoot[:],
+		DepositCount:         eth1Data.DepositCount,
+		ExecutionBlockHash:   eth1Data.BlockHash,
+		ExecutionBlockHeight: header.Number.Uint64(),
+	}
+	for _, root := range snapshotTree.getFinalized() {
+		snapshot.Finalized = append(snapshot.Finalized, append([]byte{}, root[:]...))
+	}
+
+	ds.depositTreeMutex.Lock()
+	defer ds.depositTreeMutex.Unlock()
+	if rebuiltTree && ds.treeCheckpoints[eth1Data.DepositCount] == nil {
+		ds.treeCheckpoints[eth1Data.DepositCount] = &depositTreeCheckpoint{
+			tree:        snapshotTree,
+			blockNumber: snapshot.ExecutionBlockHeight,
+		}
+	}
+	ds.snapshotCache = snapshot
+	return snapshot, nil
+}
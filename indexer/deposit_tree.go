@@ -0,0 +1,123 @@
+package indexer
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+
+	zrnt_common "github.com/protolambda/zrnt/eth2/beacon/common"
+	"github.com/protolambda/ztyp/tree"
+
+	"github.com/ethpandaops/dora/dbtypes"
+)
+
+const depositTreeDepth = 32
+
+var depositTreeZeroHashes [depositTreeDepth + 1][32]byte
+
+func init() {
+	for h := 0; h < depositTreeDepth; h++ {
+		depositTreeZeroHashes[h+1] = depositTreeHash(depositTreeZeroHashes[h], depositTreeZeroHashes[h])
+	}
+}
+
+func depositTreeHash(left [32]byte, right [32]byte) [32]byte {
+	hasher := sha256.New()
+	hasher.Write(left[:])
+	hasher.Write(right[:])
+	var hash [32]byte
+	copy(hash[:], hasher.Sum(nil))
+	return hash
+}
+
+// depositTree is the incremental merkle tree of the deposit contract (same algorithm as the contract itself).
+// branch[h] holds the root of the latest complete subtree with 2^h leaves, which is all that's needed to append leaves and compute the root.
+type depositTree struct {
+	branch [depositTreeDepth][32]byte
+	count  uint64
+}
+
+func newDepositTree() *depositTree {
+	return &depositTree{}
+}
+
+// newDepositTreeFromState restores a deposit tree from the persisted deposit indexer state
+func newDepositTreeFromState(state *dbtypes.DepositIndexerState) *depositTree {
+	if state.TreeCount > 0 && len(state.TreeBranch) != depositTreeDepth {
+		return nil
+	}
+	depositTree := &depositTree{
+		count: state.TreeCount,
+	}
+	for h := range state.TreeBranch {
+		copy(depositTree.branch[h][:], state.TreeBranch[h])
+	}
+	return depositTree
+}
+
+func (t *depositTree) clone() *depositTree {
+	return &depositTree{
+		branch: t.branch,
+		count:  t.count,
+	}
+}
+
+func (t *depositTree) push(leaf [32]byte) {
+	t.count++
+	size := t.count
+	node := leaf
+	for h := 0; h < depositTreeDepth; h++ {
+		if size&1 == 1 {
+			t.branch[h] = node
+			return
+		}
+		node = depositTreeHash(t.branch[h], node)
+		size >>= 1
+	}
+}
+
+// getRoot returns the deposit root (tree root mixed in with the deposit count) like get_deposit_root of the contract
+func (t *depositTree) getRoot() [32]byte {
+	node := [32]byte{}
+	size := t.count
+	for h := 0; h < depositTreeDepth; h++ {
+		if size&1 == 1 {
+			node = depositTreeHash(t.branch[h], node)
+		} else {
+			node = depositTreeHash(node, depositTreeZeroHashes[h])
+		}
+		size >>= 1
+	}
+	var countBytes [32]byte
+	binary.LittleEndian.PutUint64(countBytes[:], t.count)
+	return depositTreeHash(node, countBytes)
+}
+
+// getFinalized returns the roots of the complete subtrees covering all leaves (largest subtree first) as used by EIP-4881 snapshots
+func (t *depositTree) getFinalized() [][32]byte {
+	finalized := [][32]byte{}
+	for h := depositTreeDepth - 1; h >= 0; h-- {
+		if (t.count>>h)&1 == 1 {
+			finalized = append(finalized, t.branch[h])
+		}
+	}
+	return finalized
+}
+
+func (t *depositTree) applyToState(state *dbtypes.DepositIndexerState) {
+	state.TreeCount = t.count
+	state.TreeBranch = make([][]byte, depositTreeDepth)
+	for h := range t.branch {
+		state.TreeBranch[h] = append([]byte{}, t.branch[h][:]...)
+	}
+}
+
+// getDepositDataRoot returns the hash tree root of the deposit data, which is the leaf of the deposit tree
+func getDepositDataRoot(depositTx *dbtypes.DepositTx) [32]byte {
+	depositData := &zrnt_common.DepositData{
+		Pubkey:                zrnt_common.BLSPubkey(depositTx.PublicKey),
+		WithdrawalCredentials: tree.Root(depositTx.WithdrawalCredentials),
+		Amount:                zrnt_common.Gwei(depositTx.Amount),
+		Signature:             zrnt_common.BLSSignature(depositTx.Signature),
+	}
+	return depositData.HashTreeRoot(tree.GetHashFn())
+}
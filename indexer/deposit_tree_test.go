@@ -0,0 +1,182 @@
+package indexer
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"testing"
+
+	"github.com/protolambda/zrnt/eth2/beacon/phase0"
+	"github.com/protolambda/ztyp/tree"
+	"github.com/protolambda/ztyp/view"
+
+	"github.com/ethpandaops/dora/dbtypes"
+)
+
+// testDepositLeaf returns a deterministic deposit tree leaf for the given deposit index
+func testDepositLeaf(index uint64) [32]byte {
+	var indexBytes [8]byte
+	binary.LittleEndian.PutUint64(indexBytes[:], index)
+	return sha256.Sum256(indexBytes[:])
+}
+
+// testDepositRoot computes the deposit root as the ssz root of List[Root, 2**32] (as done by the spec genesis logic)
+func testDepositRoot(t *testing.T, count uint64) [32]byte {
+	rootsView := phase0.NewDepositRootsView()
+	for i := uint64(0); i < count; i++ {
+		leaf := view.RootView(testDepositLeaf(i))
+		if err := rootsView.Append(&leaf); err != nil {
+			t.Fatalf("could not append reference leaf %v: %v", i, err)
+		}
+	}
+	return rootsView.HashTreeRoot(tree.GetHashFn())
+}
+
+// newDepositTreeFromSnapshot restores a deposit tree from a EIP-4881 snapshot like a snapshot consumer does
+func newDepositTreeFromSnapshot(snapshot *DepositTreeSnapshot) (*depositTree, error) {
+	depositTree := &depositTree{
+		count: snapshot.DepositCount,
+	}
+	finalizedIdx := 0
+	for h := depositTreeDepth - 1; h >= 0; h-- {
+		if (snapshot.DepositCount>>h)&1 == 0 {
+			continue
+		}
+		if finalizedIdx >= len(snapshot.Finalized) {
+			return nil, fmt.Errorf("snapshot has too few finalized roots for %v deposits", snapshot.DepositCount)
+		}
+		copy(depositTree.branch[h][:], snapshot.Finalized[finalizedIdx])
+		finalizedIdx++
+	}
+	if finalizedIdx != len(snapshot.Finalized) {
+		return nil, fmt.Errorf("snapshot has too many finalized roots for %v deposits", snapshot.DepositCount)
+	}
+
+	depositRoot := depositTree.getRoot()
+	if !bytes.Equal(depositRoot[:], snapshot.DepositRoot) {
+		return nil, fmt.Errorf("restored deposit root 0x%x does not match snapshot deposit root 0x%x", depositRoot, snapshot.DepositRoot)
+	}
+	return depositTree, nil
+}
+
+func TestDepositTreeEmptyRoot(t *testing.T) {
+	// get_deposit_root of a freshly deployed deposit contract
+	expected, _ := hex.DecodeString("d70a234731285c6804c2a4f56711ddb8c82c99740f207854891028af34e27e5e")
+	root := newDepositTree().getRoot()
+	if !bytes.Equal(root[:], expected) {
+		t.Fatalf("unexpected empty deposit root: got 0x%x, expected 0x%x", root, expected)
+	}
+}
+
+func TestDepositTreeRoot(t *testing.T) {
+	tests := []uint64{1, 2, 3, 4, 5, 7, 8, 9, 16, 31, 32, 33, 100, 257}
+	for _, count := range tests {
+		depositTree := newDepositTree()
+		for i := uint64(0); i < count; i++ {
+			depositTree.push(testDepositLeaf(i))
+		}
+
+		root := depositTree.getRoot()
+		expected := testDepositRoot(t, count)
+		if root != expected {
+			t.Errorf("count %v: unexpected deposit root: got 0x%x, expected 0x%x", count, root, expected)
+		}
+	}
+}
+
+func TestDepositTreeState(t *testing.T) {
+	depositTree := newDepositTree()
+	for i := uint64(0); i < 21; i++ {
+		depositTree.push(testDepositLeaf(i))
+	}
+
+	state := &dbtypes.DepositIndexerState{}
+	depositTree.applyToState(state)
+	restored := newDepositTreeFromState(state)
+	if restored == nil {
+		t.Fatalf("could not restore deposit tree from state")
+	}
+	if restored.getRoot() != depositTree.getRoot() {
+		t.Fatalf("restored deposit tree root mismatch")
+	}
+
+	if newDepositTreeFromState(&dbtypes.DepositIndexerState{TreeCount: 1}) != nil {
+		t.Fatalf("expected nil tree for state without tree branch")
+	}
+}
+
+func TestDepositTreeSnapshot(t *testing.T) {
+	tests := []struct {
+		finalized uint64
+		total     uint64
+	}{
+		{finalized: 0, total: 5},
+		{finalized: 1, total: 1},
+		{finalized: 1, total: 10},
+		{finalized: 6, total: 17},
+		{finalized: 16, total: 32},
+		{finalized: 31, total: 64},
+		{finalized: 100, total: 130},
+	}
+
+	for _, test := range tests {
+		depositTree := newDepositTree()
+		for i := uint64(0); i < test.finalized; i++ {
+			depositTree.push(testDepositLeaf(i))
+		}
+
+		// finalize & build the snapshot like getDepositSnapshot does
+		depositRoot := depositTree.getRoot()
+		snapshot := &DepositTreeSnapshot{
+			Finalized:    [][]byte{},
+			DepositRoot:  depositRoot[:],
+			DepositCount: test.finalized,
+		}
+		for _, root := range depositTree.getFinalized() {
+			snapshot.Finalized = append(snapshot.Finalized, append([]byte{}, root[:]...))
+		}
+
+		// recover from the snapshot and continue with the remaining deposits
+		restored, err := newDepositTreeFromSnapshot(snapshot)
+		if err != nil {
+			t.Fatalf("finalized %v: could not restore deposit tree from snapshot: %v", test.finalized, err)
+		}
+		for i := test.finalized; i < test.total; i++ {
+			restored.push(testDepositLeaf(i))
+		}
+
+		root := restored.getRoot()
+		expected := testDepositRoot(t, test.total)
+		if root != expected {
+			t.Errorf("finalized %v, total %v: unexpected deposit root after snapshot recovery: got 0x%x, expected 0x%x", test.finalized, test.total, root, expected)
+		}
+	}
+}
+
+func TestDepositTreeSnapshotInvalid(t *testing.T) {
+	depositTree := newDepositTree()
+	for i := uint64(0); i < 6; i++ {
+		depositTree.push(testDepositLeaf(i))
+	}
+	depositRoot := depositTree.getRoot()
+	finalized := [][]byte{}
+	for _, root := range depositTree.getFinalized() {
+		finalized = append(finalized, append([]byte{}, root[:]...))
+	}
+
+	tests := []struct {
+		name     string
+		snapshot *DepositTreeSnapshot
+	}{
+		{name: "too few roots", snapshot: &DepositTreeSnapshot{Finalized: finalized[:1], DepositRoot: depositRoot[:], DepositCount: 6}},
+		{name: "too many roots", snapshot: &DepositTreeSnapshot{Finalized: finalized, DepositRoot: depositRoot[:], DepositCount: 4}},
+		{name: "root mismatch", snapshot: &DepositTreeSnapshot{Finalized: finalized, DepositRoot: make([]byte, 32), DepositCount: 6}},
+	}
+	for _, test := range tests {
+		if _, err := newDepositTreeFromSnapshot(test.snapshot); err == nil {
+			t.Errorf("%v: expected error for invalid snapshot", test.name)
+		}
+	}
+}
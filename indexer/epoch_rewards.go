@@ -0,0 +1,140 @@
+package indexer
+
+import (
+	"encoding/binary"
+	"math"
+	"time"
+
+	"github.com/ethpandaops/dora/db"
+	"github.com/ethpandaops/dora/dbtypes"
+	"github.com/ethpandaops/dora/utils"
+	"github.com/jmoiron/sqlx"
+)
+
+// epochRewardsMaxDuration limits the time spent on loading the rewards of an epoch, as it delays the finalization processing
+const epochRewardsMaxDuration = 60 * time.Second
+
+// loadEpochRewards loads the attestation rewards of an epoch and the sync committee rewards of its canonical blocks from the beacon rewards api.
+// Returns nil if the rewards history is disabled or any of the rewards could not be loaded in time, the epoch is skipped in that case.
+func loadEpochRewards(client *ConsensusClient, epoch uint64, blockMap map[uint64]*CacheBlock, epochStats *EpochStats) *dbtypes.EpochRewards {
+	if !utils.Config.Indexer.EnableRewardsHistory || client == nil {
+		return nil
+	}
+	t1 := time.Now()
+
+	attestationRewards, err := client.rpcClient.GetAttestationRewards(epoch)
+	if err != nil || attestationRewards == nil {
+		logger.WithField("client", client.clientName).Warnf("could not load attestation rewards for epoch %v: %v", epoch, err)
+		return nil
+	}
+
+	// sum up the sync committee rewards of all canonical blocks in the epoch
+	syncRewards := map[uint64]int64{}
+	firstSlot := epoch * utils.Config.Chain.Config.SlotsPerEpoch
+	lastSlot := firstSlot + utils.Config.Chain.Config.SlotsPerEpoch - 1
+	if epoch >= utils.Config.Chain.Config.AltairForkEpoch {
+		for slot := firstSlot; slot <= lastSlot; slot++ {
+			block := blockMap[slot]
+			if block == nil {
+				continue
+			}
+			if time.Since(t1) > epochRewardsMaxDuration {
+				logger.WithField("client", client.clientName).Warnf("loading rewards for epoch %v took too long, skipping epoch rewards", epoch)
+				return nil
+			}
+			blockRewards, err := client.rpcClient.GetSyncCommitteeRewardsByBlockroot(block.Root)
+			if err != nil {
+				logger.WithField("client", client.clientName).Warnf("could not load sync committee rewards for block %v [0x%x], skipping epoch rewards: %v", slot, block.Root, err)
+				return nil
+			}
+			for _, reward := range blockRewards {
+				syncRewards[reward.ValidatorIndex] += reward.Reward
+			}
+		}
+	}
+
+	// ideal attestation rewards by effective balance
+	idealRewards := map[uint64]uint64{}
+	for _, idealReward := range attestationRewards.IdealRewards {
+		idealRewards[idealReward.EffectiveBalance] = positiveReward(idealReward.Head) + positiveReward(idealReward.Target) + positiveReward(idealReward.Source) + positiveReward(idealReward.InclusionDelay)
+	}
+	var effectiveBalances map[uint64]uint64
+	if epochStats != nil {
+		epochStats.stateStatsMutex.RLock()
+		defer epochStats.stateStatsMutex.RUnlock()
+		if epochStats.stateStats != nil {
+			effectiveBalances = epochStats.stateStats.ValidatorBalances
+		}
+	}
+
+	maxIndex := uint64(0)
+	for _, totalReward := range attestationRewards.TotalRewards {
+		if totalReward.ValidatorIndex > maxIndex {
+			maxIndex = totalReward.ValidatorIndex
+		}
+	}
+	for validatorIdx := range syncRewards {
+		if validatorIdx > maxIndex {
+			maxIndex = validatorIdx
+		}
+	}
+
+	epochRewards := &dbtypes.EpochRewards{
+		Epoch:            epoch,
+		ValidatorCount:   uint64(len(attestationRewards.TotalRewards)),
+		ValidatorRewards: make([]byte, (maxIndex+1)*8),
+	}
+	for _, totalReward := range attestationRewards.TotalRewards {
+		for _, reward := range []int64{totalReward.Head, totalReward.Target, totalReward.Source, totalReward.InclusionDelay} {
+			if reward > 0 {
+				epochRewards.AttestationRewards += uint64(reward)
+			} else {
+				epochRewards.AttestationPenalties += uint64(-reward)
+			}
+		}
+		if totalReward.Inactivity < 0 {
+			epochRewards.InactivityPenalties += uint64(-totalReward.Inactivity)
+		}
+		if effectiveBalance, found := effectiveBalances[totalReward.ValidatorIndex]; found {
+			epochRewards.IdealRewards += idealRewards[effectiveBalance]
+		}
+
+		reward := totalReward.Head + totalReward.Target + totalReward.Source + totalReward.InclusionDelay + totalReward.Inactivity
+		binary.BigEndian.PutUint32(epochRewards.ValidatorRewards[totalReward.ValidatorIndex*8:], uint32(clampRewardInt32(reward)))
+	}
+	for validatorIdx, reward := range syncRewards {
+		if reward > 0 {
+			epochRewards.SyncRewards += uint64(reward)
+		} else {
+			epochRewards.SyncPenalties += uint64(-reward)
+		}
+		binary.BigEndian.PutUint32(epochRewards.ValidatorRewards[validatorIdx*8+4:], uint32(clampRewardInt32(reward)))
+	}
+
+	logger.WithField("client", client.clientName).Debugf("loaded epoch %v rewards in %v (%v validators)", epoch, time.Since(t1), epochRewards.ValidatorCount)
+	return epochRewards
+}
+
+func positiveReward(reward int64) uint64 {
+	if reward < 0 {
+		return 0
+	}
+	return uint64(reward)
+}
+
+func clampRewardInt32(reward int64) int32 {
+	if reward > math.MaxInt32 {
+		return math.MaxInt32
+	}
+	if reward < math.MinInt32 {
+		return math.MinInt32
+	}
+	return int32(reward)
+}
+
+func persistEpochRewards(epochRewards *dbtypes.EpochRewards, tx *sqlx.Tx) error {
+	if epochRewards == nil {
+		return nil
+	}
+	return db.InsertEpochRewards(epochRewards, tx)
+}
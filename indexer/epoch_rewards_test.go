@@ -0,0 +1,45 @@
+package indexer
+
+import (
+	"math"
+	"testing"
+)
+
+func TestClampRewardInt32(t *testing.T) {
+	tests := []struct {
+		reward   int64
+		expected int32
+	}{
+		{reward: 0, expected: 0},
+		{reward: 12345, expected: 12345},
+		{reward: -12345, expected: -12345},
+		{reward: math.MaxInt32, expected: math.MaxInt32},
+		{reward: math.MinInt32, expected: math.MinInt32},
+		{reward: math.MaxInt32 + 1, expected: math.MaxInt32},
+		{reward: math.MinInt32 - 1, expected: math.MinInt32},
+		{reward: math.MaxInt64, expected: math.MaxInt32},
+		{reward: math.MinInt64, expected: math.MinInt32},
+	}
+	for _, test := range tests {
+		if clamped := clampRewardInt32(test.reward); clamped != test.expected {
+			t.Errorf("clampRewardInt32(%v) = %v, expected %v", test.reward, clamped, test.expected)
+		}
+	}
+}
+
+func TestPositiveReward(t *testing.T) {
+	tests := []struct {
+		reward   int64
+		expected uint64
+	}{
+		{reward: 0, expected: 0},
+		{reward: 42, expected: 42},
+		{reward: -42, expected: 0},
+		{reward: math.MinInt64, expected: 0},
+	}
+	for _, test := range tests {
+		if positive := positiveReward(test.reward); positive != test.expected {
+			t.Errorf("positiveReward(%v) = %v, expected %v", test.reward, positive, test.expected)
+		}
+	}
+}
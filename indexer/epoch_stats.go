@@ -38,6 +38,9 @@ type EpochStateStats struct {
 	EligibleAmount    uint64
 	ValidatorBalances map[uint64]uint64
 	DepositIndex      uint64
+	Eth1Data          *phase0.ETH1Data
+	BalanceSnapshot   *dbtypes.BalanceSnapshot
+	QueueStats        *dbtypes.ValidatorQueueStats
 }
 
 func (cache *indexerCache) getEpochStats(epoch uint64, dependendRoot []byte) *EpochStats {
@@ -394,6 +397,7 @@ func (epochStats *EpochStats) loadValidatorStats(client *ConsensusClient, stateR
 	validatorStats := &EpochStateStats{
 		ValidatorBalances: make(map[uint64]uint64),
 		DepositIndex:      getDepositIndexFromState(epochState),
+		Eth1Data:          getEth1DataFromState(epochState),
 	}
 	for _, validator := range epochValidators {
 		validatorStats.ValidatorBalances[uint64(validator.Index)] = uint64(validator.Validator.EffectiveBalance)
@@ -403,9 +407,23 @@ func (epochStats *EpochStats) loadValidatorStats(client *ConsensusClient, stateR
 			validatorStats.EligibleAmount += uint64(validator.Validator.EffectiveBalance)
 		}
 	}
+	if isBalanceSnapshotEpoch(epochStats.Epoch) {
+		validatorStats.BalanceSnapshot = buildBalanceSnapshot(epochStats.Epoch, validatorList, validatorBalances)
+	}
+	validatorStats.QueueStats = buildValidatorQueueStats(epochStats.Epoch, epochValidators)
 	epochStats.stateStats = validatorStats
 }
 
+// GetEth1Data returns the eth1 data of the epoch state (nil if not loaded)
+func (epochStats *EpochStats) GetEth1Data() *phase0.ETH1Data {
+	epochStats.stateStatsMutex.RLock()
+	defer epochStats.stateStatsMutex.RUnlock()
+	if epochStats.stateStats == nil {
+		return nil
+	}
+	return epochStats.stateStats.Eth1Data
+}
+
 func (epochStats *EpochStats) GetInitialDepositIndex() *uint64 {
 	if epochStats.stateStats == nil {
 		return nil
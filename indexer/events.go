@@ -0,0 +1,203 @@
+package indexer
+
+import (
+	"bytes"
+	"sync"
+	"time"
+
+	"github.com/ethpandaops/dora/dbtypes"
+	"github.com/ethpandaops/dora/utils"
+)
+
+type IndexerEventType uint8
+
+const (
+	// BlockEventType is dispatched for each new block received from a client event stream
+	BlockEventType IndexerEventType = iota + 1
+	// FinalizedEpochEventType is dispatched after a finalized epoch has been written to the db
+	FinalizedEpochEventType
+	// HeadEventType is dispatched when the canonical head of the indexer changes
+	HeadEventType
+	// ReorgEventType is dispatched when a canonical head that stayed stable for half a slot does not descend from the previous stable head
+	ReorgEventType
+	// FinalizedCheckpointEventType is dispatched when the indexer sees a new finalized checkpoint
+	FinalizedCheckpointEventType
+)
+
+type IndexerEvent struct {
+	Type IndexerEventType
+
+	// BlockEventType, HeadEventType & ReorgEventType (new head block)
+	Block *CacheBlock
+
+	// ReorgEventType
+	OldHeadSlot uint64
+	OldHeadRoot []byte
+	ReorgDepth  uint64
+	Reorg       *dbtypes.Reorg
+
+	// FinalizedCheckpointEventType (finalized epoch in Epoch)
+	FinalizedRoot  []byte
+	JustifiedEpoch uint64
+	JustifiedRoot  []byte
+
+	// FinalizedEpochEventType
+	Epoch          uint64
+	MissedSlots    map[uint64]uint64 // slot -> proposer
+	Slashings      []*dbtypes.Slashing
+	VoluntaryExits []*dbtypes.VoluntaryExit
+}
+
+type IndexerEventSubscription struct {
+	dispatcher *indexerEventDispatcher
+	channel    chan *IndexerEvent
+}
+
+type indexerEventDispatcher struct {
+	mutex         sync.Mutex
+	subscriptions map[*IndexerEventSubscription]bool
+	headMutex     sync.Mutex
+	lastHeadSlot  uint64
+	lastHeadRoot  []byte
+	stableHead    *CacheBlock
+}
+
+// SubscribeEvents creates a new indexer event subscription.
+// Events are dropped for the subscription if its channel buffer is full, so subscribers need to process events quickly.
+func (indexer *Indexer) SubscribeEvents(bufferSize int) *IndexerEventSubscription {
+	dispatcher := &indexer.eventDispatcher
+	subscription := &IndexerEventSubscription{
+		dispatcher: dispatcher,
+		channel:    make(chan *IndexerEvent, bufferSize),
+	}
+
+	dispatcher.mutex.Lock()
+	defer dispatcher.mutex.Unlock()
+	if dispatcher.subscriptions == nil {
+		dispatcher.subscriptions = map[*IndexerEventSubscription]bool{}
+	}
+	dispatcher.subscriptions[subscription] = true
+
+	return subscription
+}
+
+func (subscription *IndexerEventSubscription) Channel() <-chan *IndexerEvent {
+	return subscription.channel
+}
+
+func (subscription *IndexerEventSubscription) Unsubscribe() {
+	subscription.dispatcher.mutex.Lock()
+	defer subscription.dispatcher.mutex.Unlock()
+	delete(subscription.dispatcher.subscriptions, subscription)
+}
+
+func (dispatcher *indexerEventDispatcher) fire(event *IndexerEvent) {
+	dispatcher.mutex.Lock()
+	defer dispatcher.mutex.Unlock()
+
+	for subscription := range dispatcher.subscriptions {
+		select {
+		case subscription.channel <- event:
+		default:
+			logger.Warnf("indexer event subscription buffer full, dropping event (type %v)", event.Type)
+		}
+	}
+}
+
+// checkHeadUpdate fires a HeadEventType when the canonical head changed since the last check.
+// Reorgs are checked after the head stayed unchanged for half a slot, so short head flips between competing blocks are not recorded as reorgs.
+func (indexer *Indexer) checkHeadUpdate() {
+	dispatcher := &indexer.eventDispatcher
+	headSlot, headRoot := indexer.GetCanonicalHead()
+	if headRoot == nil {
+		return
+	}
+
+	dispatcher.headMutex.Lock()
+	defer dispatcher.headMutex.Unlock()
+
+	if bytes.Equal(dispatcher.lastHeadRoot, headRoot) {
+		return
+	}
+	headBlock := indexer.indexerCache.getCachedBlock(headRoot)
+	if headBlock == nil {
+		return
+	}
+
+	dispatcher.lastHeadSlot = headSlot
+	dispatcher.lastHeadRoot = headRoot
+
+	debounceTime := time.Duration(utils.Config.Chain.Config.SecondsPerSlot) * time.Second / 2
+	time.AfterFunc(debounceTime, func() {
+		indexer.checkStableHead(headBlock)
+	})
+
+	dispatcher.fire(&IndexerEvent{
+		Type:  HeadEventType,
+		Block: headBlock,
+	})
+}
+
+// checkStableHead fires a ReorgEventType if the head block is still the canonical head and does not descend from the previous stable head
+func (indexer *Indexer) checkStableHead(headBlock *CacheBlock) {
+	defer utils.HandleSubroutinePanic("checkStableHead")
+
+	dispatcher := &indexer.eventDispatcher
+	dispatcher.headMutex.Lock()
+	defer dispatcher.headMutex.Unlock()
+
+	if !bytes.Equal(dispatcher.lastHeadRoot, headBlock.Root) {
+		return // head changed in the meantime
+	}
+
+	oldHeadBlock := dispatcher.stableHead
+	dispatcher.stableHead = headBlock
+	if oldHeadBlock == nil || indexer.indexerCache.isCanonicalBlock(oldHeadBlock.Root, headBlock.Root) {
+		return
+	}
+	if indexer.indexerCache.getCachedBlock(oldHeadBlock.Root) == nil {
+		return // old head has been pruned from the cache
+	}
+
+	reorg := indexer.buildReorg(oldHeadBlock, headBlock)
+	logger.Infof("chain reorg detected: head %v [0x%x] -> %v [0x%x], depth %v", oldHeadBlock.Slot, oldHeadBlock.Root, headBlock.Slot, headBlock.Root, reorg.Depth)
+	go indexer.persistReorg(reorg)
+
+	dispatcher.fire(&IndexerEvent{
+		Type:        ReorgEventType,
+		Block:       headBlock,
+		OldHeadSlot: oldHeadBlock.Slot,
+		OldHeadRoot: oldHeadBlock.Root,
+		ReorgDepth:  reorg.Depth,
+		Reorg:       reorg,
+	})
+}
+
+// buildFinalizedEpochEvent collects the missed proposals, slashings & voluntary exits of a finalized epoch (as written to the db by persistEpochData)
+func buildFinalizedEpochEvent(epoch uint64, blockMap map[uint64]*CacheBlock, epochStats *EpochStats) *IndexerEvent {
+	event := &IndexerEvent{
+		Type:           FinalizedEpochEventType,
+		Epoch:          epoch,
+		MissedSlots:    map[uint64]uint64{},
+		Slashings:      []*dbtypes.Slashing{},
+		VoluntaryExits: []*dbtypes.VoluntaryExit{},
+	}
+
+	firstSlot := epoch * utils.Config.Chain.Config.SlotsPerEpoch
+	lastSlot := firstSlot + utils.Config.Chain.Config.SlotsPerEpoch - 1
+	proposerAssignments := epochStats.GetProposerAssignments()
+	for slot := firstSlot; slot <= lastSlot; slot++ {
+		block := blockMap[slot]
+		if block == nil {
+			if proposer, found := proposerAssignments[slot]; found {
+				event.MissedSlots[slot] = proposer
+			}
+			continue
+		}
+
+		event.Slashings = append(event.Slashings, BuildDbSlashings(block)...)
+		event.VoluntaryExits = append(event.VoluntaryExits, BuildDbVoluntaryExits(block)...)
+	}
+
+	return event
+}
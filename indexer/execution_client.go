@@ -27,6 +27,7 @@ type ExecutionClient struct {
 	indexerCache        *indexerCache
 	cacheMutex          sync.RWMutex
 	lastClientError     error
+	lastClientErrorTime time.Time
 	lastHeadRefresh     time.Time
 	isSynchronizing     bool
 	syncDistance        uint64
@@ -97,6 +98,14 @@ func (client *ExecutionClient) GetLastClientError() string {
 	return client.lastClientError.Error()
 }
 
+func (client *ExecutionClient) GetLastClientErrorTime() time.Time {
+	return client.lastClientErrorTime
+}
+
+func (client *ExecutionClient) GetRetryCounter() uint64 {
+	return client.retryCounter
+}
+
 func (client *ExecutionClient) GetNodePeers() []*p2p.PeerInfo {
 	if client.peers == nil {
 		return []*p2p.PeerInfo{}
@@ -154,6 +163,7 @@ func (client *ExecutionClient) runExecutionClientLoop() {
 		}
 
 		client.lastClientError = err
+		client.lastClientErrorTime = time.Now()
 		client.retryCounter++
 		waitTime := 10
 		skipLog := false
@@ -0,0 +1,184 @@
+package indexer
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	"github.com/ethpandaops/dora/utils"
+)
+
+// ForkChoiceTree is the unfinalized block tree (since the last finalized checkpoint) as seen by the indexer cache
+type ForkChoiceTree struct {
+	FinalizedEpoch int64
+	FinalizedRoot  []byte
+	JustifiedEpoch int64
+	JustifiedRoot  []byte
+	HeadSlot       uint64
+	HeadRoot       []byte
+	VoteCounts     bool // weights are validator counts instead of gwei (no state stats available)
+	Nodes          []*ForkChoiceNode
+}
+
+// ForkChoiceNode is a single block of the fork choice tree
+type ForkChoiceNode struct {
+	Block       *CacheBlock
+	ParentRoot  []byte
+	Parent      *ForkChoiceNode
+	Children    []*ForkChoiceNode
+	Canonical   bool
+	Orphaned    bool
+	VoteWeight  uint64 // weight of the latest attestations voting for this block
+	Weight      uint64 // weight of the latest attestations voting for this block or one of its descendants
+	HeadClients []*ConsensusClient
+}
+
+type forkChoiceVote struct {
+	epoch uint64
+	slot  uint64
+	root  []byte
+}
+
+// GetForkChoiceTree builds the tree of all cached blocks since the last finalized checkpoint.
+// Nodes are weighted with the latest attestation of each validator, using the duties & balances of the matching EpochStats (as used for the EpochVotes).
+func (indexer *Indexer) GetForkChoiceTree() *ForkChoiceTree {
+	cache := indexer.indexerCache
+	finalizedEpoch, finalizedRoot, justifiedEpoch, justifiedRoot := cache.getFinalizationCheckpoints()
+	headSlot, headRoot := indexer.GetCanonicalHead()
+	tree := &ForkChoiceTree{
+		FinalizedEpoch: finalizedEpoch,
+		FinalizedRoot:  finalizedRoot,
+		JustifiedEpoch: justifiedEpoch,
+		JustifiedRoot:  justifiedRoot,
+		HeadSlot:       headSlot,
+		HeadRoot:       headRoot,
+		Nodes:          []*ForkChoiceNode{},
+	}
+
+	minSlot := uint64(0)
+	if finalizedEpoch > 0 {
+		minSlot = uint64(finalizedEpoch) * utils.Config.Chain.Config.SlotsPerEpoch
+	}
+
+	// collect blocks
+	nodeMap := map[string]*ForkChoiceNode{}
+	cache.cacheMutex.RLock()
+	for slot, blocks := range cache.slotMap {
+		for _, block := range blocks {
+			if slot < minSlot && !bytes.Equal(block.Root, finalizedRoot) {
+				continue
+			}
+			node := &ForkChoiceNode{
+				Block:       block,
+				Children:    []*ForkChoiceNode{},
+				HeadClients: []*ConsensusClient{},
+			}
+			nodeMap[string(block.Root)] = node
+			tree.Nodes = append(tree.Nodes, node)
+		}
+	}
+	cache.cacheMutex.RUnlock()
+
+	sort.Slice(tree.Nodes, func(a, b int) bool {
+		if tree.Nodes[a].Block.Slot != tree.Nodes[b].Block.Slot {
+			return tree.Nodes[a].Block.Slot < tree.Nodes[b].Block.Slot
+		}
+		return bytes.Compare(tree.Nodes[a].Block.Root, tree.Nodes[b].Block.Root) < 0
+	})
+
+	// link nodes
+	for _, node := range tree.Nodes {
+		node.ParentRoot = node.Block.GetParentRoot()
+		if parent := nodeMap[string(node.ParentRoot)]; parent != nil {
+			node.Parent = parent
+			parent.Children = append(parent.Children, node)
+		}
+	}
+
+	// mark canonical chain
+	for node := nodeMap[string(headRoot)]; node != nil; node = node.Parent {
+		node.Canonical = true
+	}
+	for _, node := range tree.Nodes {
+		node.Orphaned = !node.Canonical && headRoot != nil
+	}
+
+	// client heads
+	for _, client := range indexer.GetConsensusClients() {
+		_, clientHeadRoot, _ := client.GetLastHead()
+		if node := nodeMap[string(clientHeadRoot)]; node != nil {
+			node.HeadClients = append(node.HeadClients, client)
+		}
+	}
+
+	// attestation weights (latest message of each validator)
+	latestVotes := map[uint64]*forkChoiceVote{}
+	epochStatsMap := map[uint64]*EpochStats{}
+	for _, node := range tree.Nodes {
+		blockBody := node.Block.GetBlockBody()
+		if blockBody == nil {
+			continue
+		}
+		attestations, err := blockBody.Attestations()
+		if err != nil {
+			continue
+		}
+		for _, att := range attestations {
+			attEpoch := uint64(att.Data.Target.Epoch)
+			epochStats, found := epochStatsMap[attEpoch]
+			if !found {
+				epochStats = indexer.getCachedEpochStats(attEpoch, headRoot)
+				epochStatsMap[attEpoch] = epochStats
+			}
+			if epochStats == nil || epochStats.attestorAssignments == nil {
+				continue
+			}
+
+			attSlot := uint64(att.Data.Slot)
+			voteValidators := epochStats.attestorAssignments[fmt.Sprintf("%v-%v", attSlot, uint64(att.Data.Index))]
+			for bitIdx, validatorIdx := range voteValidators {
+				if !utils.BitAtVector(att.AggregationBits, bitIdx) {
+					continue
+				}
+				latestVote := latestVotes[validatorIdx]
+				if latestVote != nil && (latestVote.epoch > attEpoch || (latestVote.epoch == attEpoch && latestVote.slot >= attSlot)) {
+					continue
+				}
+				latestVotes[validatorIdx] = &forkChoiceVote{
+					epoch: attEpoch,
+					slot:  attSlot,
+					root:  att.Data.BeaconBlockRoot[:],
+				}
+			}
+		}
+	}
+
+	headEpochStats := indexer.getCachedEpochStats(utils.EpochOfSlot(headSlot), headRoot)
+	var validatorBalances map[uint64]uint64
+	if headEpochStats != nil && headEpochStats.stateStats != nil {
+		validatorBalances = headEpochStats.stateStats.ValidatorBalances
+	}
+	tree.VoteCounts = validatorBalances == nil
+	for validatorIdx, vote := range latestVotes {
+		node := nodeMap[string(vote.root)]
+		if node == nil {
+			continue
+		}
+		if validatorBalances != nil {
+			node.VoteWeight += validatorBalances[validatorIdx]
+		} else {
+			node.VoteWeight++
+		}
+	}
+
+	// accumulate weights bottom-up (nodes are sorted by slot, so children are always processed before their parents)
+	for idx := len(tree.Nodes) - 1; idx >= 0; idx-- {
+		node := tree.Nodes[idx]
+		node.Weight += node.VoteWeight
+		if node.Parent != nil {
+			node.Parent.Weight += node.Weight
+		}
+	}
+
+	return tree
+}
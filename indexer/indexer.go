@@ -2,6 +2,7 @@ package indexer
 
 import (
 	"bytes"
+	"fmt"
 	"math"
 	"math/rand"
 	"sort"
@@ -22,12 +23,14 @@ type Indexer struct {
 	BlobStore             *BlobStore
 	indexerCache          *indexerCache
 	depositIndexer        *DepositIndexer
+	txIndexer             *TxIndexer
 	consensusClients      []*ConsensusClient
 	executionClients      []*ExecutionClient
 	writeDb               bool
 	disableSync           bool
 	inMemoryEpochs        uint16
 	cachePersistenceDelay uint16
+	eventDispatcher       indexerEventDispatcher
 }
 
 func NewIndexer() (*Indexer, error) {
@@ -51,6 +54,12 @@ func NewIndexer() (*Indexer, error) {
 	}
 	indexer.indexerCache = newIndexerCache(indexer)
 	indexer.depositIndexer = newDepositIndexer(indexer)
+	if indexer.writeDb && utils.Config.Indexer.EnableTransactionIndex {
+		indexer.txIndexer = newTxIndexer(indexer)
+	}
+	if indexer.writeDb {
+		indexer.BlobStore.startPruner()
+	}
 
 	return indexer, nil
 }
@@ -333,6 +342,66 @@ func (indexer *Indexer) GetCacheState() (highestSlot int64, finalizedEpoch int64
 	return
 }
 
+// GetCacheStats returns the number of blocks and epoch stats currently held in the indexer cache
+func (indexer *Indexer) GetCacheStats() (blockCount int, epochStatsCount int) {
+	indexer.indexerCache.cacheMutex.RLock()
+	blockCount = len(indexer.indexerCache.rootMap)
+	indexer.indexerCache.cacheMutex.RUnlock()
+
+	indexer.indexerCache.epochStatsMutex.RLock()
+	for _, epochStats := range indexer.indexerCache.epochStatsMap {
+		epochStatsCount += len(epochStats)
+	}
+	indexer.indexerCache.epochStatsMutex.RUnlock()
+
+	return
+}
+
+// GetSynchronizerState returns whether the synchronizer is running and the epoch it is currently processing
+func (indexer *Indexer) GetSynchronizerState() (running bool, currentEpoch uint64) {
+	synchronizer := indexer.indexerCache.synchronizer
+	if synchronizer == nil {
+		return false, 0
+	}
+	synchronizer.stateMutex.Lock()
+	defer synchronizer.stateMutex.Unlock()
+	return synchronizer.running, synchronizer.currentEpoch
+}
+
+// GetDepositIndexerState returns the current state of the deposit log indexer (nil if not loaded yet)
+func (indexer *Indexer) GetDepositIndexerState() *dbtypes.DepositIndexerState {
+	if indexer.depositIndexer == nil {
+		return nil
+	}
+	return indexer.depositIndexer.getState()
+}
+
+// GetDepositTreeStatus returns the state & verification results of the deposit tree (nil if not loaded yet)
+func (indexer *Indexer) GetDepositTreeStatus() *DepositTreeStatus {
+	if indexer.depositIndexer == nil {
+		return nil
+	}
+	return indexer.depositIndexer.getDepositTreeStatus()
+}
+
+// GetDepositTreeRoot returns the deposit root after the given number of deposits (nil if unknown)
+func (indexer *Indexer) GetDepositTreeRoot(depositCount uint64) []byte {
+	if indexer.depositIndexer == nil {
+		return nil
+	}
+	indexer.depositIndexer.depositTreeMutex.RLock()
+	defer indexer.depositIndexer.depositTreeMutex.RUnlock()
+	return indexer.depositIndexer.getDepositRoot(depositCount)
+}
+
+// GetDepositSnapshot returns the EIP-4881 deposit tree snapshot at the eth1 data of the latest finalized state
+func (indexer *Indexer) GetDepositSnapshot() (*DepositTreeSnapshot, error) {
+	if indexer.depositIndexer == nil {
+		return nil, fmt.Errorf("deposit indexer not running")
+	}
+	return indexer.depositIndexer.getDepositSnapshot()
+}
+
 func (indexer *Indexer) GetHeadForks(readyOnly bool) []*HeadFork {
 	headForks := []*HeadFork{}
 	for _, client := range indexer.consensusClients {
@@ -594,6 +663,12 @@ func (indexer *Indexer) GetCachedValidatorSet() map[phase0.ValidatorIndex]*v1.Va
 	return indexer.indexerCache.lastValidatorsResp
 }
 
+func (indexer *Indexer) GetCachedValidatorSetEpoch() int64 {
+	indexer.indexerCache.cacheMutex.RLock()
+	defer indexer.indexerCache.cacheMutex.RUnlock()
+	return indexer.indexerCache.lastValidatorsEpoch
+}
+
 func (indexer *Indexer) GetCachedValidatorPubkeyMap() map[phase0.BLSPubKey]*v1.Validator {
 	return indexer.indexerCache.lastValidatorsPubKeyMap
 }
@@ -607,6 +682,23 @@ func (indexer *Indexer) GetEpochVotes(epoch uint64) (*EpochStats, *EpochVotes) {
 }
 
 func (indexer *Indexer) getEpochVotes(epoch uint64, epochStats *EpochStats) *EpochVotes {
+	canonicalMap, epochTarget := indexer.getEpochCanonicalBlocks(epoch)
+
+	// calculate votes
+	return aggregateEpochVotes(canonicalMap, epoch, epochStats, epochTarget, false, false)
+}
+
+// GetEpochAttestationOutcomes returns the attestation outcomes of a cached (not yet finalized) epoch.
+func (indexer *Indexer) GetEpochAttestationOutcomes(epoch uint64) []byte {
+	epochStats := indexer.GetCachedEpochStats(epoch)
+	if epochStats == nil {
+		return nil
+	}
+	canonicalMap, epochTarget := indexer.getEpochCanonicalBlocks(epoch)
+	return buildEpochAttestationOutcomes(canonicalMap, epoch, epochStats, epochTarget, false)
+}
+
+func (indexer *Indexer) getEpochCanonicalBlocks(epoch uint64) (map[uint64]*CacheBlock, []byte) {
 	_, headRoot := indexer.GetCanonicalHead()
 
 	// get epoch target
@@ -630,8 +722,7 @@ func (indexer *Indexer) getEpochVotes(epoch uint64, epochStats *EpochStats) *Epo
 		canonicalMap[slot] = block
 	}
 
-	// calculate votes
-	return aggregateEpochVotes(canonicalMap, epoch, epochStats, epochTarget, false, false)
+	return canonicalMap, epochTarget
 }
 
 func (indexer *Indexer) BuildLiveEpoch(epoch uint64) *dbtypes.Epoch {
@@ -689,3 +780,11 @@ func (indexer *Indexer) BuildLiveBlock(block *CacheBlock) *dbtypes.Slot {
 	}
 	return dbBlock
 }
+
+// GetTxIndexerState returns the current state of the transaction indexer (nil if disabled or not loaded yet)
+func (indexer *Indexer) GetTxIndexerState() *dbtypes.TxIndexerState {
+	if indexer.txIndexer == nil {
+		return nil
+	}
+	return indexer.txIndexer.getState()
+}
@@ -0,0 +1,74 @@
+package indexer
+
+import (
+	"bytes"
+	"strings"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/ethpandaops/dora/db"
+	"github.com/ethpandaops/dora/dbtypes"
+	"github.com/ethpandaops/dora/utils"
+)
+
+// buildReorg collects the details of a reorg from oldHeadBlock to headBlock.
+// The old chain is walked back (up to 64 blocks) to find the common ancestor, and the consensus clients are grouped by the branch their head follows.
+func (indexer *Indexer) buildReorg(oldHeadBlock *CacheBlock, headBlock *CacheBlock) *dbtypes.Reorg {
+	cache := indexer.indexerCache
+	reorg := &dbtypes.Reorg{
+		SlotNumber:   headBlock.Slot,
+		HeadRoot:     headBlock.Root,
+		OldHeadSlot:  oldHeadBlock.Slot,
+		OldHeadRoot:  oldHeadBlock.Root,
+		DetectedTime: uint64(time.Now().Unix()),
+	}
+
+	for block := oldHeadBlock; block != nil && reorg.Depth < 64; reorg.Depth++ {
+		if cache.isCanonicalBlock(block.Root, headBlock.Root) {
+			ancestorSlot := block.Slot
+			reorg.AncestorSlot = &ancestorSlot
+			reorg.AncestorRoot = block.Root
+			break
+		}
+		block = cache.getCachedBlock(block.GetParentRoot())
+	}
+
+	oldHeadClients := []string{}
+	newHeadClients := []string{}
+	for _, client := range indexer.GetConsensusClients() {
+		_, clientHeadRoot, _ := client.GetLastHead()
+		if clientHeadRoot == nil {
+			continue
+		}
+		// a client is on a branch if its head is part of that branch (descendant of the ancestor), either ahead or behind of the branch head
+		onNewBranch := cache.isCanonicalBlock(headBlock.Root, clientHeadRoot) || cache.isCanonicalBlock(clientHeadRoot, headBlock.Root)
+		onOldBranch := cache.isCanonicalBlock(oldHeadBlock.Root, clientHeadRoot) || cache.isCanonicalBlock(clientHeadRoot, oldHeadBlock.Root)
+		if reorg.AncestorRoot != nil && bytes.Equal(clientHeadRoot, reorg.AncestorRoot) {
+			continue
+		}
+		if onNewBranch && !onOldBranch {
+			newHeadClients = append(newHeadClients, client.GetName())
+		} else if onOldBranch && !onNewBranch {
+			oldHeadClients = append(oldHeadClients, client.GetName())
+		}
+	}
+	reorg.OldHeadClients = strings.Join(oldHeadClients, ",")
+	reorg.NewHeadClients = strings.Join(newHeadClients, ",")
+
+	return reorg
+}
+
+// persistReorg writes the reorg to the db, called in a separate goroutine to not block the head processing
+func (indexer *Indexer) persistReorg(reorg *dbtypes.Reorg) {
+	defer utils.HandleSubroutinePanic("persistReorg")
+	if !indexer.writeDb {
+		return
+	}
+	err := db.RunDBTransaction(func(tx *sqlx.Tx) error {
+		return db.InsertReorg(reorg, tx)
+	})
+	if err != nil {
+		logger.Errorf("error persisting reorg at slot %v: %v", reorg.SlotNumber, err)
+	}
+}
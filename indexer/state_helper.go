@@ -1,6 +1,9 @@
 package indexer
 
-import "github.com/attestantio/go-eth2-client/spec"
+import (
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
 
 func getDepositIndexFromState(state *spec.VersionedBeaconState) uint64 {
 	switch state.Version {
@@ -17,3 +20,19 @@ func getDepositIndexFromState(state *spec.VersionedBeaconState) uint64 {
 	}
 	return 0
 }
+
+func getEth1DataFromState(state *spec.VersionedBeaconState) *phase0.ETH1Data {
+	switch state.Version {
+	case spec.DataVersionPhase0:
+		return state.Phase0.ETH1Data
+	case spec.DataVersionAltair:
+		return state.Altair.ETH1Data
+	case spec.DataVersionBellatrix:
+		return state.Bellatrix.ETH1Data
+	case spec.DataVersionCapella:
+		return state.Capella.ETH1Data
+	case spec.DataVersionDeneb:
+		return state.Deneb.ETH1Data
+	}
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/ethpandaops/dora/db"
 	"github.com/ethpandaops/dora/dbtypes"
+	"github.com/ethpandaops/dora/rpc"
 	"github.com/ethpandaops/dora/utils"
 	"github.com/jmoiron/sqlx"
 	"github.com/sirupsen/logrus"
@@ -181,12 +182,27 @@ func (sync *synchronizerState) syncEpoch(syncEpoch uint64, retryCount int, lastT
 			if err != nil {
 				return false, client, fmt.Errorf("error fetching slot %v block: %v", slot, err)
 			}
-			sync.cachedBlocks[slot] = &CacheBlock{
-				Root:   headerRsp.Root[:],
-				Slot:   slot,
-				header: headerRsp.Header,
-				block:  blockRsp,
+			var executionRequests *rpc.ExecutionRequests
+			if utils.IsElectraEpoch(utils.EpochOfSlot(slot)) {
+				executionRequests, err = client.rpcClient.GetBlockExecutionRequestsByBlockroot(headerRsp.Root[:])
+				if err != nil {
+					return false, client, fmt.Errorf("error fetching slot %v execution requests: %v", slot, err)
+				}
+			}
+			blockRewards, err := client.rpcClient.GetBlockRewardsByBlockroot(headerRsp.Root[:])
+			if err != nil {
+				synclogger.WithField("client", client.clientName).Debugf("error fetching slot %v rewards: %v", slot, err)
 			}
+			cachedBlock := &CacheBlock{
+				Root:              headerRsp.Root[:],
+				Slot:              slot,
+				header:            headerRsp.Header,
+				block:             blockRsp,
+				executionRequests: executionRequests,
+				rewards:           blockRewards,
+			}
+			sync.indexer.loadBlockPriorityFees(cachedBlock)
+			sync.cachedBlocks[slot] = cachedBlock
 		}
 		if firstBlock == nil && sync.cachedBlocks[slot] != nil {
 			firstBlock = sync.cachedBlocks[slot]
@@ -274,18 +290,18 @@ func (sync *synchronizerState) syncEpoch(syncEpoch uint64, retryCount int, lastT
 		if err != nil {
 			return false, client, fmt.Errorf("cannot load blobs for block 0x%x: %v", block.Root, err)
 		}
-		for _, blob := range blobRsp {
-			blobs = append(blobs, &BlobAssignment{
-				Slot: slot,
-				Root: block.Root,
-				Blob: blob,
-			})
-		}
+		blobs = append(blobs, buildBlobAssignments(block, blobRsp)...)
+	}
+
+	// load attestation & sync committee rewards (optional)
+	epochRewards := loadEpochRewards(client, syncEpoch, sync.cachedBlocks, epochStats)
+	if sync.checkKillChan(0) {
+		return false, nil, nil
 	}
 
 	// save blocks
 	err = db.RunDBTransaction(func(tx *sqlx.Tx) error {
-		err = persistEpochData(syncEpoch, sync.cachedBlocks, epochStats, epochVotes, tx)
+		err = persistEpochData(syncEpoch, sync.cachedBlocks, epochStats, epochVotes, sync.indexer.GetCachedValidatorSet(), tx)
 		if err != nil {
 			return fmt.Errorf("error persisting epoch data to db: %v", err)
 		}
@@ -295,6 +311,36 @@ func (sync *synchronizerState) syncEpoch(syncEpoch uint64, retryCount int, lastT
 			return fmt.Errorf("error persisting sync committee assignments to db: %v", err)
 		}
 
+		err = persistEpochAttestationOutcomes(syncEpoch, sync.cachedBlocks, epochStats, targetRoot, tx)
+		if err != nil {
+			return fmt.Errorf("error persisting attestation outcomes to db: %v", err)
+		}
+
+		err = persistBalanceSnapshot(epochStats, tx)
+		if err != nil {
+			return fmt.Errorf("error persisting balance snapshot to db: %v", err)
+		}
+
+		err = persistValidatorQueueStats(epochStats, tx)
+		if err != nil {
+			return fmt.Errorf("error persisting validator queue stats to db: %v", err)
+		}
+
+		err = persistClientDiversity(syncEpoch, sync.cachedBlocks, epochStats, tx)
+		if err != nil {
+			return fmt.Errorf("error persisting client diversity to db: %v", err)
+		}
+
+		err = persistBlobStats(syncEpoch, sync.cachedBlocks, tx)
+		if err != nil {
+			return fmt.Errorf("error persisting blob stats to db: %v", err)
+		}
+
+		err = persistEpochRewards(epochRewards, tx)
+		if err != nil {
+			return fmt.Errorf("error persisting epoch rewards to db: %v", err)
+		}
+
 		if len(blobs) > 0 {
 			for _, blob := range blobs {
 				err := sync.indexer.BlobStore.saveBlob(blob, tx)
@@ -0,0 +1,229 @@
+package indexer
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/ethpandaops/dora/db"
+	"github.com/ethpandaops/dora/dbtypes"
+	"github.com/ethpandaops/dora/utils"
+)
+
+// TxIndexer indexes the transactions of all finalized execution blocks (with the status of their receipts).
+// Unfinalized transactions are not indexed, they're loaded from the execution clients on demand.
+type TxIndexer struct {
+	indexer    *Indexer
+	stateMutex sync.Mutex
+	state      *dbtypes.TxIndexerState
+	batchSize  uint64
+}
+
+func newTxIndexer(indexer *Indexer) *TxIndexer {
+	txi := &TxIndexer{
+		indexer:   indexer,
+		batchSize: 100,
+	}
+
+	go txi.runTxIndexerLoop()
+
+	return txi
+}
+
+func (txi *TxIndexer) runTxIndexerLoop() {
+	defer utils.HandleSubroutinePanic("runTxIndexerLoop")
+
+	for {
+		time.Sleep(60 * time.Second)
+		logger.Debugf("run transaction indexer logic")
+
+		err := txi.runTxIndexer()
+		if err != nil {
+			logger.Errorf("transaction indexer error: %v", err)
+		}
+	}
+}
+
+func (txi *TxIndexer) runTxIndexer() error {
+	if txi.state == nil {
+		txi.loadState()
+	}
+
+	finalizedEpoch, finalizedRoot, _, _ := txi.indexer.GetFinalizationCheckpoints()
+	if finalizedEpoch < 0 {
+		return fmt.Errorf("no finalization checkpoint")
+	}
+
+	finalizedBlock := txi.indexer.GetCachedBlock(finalizedRoot)
+	if finalizedBlock == nil {
+		return fmt.Errorf("could not get finalized block from cache (0x%x)", finalizedRoot)
+	}
+
+	finalizedBlockBody := finalizedBlock.GetBlockBody()
+	if finalizedBlockBody == nil {
+		return fmt.Errorf("could not get finalized block body (0x%x)", finalizedRoot)
+	}
+
+	finalizedBlockNumber, err := finalizedBlockBody.ExecutionBlockNumber()
+	if err != nil {
+		return fmt.Errorf("could not get execution block number from block body (0x%x): %v", finalizedRoot, err)
+	}
+
+	for txi.state.FinalBlock < finalizedBlockNumber {
+		toBlock := txi.state.FinalBlock + txi.batchSize
+		if toBlock > finalizedBlockNumber {
+			toBlock = finalizedBlockNumber
+		}
+
+		err := txi.processFinalizedBlocks(txi.state.FinalBlock+1, toBlock)
+		if err != nil {
+			// reload state from db, as it might be ahead of the persisted transactions
+			txi.setState(nil)
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (txi *TxIndexer) loadState() {
+	syncState := dbtypes.TxIndexerState{}
+	db.GetExplorerState("indexer.txindexstate", &syncState)
+	if syncState.FinalBlock == 0 && utils.Config.Indexer.TransactionIndexStartBlock > 0 {
+		syncState.FinalBlock = utils.Config.Indexer.TransactionIndexStartBlock - 1
+	}
+	txi.setState(&syncState)
+}
+
+// setState replaces the indexer state, the state is only modified from the indexer loop.
+func (txi *TxIndexer) setState(state *dbtypes.TxIndexerState) {
+	txi.stateMutex.Lock()
+	defer txi.stateMutex.Unlock()
+	txi.state = state
+}
+
+// getState returns a copy of the indexer state, safe to call from any goroutine.
+func (txi *TxIndexer) getState() *dbtypes.TxIndexerState {
+	txi.stateMutex.Lock()
+	defer txi.stateMutex.Unlock()
+	if txi.state == nil {
+		return nil
+	}
+	state := *txi.state
+	return &state
+}
+
+func (txi *TxIndexer) processFinalizedBlocks(fromBlock uint64, toBlock uint64) error {
+	client := txi.indexer.GetReadyElClient(false, nil, nil)
+	if client == nil {
+		return fmt.Errorf("no ready execution client found")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+
+	transactions := []*dbtypes.ElTransaction{}
+	for blockNumber := fromBlock; blockNumber <= toBlock; blockNumber++ {
+		block, err := client.GetRpcClient().GetBlockByNumber(ctx, blockNumber)
+		if err != nil {
+			return fmt.Errorf("could not load execution block %v: %v", blockNumber, err)
+		}
+
+		blockTxs := block.Transactions()
+		if len(blockTxs) == 0 {
+			continue
+		}
+
+		receipts, err := client.GetRpcClient().GetBlockReceipts(ctx, block.Hash())
+		if err != nil {
+			return fmt.Errorf("could not load receipts of execution block %v: %v", blockNumber, err)
+		}
+		receiptMap := make(map[common.Hash]*types.Receipt, len(receipts))
+		for _, receipt := range receipts {
+			receiptMap[receipt.TxHash] = receipt
+		}
+
+		for idx, tx := range blockTxs {
+			transaction, err := BuildElTransaction(tx, receiptMap[tx.Hash()])
+			if err != nil {
+				// skip undecodable transactions, failing the batch would retry the same range forever
+				logger.Warnf("could not decode transaction %v in block %v, skipping: %v", tx.Hash(), blockNumber, err)
+				continue
+			}
+			transaction.BlockNumber = blockNumber
+			transaction.BlockHash = block.Hash().Bytes()
+			transaction.BlockTime = block.Time()
+			transaction.TxIndex = uint64(idx)
+			transactions = append(transactions, transaction)
+		}
+	}
+
+	logger.Infof("indexed transactions for block %v - %v: %v transactions", fromBlock, toBlock, len(transactions))
+	return txi.persistFinalizedTransactions(toBlock, transactions)
+}
+
+// BuildElTransaction converts a transaction and its receipt (optional) to the db representation, the block reference is set by the caller
+func BuildElTransaction(tx *types.Transaction, receipt *types.Receipt) (*dbtypes.ElTransaction, error) {
+	txFrom, err := types.Sender(types.LatestSignerForChainID(tx.ChainId()), tx)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode tx sender: %v", err)
+	}
+
+	transaction := &dbtypes.ElTransaction{
+		TxHash:   tx.Hash().Bytes(),
+		TxFrom:   txFrom.Bytes(),
+		TxType:   uint64(tx.Type()),
+		Nonce:    tx.Nonce(),
+		Value:    tx.Value().Bytes(),
+		DataLen:  uint64(len(tx.Data())),
+		GasLimit: tx.Gas(),
+	}
+	if txTo := tx.To(); txTo != nil {
+		transaction.TxTo = txTo.Bytes()
+	}
+	if len(tx.Data()) >= 4 {
+		transaction.MethodSig = tx.Data()[0:4]
+	}
+	if receipt != nil {
+		gasUsed := receipt.GasUsed
+		status := receipt.Status
+		transaction.GasUsed = &gasUsed
+		transaction.Status = &status
+		if receipt.ContractAddress != (common.Address{}) {
+			transaction.ContractAddress = receipt.ContractAddress.Bytes()
+		}
+	}
+	return transaction, nil
+}
+
+func (txi *TxIndexer) persistFinalizedTransactions(toBlockNumber uint64, transactions []*dbtypes.ElTransaction) error {
+	return db.RunDBTransaction(func(tx *sqlx.Tx) error {
+		txCount := len(transactions)
+		for txIdx := 0; txIdx < txCount; txIdx += 500 {
+			endIdx := txIdx + 500
+			if endIdx > txCount {
+				endIdx = txCount
+			}
+
+			err := db.InsertElTransactions(transactions[txIdx:endIdx], tx)
+			if err != nil {
+				return fmt.Errorf("error while inserting transactions: %v", err)
+			}
+		}
+
+		txi.stateMutex.Lock()
+		txi.state.FinalBlock = toBlockNumber
+		txi.stateMutex.Unlock()
+		err := db.SetExplorerState("indexer.txindexstate", txi.state, tx)
+		if err != nil {
+			return fmt.Errorf("error while updating transaction indexer state: %v", err)
+		}
+
+		return nil
+	})
+}
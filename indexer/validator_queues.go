@@ -0,0 +1,52 @@
+package indexer
+
+import (
+	v1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/ethpandaops/dora/db"
+	"github.com/ethpandaops/dora/dbtypes"
+	"github.com/ethpandaops/dora/utils"
+)
+
+// buildValidatorQueueStats counts the validators waiting for activation (eligible or already scheduled) and exit at the start of an epoch
+func buildValidatorQueueStats(epoch uint64, validators map[phase0.ValidatorIndex]*v1.Validator) *dbtypes.ValidatorQueueStats {
+	stats := &dbtypes.ValidatorQueueStats{
+		Epoch: epoch,
+	}
+	totalActiveBalance := uint64(0)
+	for _, validator := range validators {
+		activationEpoch := uint64(validator.Validator.ActivationEpoch)
+		exitEpoch := uint64(validator.Validator.ExitEpoch)
+		if activationEpoch <= epoch && epoch < exitEpoch {
+			stats.ActiveCount++
+			totalActiveBalance += uint64(validator.Validator.EffectiveBalance)
+		}
+		if activationEpoch > epoch && validator.Validator.ActivationEligibilityEpoch != utils.FarFutureEpoch {
+			stats.ActivationCount++
+			stats.ActivationAmount += uint64(validator.Validator.EffectiveBalance)
+		} else if activationEpoch <= epoch && exitEpoch > epoch && exitEpoch != utils.FarFutureEpoch {
+			stats.ExitCount++
+			stats.ExitAmount += uint64(validator.Validator.EffectiveBalance)
+		}
+	}
+
+	if utils.IsElectraEpoch(epoch) {
+		stats.ActivationChurn = utils.GetActivationExitChurnLimit(totalActiveBalance)
+		stats.ExitChurn = stats.ActivationChurn
+	} else {
+		stats.ActivationChurn = utils.GetActivationChurnLimit(epoch, stats.ActiveCount)
+		stats.ExitChurn = utils.GetValidatorChurnLimit(stats.ActiveCount)
+	}
+	return stats
+}
+
+func persistValidatorQueueStats(epochStats *EpochStats, tx *sqlx.Tx) error {
+	epochStats.stateStatsMutex.RLock()
+	defer epochStats.stateStatsMutex.RUnlock()
+	if epochStats.stateStats == nil || epochStats.stateStats.QueueStats == nil {
+		return nil
+	}
+	return db.InsertValidatorQueueStats(epochStats.stateStats.QueueStats, tx)
+}
@@ -3,6 +3,8 @@ package indexer
 import (
 	"fmt"
 
+	v1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/ethpandaops/dora/db"
 	"github.com/ethpandaops/dora/dbtypes"
 	"github.com/ethpandaops/dora/utils"
@@ -54,7 +56,7 @@ func persistMissedSlots(epoch uint64, blockMap map[uint64]*CacheBlock, epochStat
 	return nil
 }
 
-func persistBlockData(block *CacheBlock, epochStats *EpochStats, depositIndex *uint64, orphaned bool, tx *sqlx.Tx) error {
+func persistBlockData(block *CacheBlock, epochStats *EpochStats, depositIndex *uint64, validatorSet map[phase0.ValidatorIndex]*v1.Validator, orphaned bool, tx *sqlx.Tx) error {
 	// insert block
 	dbBlock := buildDbBlock(block, epochStats)
 	if orphaned {
@@ -69,7 +71,7 @@ func persistBlockData(block *CacheBlock, epochStats *EpochStats, depositIndex *u
 	block.isInFinalizedDb = true
 
 	// insert child objects
-	err = persistBlockChildObjects(block, depositIndex, orphaned, tx)
+	err = persistBlockChildObjects(block, depositIndex, validatorSet, orphaned, tx)
 	if err != nil {
 		return err
 	}
@@ -77,7 +79,7 @@ func persistBlockData(block *CacheBlock, epochStats *EpochStats, depositIndex *u
 	return nil
 }
 
-func persistBlockChildObjects(block *CacheBlock, depositIndex *uint64, orphaned bool, tx *sqlx.Tx) error {
+func persistBlockChildObjects(block *CacheBlock, depositIndex *uint64, validatorSet map[phase0.ValidatorIndex]*v1.Validator, orphaned bool, tx *sqlx.Tx) error {
 	var err error
 
 	// insert deposits
@@ -98,18 +100,42 @@ func persistBlockChildObjects(block *CacheBlock, depositIndex *uint64, orphaned
 		return err
 	}
 
+	// insert withdrawals
+	err = persistBlockWithdrawals(block, validatorSet, orphaned, tx)
+	if err != nil {
+		return err
+	}
+
+	// insert bls changes
+	err = persistBlockBLSChanges(block, orphaned, tx)
+	if err != nil {
+		return err
+	}
+
+	// insert sync committee participation
+	err = persistBlockSyncParticipation(block, orphaned, tx)
+	if err != nil {
+		return err
+	}
+
+	// insert execution layer requests
+	err = persistBlockExecutionRequests(block, orphaned, tx)
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
-func persistEpochData(epoch uint64, blockMap map[uint64]*CacheBlock, epochStats *EpochStats, epochVotes *EpochVotes, tx *sqlx.Tx) error {
+func persistEpochData(epoch uint64, blockMap map[uint64]*CacheBlock, epochStats *EpochStats, epochVotes *EpochVotes, validatorSet map[phase0.ValidatorIndex]*v1.Validator, tx *sqlx.Tx) error {
 	if tx == nil {
 		return db.RunDBTransaction(func(tx *sqlx.Tx) error {
-			return persistEpochData(epoch, blockMap, epochStats, epochVotes, tx)
+			return persistEpochData(epoch, blockMap, epochStats, epochVotes, validatorSet, tx)
 		})
 	}
 
 	dbEpoch := buildDbEpoch(epoch, blockMap, epochStats, epochVotes, func(block *CacheBlock, depositIndex *uint64) {
-		err := persistBlockData(block, epochStats, depositIndex, false, tx)
+		err := persistBlockData(block, epochStats, depositIndex, validatorSet, false, tx)
 		if err != nil {
 			logger.Errorf("error persisting slot: %v", err)
 		}
@@ -178,8 +204,10 @@ func buildDbBlock(block *CacheBlock, epochStats *EpochStats) *dbtypes.Slot {
 	executionBlockNumber, _ := blockBody.ExecutionBlockNumber()
 	executionBlockHash, _ := blockBody.ExecutionBlockHash()
 	executionExtraData, _ := GetExecutionExtraData(blockBody)
+	executionFeeRecipient, _ := GetExecutionFeeRecipient(blockBody)
 	executionTransactions, _ := blockBody.ExecutionTransactions()
 	executionWithdrawals, _ := blockBody.Withdrawals()
+	eth1Data, _ := blockBody.ETH1Data()
 
 	dbBlock := dbtypes.Slot{
 		Slot:                  uint64(block.header.Message.Slot),
@@ -222,12 +250,21 @@ func buildDbBlock(block *CacheBlock, epochStats *EpochStats) *dbtypes.Slot {
 		dbBlock.EthBlockHash = executionBlockHash[:]
 		dbBlock.EthBlockExtra = executionExtraData
 		dbBlock.EthBlockExtraText = utils.GraffitiToString(executionExtraData[:])
+		dbBlock.EthFeeRecipient = executionFeeRecipient
 		dbBlock.WithdrawCount = uint64(len(executionWithdrawals))
 		for _, withdrawal := range executionWithdrawals {
 			dbBlock.WithdrawAmount += uint64(withdrawal.Amount)
 		}
 	}
 
+	if eth1Data != nil {
+		dbBlock.Eth1DataRoot = eth1Data.DepositRoot[:]
+		dbBlock.Eth1DataCount = &eth1Data.DepositCount
+		dbBlock.Eth1DataBlockHash = eth1Data.BlockHash
+	}
+
+	applyBlockRewards(&dbBlock, block.GetRewards(), block.GetPriorityFees())
+
 	return &dbBlock
 }
 
@@ -491,3 +528,235 @@ func BuildDbSlashings(block *CacheBlock) []*dbtypes.Slashing {
 
 	return dbSlashings
 }
+
+func persistBlockWithdrawals(block *CacheBlock, validatorSet map[phase0.ValidatorIndex]*v1.Validator, orphaned bool, tx *sqlx.Tx) error {
+	// insert withdrawals
+	dbWithdrawals := BuildDbWithdrawals(block, validatorSet)
+	if orphaned {
+		for idx := range dbWithdrawals {
+			dbWithdrawals[idx].Orphaned = true
+		}
+	}
+
+	if len(dbWithdrawals) > 0 {
+		err := db.InsertWithdrawals(dbWithdrawals, tx)
+		if err != nil {
+			return fmt.Errorf("error inserting withdrawals: %v", err)
+		}
+	}
+
+	return nil
+}
+
+// BuildDbWithdrawals builds the withdrawal db objects for a block.
+// A withdrawal is marked as full withdrawal if the validator was already withdrawable at the time of the withdrawal.
+// The withdrawable epoch of a validator does not change once it is set, so the latest validator set can be used for old blocks too.
+func BuildDbWithdrawals(block *CacheBlock, validatorSet map[phase0.ValidatorIndex]*v1.Validator) []*dbtypes.Withdrawal {
+	blockBody := block.GetBlockBody()
+	if blockBody == nil {
+		return nil
+	}
+
+	withdrawals, err := blockBody.Withdrawals()
+	if err != nil {
+		return nil
+	}
+
+	epoch := phase0.Epoch(utils.EpochOfSlot(block.Slot))
+	dbWithdrawals := make([]*dbtypes.Withdrawal, len(withdrawals))
+	for idx, withdrawal := range withdrawals {
+		dbWithdrawal := &dbtypes.Withdrawal{
+			SlotNumber:      block.Slot,
+			SlotIndex:       uint64(idx),
+			SlotRoot:        block.Root,
+			Orphaned:        false,
+			WithdrawalIndex: uint64(withdrawal.Index),
+			ValidatorIndex:  uint64(withdrawal.ValidatorIndex),
+			Address:         withdrawal.Address[:],
+			Amount:          uint64(withdrawal.Amount),
+			Type:            dbtypes.UnspecifiedWithdrawal,
+		}
+
+		// the type can only be determined with the validator, keep it unspecified if the validator set is not available
+		if validator := validatorSet[withdrawal.ValidatorIndex]; validator != nil {
+			if validator.Validator.WithdrawableEpoch <= epoch {
+				dbWithdrawal.Type = dbtypes.FullWithdrawal
+			} else {
+				dbWithdrawal.Type = dbtypes.PartialWithdrawal
+			}
+		}
+
+		dbWithdrawals[idx] = dbWithdrawal
+	}
+
+	return dbWithdrawals
+}
+
+func persistBlockBLSChanges(block *CacheBlock, orphaned bool, tx *sqlx.Tx) error {
+	// insert bls changes
+	dbBLSChanges := BuildDbBLSChanges(block)
+	if orphaned {
+		for idx := range dbBLSChanges {
+			dbBLSChanges[idx].Orphaned = true
+		}
+	}
+
+	if len(dbBLSChanges) > 0 {
+		err := db.InsertBLSChanges(dbBLSChanges, tx)
+		if err != nil {
+			return fmt.Errorf("error inserting bls changes: %v", err)
+		}
+	}
+
+	return nil
+}
+
+func persistBlockSyncParticipation(block *CacheBlock, orphaned bool, tx *sqlx.Tx) error {
+	dbSyncParticipation := BuildDbSyncParticipation(block)
+	if dbSyncParticipation == nil {
+		return nil
+	}
+	dbSyncParticipation.Orphaned = orphaned
+
+	err := db.InsertSyncParticipation(dbSyncParticipation, tx)
+	if err != nil {
+		return fmt.Errorf("error inserting sync participation: %v", err)
+	}
+
+	return nil
+}
+
+// BuildDbSyncParticipation returns the sync committee bits of the block (nil for pre-altair blocks)
+func BuildDbSyncParticipation(block *CacheBlock) *dbtypes.SyncParticipation {
+	blockBody := block.GetBlockBody()
+	if blockBody == nil {
+		return nil
+	}
+
+	syncAggregate, err := blockBody.SyncAggregate()
+	if err != nil || syncAggregate == nil {
+		return nil
+	}
+
+	return &dbtypes.SyncParticipation{
+		SlotNumber: block.Slot,
+		SlotRoot:   block.Root,
+		Bits:       syncAggregate.SyncCommitteeBits,
+	}
+}
+
+func BuildDbBLSChanges(block *CacheBlock) []*dbtypes.BLSChange {
+	blockBody := block.GetBlockBody()
+	if blockBody == nil {
+		return nil
+	}
+
+	blsChanges, err := blockBody.BLSToExecutionChanges()
+	if err != nil {
+		return nil
+	}
+
+	dbBLSChanges := make([]*dbtypes.BLSChange, len(blsChanges))
+	for idx, blsChange := range blsChanges {
+		dbBLSChange := &dbtypes.BLSChange{
+			SlotNumber:     block.Slot,
+			SlotIndex:      uint64(idx),
+			SlotRoot:       block.Root,
+			Orphaned:       false,
+			ValidatorIndex: uint64(blsChange.Message.ValidatorIndex),
+			BlsPubkey:      blsChange.Message.FromBLSPubkey[:],
+			Address:        blsChange.Message.ToExecutionAddress[:],
+		}
+
+		dbBLSChanges[idx] = dbBLSChange
+	}
+
+	return dbBLSChanges
+}
+
+func persistBlockExecutionRequests(block *CacheBlock, orphaned bool, tx *sqlx.Tx) error {
+	// insert deposit, withdrawal & consolidation requests
+	dbDepositRequests, dbWithdrawalRequests, dbConsolidationRequests := BuildDbExecutionRequests(block)
+	if orphaned {
+		for idx := range dbDepositRequests {
+			dbDepositRequests[idx].Orphaned = true
+		}
+		for idx := range dbWithdrawalRequests {
+			dbWithdrawalRequests[idx].Orphaned = true
+		}
+		for idx := range dbConsolidationRequests {
+			dbConsolidationRequests[idx].Orphaned = true
+		}
+	}
+
+	if len(dbDepositRequests) > 0 {
+		err := db.InsertDepositRequests(dbDepositRequests, tx)
+		if err != nil {
+			return fmt.Errorf("error inserting deposit requests: %v", err)
+		}
+	}
+	if len(dbWithdrawalRequests) > 0 {
+		err := db.InsertWithdrawalRequests(dbWithdrawalRequests, tx)
+		if err != nil {
+			return fmt.Errorf("error inserting withdrawal requests: %v", err)
+		}
+	}
+	if len(dbConsolidationRequests) > 0 {
+		err := db.InsertConsolidationRequests(dbConsolidationRequests, tx)
+		if err != nil {
+			return fmt.Errorf("error inserting consolidation requests: %v", err)
+		}
+	}
+
+	return nil
+}
+
+func BuildDbExecutionRequests(block *CacheBlock) ([]*dbtypes.DepositRequest, []*dbtypes.WithdrawalRequest, []*dbtypes.ConsolidationRequest) {
+	executionRequests := block.GetExecutionRequests()
+	if executionRequests == nil {
+		return nil, nil, nil
+	}
+
+	dbDepositRequests := make([]*dbtypes.DepositRequest, len(executionRequests.Deposits))
+	for idx, depositRequest := range executionRequests.Deposits {
+		dbDepositRequests[idx] = &dbtypes.DepositRequest{
+			SlotNumber:            block.Slot,
+			SlotIndex:             uint64(idx),
+			SlotRoot:              block.Root,
+			Orphaned:              false,
+			PublicKey:             depositRequest.Pubkey,
+			WithdrawalCredentials: depositRequest.WithdrawalCredentials,
+			Amount:                depositRequest.Amount,
+			Signature:             depositRequest.Signature,
+			DepositIndex:          depositRequest.Index,
+		}
+	}
+
+	dbWithdrawalRequests := make([]*dbtypes.WithdrawalRequest, len(executionRequests.Withdrawals))
+	for idx, withdrawalRequest := range executionRequests.Withdrawals {
+		dbWithdrawalRequests[idx] = &dbtypes.WithdrawalRequest{
+			SlotNumber:      block.Slot,
+			SlotIndex:       uint64(idx),
+			SlotRoot:        block.Root,
+			Orphaned:        false,
+			SourceAddress:   withdrawalRequest.SourceAddress,
+			ValidatorPubkey: withdrawalRequest.ValidatorPubkey,
+			Amount:          withdrawalRequest.Amount,
+		}
+	}
+
+	dbConsolidationRequests := make([]*dbtypes.ConsolidationRequest, len(executionRequests.Consolidations))
+	for idx, consolidationRequest := range executionRequests.Consolidations {
+		dbConsolidationRequests[idx] = &dbtypes.ConsolidationRequest{
+			SlotNumber:    block.Slot,
+			SlotIndex:     uint64(idx),
+			SlotRoot:      block.Root,
+			Orphaned:      false,
+			SourceAddress: consolidationRequest.SourceAddress,
+			SourcePubkey:  consolidationRequest.SourcePubkey,
+			TargetPubkey:  consolidationRequest.TargetPubkey,
+		}
+	}
+
+	return dbDepositRequests, dbWithdrawalRequests, dbConsolidationRequests
+}
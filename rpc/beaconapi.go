@@ -1,6 +1,7 @@
 package rpc
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
@@ -139,6 +140,52 @@ func (bc *BeaconClient) getJson(requrl string, returnValue interface{}) error {
 	return nil
 }
 
+func (bc *BeaconClient) postJson(requrl string, postData interface{}, returnValue interface{}, timeout time.Duration) error {
+	logurl := utils.GetRedactedUrl(requrl)
+	t0 := time.Now()
+	defer func() {
+		logger.WithField("client", bc.name).Debugf("RPC POST call (json): %v [%v ms]", logurl, time.Since(t0).Milliseconds())
+	}()
+
+	postBody, err := json.Marshal(postData)
+	if err != nil {
+		return fmt.Errorf("error encoding request body: %v", err)
+	}
+	req, err := nethttp.NewRequest("POST", requrl, bytes.NewReader(postBody))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for headerKey, headerVal := range bc.headers {
+		req.Header.Set(headerKey, headerVal)
+	}
+
+	client := &nethttp.Client{Timeout: timeout}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != nethttp.StatusOK {
+		if resp.StatusCode == nethttp.StatusNotFound {
+			return errNotFound
+		}
+		data, _ := io.ReadAll(resp.Body)
+		logger.WithField("client", bc.name).Debugf("RPC Error %v: %v", resp.StatusCode, data)
+		return fmt.Errorf("url: %v, error-response: %s", logurl, data)
+	}
+
+	dec := json.NewDecoder(resp.Body)
+	err = dec.Decode(&returnValue)
+	if err != nil {
+		return fmt.Errorf("error parsing json response: %v", err)
+	}
+
+	return nil
+}
+
 func (bc *BeaconClient) Initialize() error {
 	if bc.clientSvc != nil {
 		return nil
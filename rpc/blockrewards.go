@@ -0,0 +1,32 @@
+package rpc
+
+import (
+	"fmt"
+)
+
+// BlockRewards holds the consensus layer rewards of a block proposer in gwei (/eth/v1/beacon/rewards/blocks)
+type BlockRewards struct {
+	ProposerIndex     uint64 `json:"proposer_index,string"`
+	Total             uint64 `json:"total,string"`
+	Attestations      uint64 `json:"attestations,string"`
+	SyncAggregate     uint64 `json:"sync_aggregate,string"`
+	ProposerSlashings uint64 `json:"proposer_slashings,string"`
+	AttesterSlashings uint64 `json:"attester_slashings,string"`
+}
+
+// GetBlockRewardsByBlockroot loads the proposer rewards of a block.
+// The rewards are calculated by the client from the block pre-state, so this fails for old blocks on non-archive clients.
+func (bc *BeaconClient) GetBlockRewardsByBlockroot(blockroot []byte) (*BlockRewards, error) {
+	var rewardsRsp struct {
+		Data *BlockRewards `json:"data"`
+	}
+
+	err := bc.getJson(fmt.Sprintf("%s/eth/v1/beacon/rewards/blocks/0x%x", bc.endpoint, blockroot), &rewardsRsp)
+	if err != nil {
+		if err == errNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return rewardsRsp.Data, nil
+}
@@ -206,6 +206,19 @@ func (ec *ExecutionClient) GetBlockByHash(ctx context.Context, hash common.Hash)
 	return block, nil
 }
 
+func (ec *ExecutionClient) GetBlockByNumber(ctx context.Context, number uint64) (*ethtypes.Block, error) {
+	block, err := ec.ethClient.BlockByNumber(ctx, big.NewInt(0).SetUint64(number))
+	if err != nil {
+		return nil, err
+	}
+
+	return block, nil
+}
+
+func (ec *ExecutionClient) GetTransactionByHash(ctx context.Context, txHash common.Hash) (*ethtypes.Transaction, bool, error) {
+	return ec.ethClient.TransactionByHash(ctx, txHash)
+}
+
 func (ec *ExecutionClient) GetNonceAt(ctx context.Context, wallet common.Address, blockNumber *big.Int) (uint64, error) {
 	return ec.ethClient.NonceAt(ctx, wallet, blockNumber)
 }
@@ -218,6 +231,10 @@ func (ec *ExecutionClient) GetTransactionReceipt(ctx context.Context, txHash com
 	return ec.ethClient.TransactionReceipt(ctx, txHash)
 }
 
+func (ec *ExecutionClient) GetBlockReceipts(ctx context.Context, blockHash common.Hash) ([]*ethtypes.Receipt, error) {
+	return ec.ethClient.BlockReceipts(ctx, rpc.BlockNumberOrHashWithHash(blockHash, false))
+}
+
 func (ec *ExecutionClient) SendTransaction(ctx context.Context, tx *ethtypes.Transaction) error {
 	return ec.ethClient.SendTransaction(ctx, tx)
 }
@@ -0,0 +1,66 @@
+package rpc
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// ExecutionRequests holds the execution layer triggered requests (EIP-6110, EIP-7002 & EIP-7251) of an electra block
+type ExecutionRequests struct {
+	Deposits       []*DepositRequest       `json:"deposits"`
+	Withdrawals    []*WithdrawalRequest    `json:"withdrawals"`
+	Consolidations []*ConsolidationRequest `json:"consolidations"`
+}
+
+// DepositRequest is a deposit sent to the deposit contract (EIP-6110)
+type DepositRequest struct {
+	Pubkey                hexutil.Bytes `json:"pubkey"`
+	WithdrawalCredentials hexutil.Bytes `json:"withdrawal_credentials"`
+	Amount                uint64        `json:"amount,string"`
+	Signature             hexutil.Bytes `json:"signature"`
+	Index                 uint64        `json:"index,string"`
+}
+
+// WithdrawalRequest is a withdrawal / exit request sent to the withdrawal request contract (EIP-7002)
+type WithdrawalRequest struct {
+	SourceAddress   hexutil.Bytes `json:"source_address"`
+	ValidatorPubkey hexutil.Bytes `json:"validator_pubkey"`
+	Amount          uint64        `json:"amount,string"`
+}
+
+// ConsolidationRequest is a consolidation request sent to the consolidation request contract (EIP-7251)
+type ConsolidationRequest struct {
+	SourceAddress hexutil.Bytes `json:"source_address"`
+	SourcePubkey  hexutil.Bytes `json:"source_pubkey"`
+	TargetPubkey  hexutil.Bytes `json:"target_pubkey"`
+}
+
+// GetBlockExecutionRequestsByBlockroot loads the execution requests of an electra block.
+// The requests are decoded from the json block response directly, as the consensus client library does not support electra blocks yet.
+func (bc *BeaconClient) GetBlockExecutionRequestsByBlockroot(blockroot []byte) (*ExecutionRequests, error) {
+	var blockRsp struct {
+		Version string `json:"version"`
+		Data    struct {
+			Message struct {
+				Body struct {
+					ExecutionRequests *ExecutionRequests `json:"execution_requests"`
+				} `json:"body"`
+			} `json:"message"`
+		} `json:"data"`
+	}
+
+	err := bc.getJson(fmt.Sprintf("%s/eth/v2/beacon/blocks/0x%x", bc.endpoint, blockroot), &blockRsp)
+	if err != nil {
+		if err == errNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	executionRequests := blockRsp.Data.Message.Body.ExecutionRequests
+	if executionRequests == nil {
+		executionRequests = &ExecutionRequests{}
+	}
+	return executionRequests, nil
+}
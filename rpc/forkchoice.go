@@ -0,0 +1,79 @@
+package rpc
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// ForkChoice holds the fork choice store of a consensus client (/eth/v1/debug/fork_choice)
+type ForkChoice struct {
+	JustifiedCheckpoint ForkChoiceCheckpoint
+	FinalizedCheckpoint ForkChoiceCheckpoint
+	Nodes               []*ForkChoiceNode
+}
+
+type ForkChoiceCheckpoint struct {
+	Epoch uint64        `json:"epoch,string"`
+	Root  hexutil.Bytes `json:"root"`
+}
+
+// ForkChoiceNode is a single block of the fork choice store
+type ForkChoiceNode struct {
+	Slot               uint64
+	BlockRoot          []byte
+	ParentRoot         []byte
+	JustifiedEpoch     uint64
+	FinalizedEpoch     uint64
+	Weight             uint64
+	Validity           string
+	ExecutionBlockHash []byte
+}
+
+// GetForkChoice loads the fork choice store via the debug api.
+// The response is decoded leniently (instead of using the consensus client library), as clients differ in how they encode optional fields like the parent root of the anchor node.
+func (bc *BeaconClient) GetForkChoice() (*ForkChoice, error) {
+	var forkChoiceRsp struct {
+		JustifiedCheckpoint ForkChoiceCheckpoint `json:"justified_checkpoint"`
+		FinalizedCheckpoint ForkChoiceCheckpoint `json:"finalized_checkpoint"`
+		ForkChoiceNodes     []struct {
+			Slot               uint64         `json:"slot,string"`
+			BlockRoot          hexutil.Bytes  `json:"block_root"`
+			ParentRoot         *hexutil.Bytes `json:"parent_root"`
+			JustifiedEpoch     uint64         `json:"justified_epoch,string"`
+			FinalizedEpoch     uint64         `json:"finalized_epoch,string"`
+			Weight             uint64         `json:"weight,string"`
+			Validity           string         `json:"validity"`
+			ExecutionBlockHash *hexutil.Bytes `json:"execution_block_hash"`
+		} `json:"fork_choice_nodes"`
+	}
+
+	err := bc.getJson(fmt.Sprintf("%s/eth/v1/debug/fork_choice", bc.endpoint), &forkChoiceRsp)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving fork choice: %v", err)
+	}
+
+	forkChoice := &ForkChoice{
+		JustifiedCheckpoint: forkChoiceRsp.JustifiedCheckpoint,
+		FinalizedCheckpoint: forkChoiceRsp.FinalizedCheckpoint,
+		Nodes:               make([]*ForkChoiceNode, 0, len(forkChoiceRsp.ForkChoiceNodes)),
+	}
+	for _, rspNode := range forkChoiceRsp.ForkChoiceNodes {
+		node := &ForkChoiceNode{
+			Slot:           rspNode.Slot,
+			BlockRoot:      rspNode.BlockRoot,
+			JustifiedEpoch: rspNode.JustifiedEpoch,
+			FinalizedEpoch: rspNode.FinalizedEpoch,
+			Weight:         rspNode.Weight,
+			Validity:       rspNode.Validity,
+		}
+		if rspNode.ParentRoot != nil {
+			node.ParentRoot = *rspNode.ParentRoot
+		}
+		if rspNode.ExecutionBlockHash != nil {
+			node.ExecutionBlockHash = *rspNode.ExecutionBlockHash
+		}
+		forkChoice.Nodes = append(forkChoice.Nodes, node)
+	}
+	return forkChoice, nil
+}
@@ -0,0 +1,77 @@
+package rpc
+
+import (
+	"fmt"
+	"time"
+)
+
+// the rewards endpoints are called from the finalization logic, so a slow client must not stall it for long
+const (
+	attestationRewardsTimeout   = 30 * time.Second
+	syncCommitteeRewardsTimeout = 10 * time.Second
+)
+
+// AttestationRewards holds the attestation rewards of an epoch in gwei (/eth/v1/beacon/rewards/attestations)
+type AttestationRewards struct {
+	IdealRewards []*AttestationIdealReward `json:"ideal_rewards"`
+	TotalRewards []*AttestationTotalReward `json:"total_rewards"`
+}
+
+// AttestationIdealReward holds the maximum rewards a validator with the given effective balance could have earned
+type AttestationIdealReward struct {
+	EffectiveBalance uint64 `json:"effective_balance,string"`
+	Head             int64  `json:"head,string"`
+	Target           int64  `json:"target,string"`
+	Source           int64  `json:"source,string"`
+	InclusionDelay   int64  `json:"inclusion_delay,string"`
+	Inactivity       int64  `json:"inactivity,string"`
+}
+
+// AttestationTotalReward holds the actual rewards (positive) & penalties (negative) of a validator
+type AttestationTotalReward struct {
+	ValidatorIndex uint64 `json:"validator_index,string"`
+	Head           int64  `json:"head,string"`
+	Target         int64  `json:"target,string"`
+	Source         int64  `json:"source,string"`
+	InclusionDelay int64  `json:"inclusion_delay,string"`
+	Inactivity     int64  `json:"inactivity,string"`
+}
+
+// SyncCommitteeReward holds the sync committee reward (positive) or penalty (negative) of a validator for a single block
+type SyncCommitteeReward struct {
+	ValidatorIndex uint64 `json:"validator_index,string"`
+	Reward         int64  `json:"reward,string"`
+}
+
+// GetAttestationRewards loads the attestation rewards of all validators for an epoch.
+// The rewards are calculated from the state at the end of the following epoch, so this fails for old epochs on non-archive clients.
+func (bc *BeaconClient) GetAttestationRewards(epoch uint64) (*AttestationRewards, error) {
+	var rewardsRsp struct {
+		Data *AttestationRewards `json:"data"`
+	}
+
+	err := bc.postJson(fmt.Sprintf("%s/eth/v1/beacon/rewards/attestations/%v", bc.endpoint, epoch), []string{}, &rewardsRsp, attestationRewardsTimeout)
+	if err != nil {
+		if err == errNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return rewardsRsp.Data, nil
+}
+
+// GetSyncCommitteeRewardsByBlockroot loads the sync committee rewards of all sync committee members for a block.
+func (bc *BeaconClient) GetSyncCommitteeRewardsByBlockroot(blockroot []byte) ([]*SyncCommitteeReward, error) {
+	var rewardsRsp struct {
+		Data []*SyncCommitteeReward `json:"data"`
+	}
+
+	err := bc.postJson(fmt.Sprintf("%s/eth/v1/beacon/rewards/sync_committee/0x%x", bc.endpoint, blockroot), []string{}, &rewardsRsp, syncCommitteeRewardsTimeout)
+	if err != nil {
+		if err == errNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return rewardsRsp.Data, nil
+}
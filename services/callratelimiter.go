@@ -1,6 +1,7 @@
 package services
 
 import (
+	"errors"
 	"fmt"
 	"net"
 	"net/http"
@@ -27,6 +28,8 @@ type callRateVisitor struct {
 
 var GlobalCallRateLimiter *CallRateLimiter
 
+var ErrCallRateLimitExceeded = errors.New("call rate limit exceeded")
+
 // StartFrontendCache is used to start the global frontend cache service
 func StartCallRateLimiter(proxyCount uint, rateLimit uint, burstLimit uint) error {
 	if GlobalCallRateLimiter != nil {
@@ -54,7 +57,7 @@ func (crl *CallRateLimiter) CheckCallLimit(r *http.Request, callCost uint) error
 		return fmt.Errorf("could not get visitor")
 	}
 	if !visitor.limiter.AllowN(time.Now(), int(callCost)) {
-		return fmt.Errorf("call rate limit exceeded")
+		return ErrCallRateLimitExceeded
 	}
 	return nil
 }
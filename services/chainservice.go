@@ -28,8 +28,14 @@ type ChainService struct {
 		activity   map[uint64]uint8
 	}
 
+	validatorQueuesMutex sync.Mutex
+	validatorQueuesCache *ValidatorQueues
+
 	assignmentsCacheMux sync.Mutex
 	assignmentsCache    *lru.Cache[uint64, *rpc.EpochAssignments]
+
+	syncCommitteeCacheMux sync.Mutex
+	syncCommitteeCache    *lru.Cache[uint64, []uint64]
 }
 
 var GlobalBeaconService *ChainService
@@ -81,9 +87,10 @@ func StartChainService() error {
 	mevIndexer.StartUpdater(indexer)
 
 	GlobalBeaconService = &ChainService{
-		indexer:          indexer,
-		validatorNames:   validatorNames,
-		assignmentsCache: lru.NewCache[uint64, *rpc.EpochAssignments](10),
+		indexer:            indexer,
+		validatorNames:     validatorNames,
+		assignmentsCache:   lru.NewCache[uint64, *rpc.EpochAssignments](10),
+		syncCommitteeCache: lru.NewCache[uint64, []uint64](5),
 	}
 	return nil
 }
@@ -0,0 +1,183 @@
+package services
+
+import (
+	"bytes"
+	"math"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+
+	"github.com/ethpandaops/dora/db"
+	"github.com/ethpandaops/dora/indexer"
+	"github.com/ethpandaops/dora/utils"
+)
+
+type ValidatorBalancePoint struct {
+	Epoch            uint64
+	Balance          uint64
+	EffectiveBalance uint64
+}
+
+type ValidatorIncome struct {
+	StartEpoch   uint64
+	EndEpoch     uint64
+	StartBalance uint64
+	EndBalance   uint64
+	Withdrawn    uint64
+	Deposited    uint64
+	Rewards      uint64
+	Penalties    uint64
+}
+
+// GetValidatorBalanceHistory returns the persisted balance snapshots of a validator since minEpoch (ascending).
+// The most recent balance from the cached validator set is appended as last point.
+func (bs *ChainService) GetValidatorBalanceHistory(validator uint64, minEpoch uint64) []*ValidatorBalancePoint {
+	history := make([]*ValidatorBalancePoint, 0)
+
+	if utils.Config.Indexer.BalanceSnapshotInterval > 0 {
+		balances, err := db.GetValidatorBalances(validator, minEpoch, math.MaxInt64)
+		if err == nil {
+			for _, balance := range balances {
+				if len(balance.Balance) == 0 {
+					continue
+				}
+				history = append(history, &ValidatorBalancePoint{
+					Epoch:            balance.Epoch,
+					Balance:          balance.GetBalance(),
+					EffectiveBalance: balance.GetEffectiveBalance(),
+				})
+			}
+		}
+	}
+
+	validatorSetEpoch := bs.indexer.GetCachedValidatorSetEpoch()
+	if validatorSetEpoch >= 0 && uint64(validatorSetEpoch) >= minEpoch && (len(history) == 0 || history[len(history)-1].Epoch < uint64(validatorSetEpoch)) {
+		if validatorData := bs.indexer.GetCachedValidatorSet()[phase0.ValidatorIndex(validator)]; validatorData != nil {
+			history = append(history, &ValidatorBalancePoint{
+				Epoch:            uint64(validatorSetEpoch),
+				Balance:          uint64(validatorData.Balance),
+				EffectiveBalance: uint64(validatorData.Validator.EffectiveBalance),
+			})
+		}
+	}
+
+	return history
+}
+
+// GetValidatorIncome calculates the consensus layer income of a validator from the balance history points since startEpoch.
+// Balance changes between two points are corrected by withdrawals & deposits in that range and counted as rewards (positive) or penalties (negative).
+// Accuracy depends on the snapshot interval, as rewards and penalties within one interval are netted.
+func (bs *ChainService) GetValidatorIncome(validator uint64, pubkey []byte, history []*ValidatorBalancePoint, startEpoch uint64) *ValidatorIncome {
+	points := make([]*ValidatorBalancePoint, 0, len(history))
+	for _, point := range history {
+		if point.Epoch >= startEpoch {
+			points = append(points, point)
+		}
+	}
+	if len(points) < 2 {
+		return nil
+	}
+
+	income := &ValidatorIncome{
+		StartEpoch:   points[0].Epoch,
+		EndEpoch:     points[len(points)-1].Epoch,
+		StartBalance: points[0].Balance,
+		EndBalance:   points[len(points)-1].Balance,
+	}
+	startSlot := income.StartEpoch * utils.Config.Chain.Config.SlotsPerEpoch
+	endSlot := income.EndEpoch * utils.Config.Chain.Config.SlotsPerEpoch
+
+	// balance snapshots reflect the state at the start of the epoch, so attribute each withdrawal/deposit to the first point after its epoch
+	withdrawalSums, depositSums := bs.getValidatorBalanceCorrections(validator, pubkey, startSlot, endSlot-1)
+
+	pointCorrections := make([]int64, len(points))
+	getPointIndex := func(epoch uint64) int {
+		for idx, point := range points {
+			if point.Epoch > epoch {
+				return idx
+			}
+		}
+		return -1
+	}
+	for epoch, amount := range withdrawalSums {
+		if idx := getPointIndex(epoch); idx > 0 {
+			pointCorrections[idx] += int64(amount)
+			income.Withdrawn += amount
+		}
+	}
+	for epoch, amount := range depositSums {
+		if idx := getPointIndex(epoch); idx > 0 {
+			pointCorrections[idx] -= int64(amount)
+			income.Deposited += amount
+		}
+	}
+
+	for idx := 1; idx < len(points); idx++ {
+		change := int64(points[idx].Balance) - int64(points[idx-1].Balance) + pointCorrections[idx]
+		if change > 0 {
+			income.Rewards += uint64(change)
+		} else {
+			income.Penalties += uint64(-change)
+		}
+	}
+
+	return income
+}
+
+// getValidatorBalanceCorrections sums up the withdrawals & deposits of a validator in the given slot range by epoch.
+// Finalized withdrawals & deposits are aggregated in the db, unfinalized ones are taken from the indexer cache
+// (unfinalized objects are stored as orphaned in the db until their block gets finalized).
+func (bs *ChainService) getValidatorBalanceCorrections(validator uint64, pubkey []byte, minSlot uint64, maxSlot uint64) (map[uint64]uint64, map[uint64]uint64) {
+	withdrawalSums := map[uint64]uint64{}
+	depositSums := map[uint64]uint64{}
+
+	// finalized epochs are written to the db as canonical once processed, until then their blocks are still in the cache
+	_, finalizedEpoch, _, processedEpoch := bs.indexer.GetCacheState()
+	if processedEpoch < finalizedEpoch {
+		finalizedEpoch = processedEpoch
+	}
+	cacheMinSlot := uint64(0)
+	if finalizedEpoch >= 0 {
+		cacheMinSlot = uint64(finalizedEpoch+1) * utils.Config.Chain.Config.SlotsPerEpoch
+	}
+
+	if minSlot < cacheMinSlot {
+		dbMaxSlot := maxSlot
+		if dbMaxSlot >= cacheMinSlot {
+			dbMaxSlot = cacheMinSlot - 1
+		}
+
+		withdrawals, _ := db.GetValidatorWithdrawalSums(validator, minSlot, dbMaxSlot)
+		for _, sum := range withdrawals {
+			withdrawalSums[sum.Epoch] += sum.Amount
+		}
+		deposits, _ := db.GetValidatorDepositSums(pubkey, minSlot, dbMaxSlot)
+		for _, sum := range deposits {
+			depositSums[sum.Epoch] += sum.Amount
+		}
+	}
+
+	slot := minSlot
+	if slot < cacheMinSlot {
+		slot = cacheMinSlot
+	}
+	for ; slot <= maxSlot; slot++ {
+		epoch := utils.EpochOfSlot(slot)
+		for _, block := range bs.indexer.GetCachedBlocks(slot) {
+			if !block.IsCanonical(bs.indexer, nil) {
+				continue
+			}
+			for _, withdrawal := range indexer.BuildDbWithdrawals(block, nil) {
+				if withdrawal.ValidatorIndex == validator {
+					withdrawalSums[epoch] += withdrawal.Amount
+				}
+			}
+			for _, deposit := range indexer.BuildDbDeposits(block, nil) {
+				if bytes.Equal(deposit.PublicKey, pubkey) {
+					depositSums[epoch] += deposit.Amount
+				}
+			}
+		}
+	}
+
+	return withdrawalSums, depositSums
+}
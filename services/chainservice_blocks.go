@@ -1,6 +1,7 @@
 package services
 
 import (
+	"bytes"
 	"math"
 	"sort"
 	"strings"
@@ -192,6 +193,36 @@ func (bs *ChainService) GetOrphanedBlock(blockroot []byte) *CombinedBlockRespons
 	}
 }
 
+type BlockRewardsResponse struct {
+	ClReward             *uint64
+	ClRewardAttestations *uint64
+	ClRewardSync         *uint64
+	ClRewardSlashings    *uint64
+	ElPriorityFees       *uint64
+}
+
+// GetBlockRewards returns the proposer rewards of a block from the cache or the db (fields are nil if not available)
+func (bs *ChainService) GetBlockRewards(blockroot []byte) *BlockRewardsResponse {
+	result := &BlockRewardsResponse{}
+	if blockInfo := bs.indexer.GetCachedBlock(blockroot); blockInfo != nil {
+		if rewards := blockInfo.GetRewards(); rewards != nil {
+			slashingRewards := rewards.ProposerSlashings + rewards.AttesterSlashings
+			result.ClReward = &rewards.Total
+			result.ClRewardAttestations = &rewards.Attestations
+			result.ClRewardSync = &rewards.SyncAggregate
+			result.ClRewardSlashings = &slashingRewards
+		}
+		result.ElPriorityFees = blockInfo.GetPriorityFees()
+	} else if dbBlock := db.GetSlotByRoot(blockroot); dbBlock != nil {
+		result.ClReward = dbBlock.ClReward
+		result.ClRewardAttestations = dbBlock.ClRewardAttestations
+		result.ClRewardSync = dbBlock.ClRewardSync
+		result.ClRewardSlashings = dbBlock.ClRewardSlashings
+		result.ElPriorityFees = dbBlock.ElPriorityFees
+	}
+	return result
+}
+
 func (bs *ChainService) GetDbBlocks(firstSlot uint64, limit int32, withMissing bool, withOrphaned bool) []*dbtypes.Slot {
 	resBlocks := make([]*dbtypes.Slot, limit)
 	resIdx := 0
@@ -488,6 +519,12 @@ func (bs *ChainService) GetDbBlocksByFilter(filter *dbtypes.BlockFilter, pageIdx
 						continue
 					}
 				}
+				if len(filter.FeeRecipient) > 0 {
+					feeRecipient, _ := indexer.GetExecutionFeeRecipient(block.GetBlockBody())
+					if !bytes.Equal(feeRecipient, filter.FeeRecipient) {
+						continue
+					}
+				}
 				proposer := uint64(block.GetHeader().Message.ProposerIndex)
 				if filter.ProposerIndex != nil {
 					if proposer != *filter.ProposerIndex {
@@ -510,7 +547,7 @@ func (bs *ChainService) GetDbBlocksByFilter(filter *dbtypes.BlockFilter, pageIdx
 		}
 	}
 
-	if filter.WithMissing != 0 && filter.Graffiti == "" && filter.ExtraData == "" && filter.WithOrphaned != 2 {
+	if filter.WithMissing != 0 && filter.Graffiti == "" && filter.ExtraData == "" && len(filter.FeeRecipient) == 0 && filter.WithOrphaned != 2 {
 		// add missed blocks
 		idxHeadSlot := bs.indexer.GetHighestSlot()
 		idxHeadEpoch := utils.EpochOfSlot(idxHeadSlot)
@@ -157,3 +157,42 @@ func (bs *ChainService) GetDbEpochs(firstEpoch uint64, limit uint32) []*dbtypes.
 
 	return resEpochs
 }
+
+// GetValidatorAttestations returns the attestation outcomes of a validator for the most recent epochs (descending).
+// Epochs that are still in the indexer cache are built from the cached blocks, older epochs are loaded from the db.
+func (bs *ChainService) GetValidatorAttestations(validator uint64, limit uint32) []*dbtypes.ValidatorAttestation {
+	idxHeadSlot, _, persistedEpoch, _ := bs.indexer.GetCacheState()
+	if idxHeadSlot < 0 {
+		return []*dbtypes.ValidatorAttestation{}
+	}
+	headEpoch := int64(utils.EpochOfSlot(uint64(idxHeadSlot)))
+
+	attestations := make([]*dbtypes.ValidatorAttestation, 0, limit)
+	for epoch := headEpoch; epoch > persistedEpoch && epoch >= 0 && len(attestations) < int(limit); epoch-- {
+		outcomes := bs.indexer.GetEpochAttestationOutcomes(uint64(epoch))
+		if outcomes == nil {
+			continue
+		}
+		attestation := &dbtypes.ValidatorAttestation{
+			Epoch: uint64(epoch),
+		}
+		if validator < uint64(len(outcomes)) {
+			attestation.Outcome = []byte{outcomes[validator]}
+		}
+		attestations = append(attestations, attestation)
+	}
+
+	if len(attestations) < int(limit) && persistedEpoch >= 0 && !utils.Config.Indexer.DisableAttestationHistory {
+		minEpoch := uint64(0)
+		remaining := uint64(int(limit) - len(attestations))
+		if uint64(persistedEpoch)+1 > remaining {
+			minEpoch = uint64(persistedEpoch) + 1 - remaining
+		}
+		dbAttestations, err := db.GetValidatorAttestationOutcomes(validator, minEpoch, uint64(persistedEpoch), uint32(remaining))
+		if err == nil {
+			attestations = append(attestations, dbAttestations...)
+		}
+	}
+
+	return attestations
+}
@@ -0,0 +1,143 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"sort"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/sirupsen/logrus"
+
+	"github.com/ethpandaops/dora/utils"
+)
+
+// number of candidates per voting period to resolve the voted execution block for
+const eth1VoteResolveLimit = 10
+
+type Eth1VoteCandidate struct {
+	DepositRoot  []byte
+	DepositCount uint64
+	BlockHash    []byte
+	BlockNumber  *uint64
+	BlockTime    *time.Time
+	Votes        uint64
+	FirstSlot    uint64
+	LastSlot     uint64
+	MajoritySlot *uint64
+}
+
+// Eth1VotingPeriod holds the eth1 data votes of the canonical blocks in an eth1 voting period.
+// A candidate becomes the eth1 data of the beacon state as soon as more than half of the period slots voted for it.
+type Eth1VotingPeriod struct {
+	Period        uint64
+	FirstSlot     uint64
+	LastSlot      uint64
+	PeriodSlots   uint64
+	BlockCount    uint64
+	UnknownVotes  uint64
+	Candidates    []*Eth1VoteCandidate
+	Majority      *Eth1VoteCandidate
+	StartEth1Data *phase0.ETH1Data
+}
+
+// GetEth1VotingPeriod aggregates the eth1 data votes of the canonical blocks in the given voting period.
+func (bs *ChainService) GetEth1VotingPeriod(period uint64) *Eth1VotingPeriod {
+	chainConfig := utils.Config.Chain.Config
+	periodSlots := chainConfig.EpochsPerEth1VotingPeriod * chainConfig.SlotsPerEpoch
+	if periodSlots == 0 {
+		return nil
+	}
+	votingPeriod := &Eth1VotingPeriod{
+		Period:      period,
+		FirstSlot:   period * periodSlots,
+		LastSlot:    (period+1)*periodSlots - 1,
+		PeriodSlots: periodSlots,
+		Candidates:  []*Eth1VoteCandidate{},
+	}
+
+	if epochStats := bs.indexer.GetCachedEpochStats(utils.EpochOfSlot(votingPeriod.FirstSlot)); epochStats != nil {
+		votingPeriod.StartEth1Data = epochStats.GetEth1Data()
+	}
+
+	blocks := bs.GetDbBlocksForSlots(votingPeriod.LastSlot, uint32(periodSlots-1), false, false)
+	sort.Slice(blocks, func(a, b int) bool {
+		return blocks[a].Slot < blocks[b].Slot
+	})
+
+	for _, block := range blocks {
+		if block.Slot < votingPeriod.FirstSlot || block.Slot > votingPeriod.LastSlot {
+			continue
+		}
+		votingPeriod.BlockCount++
+		if block.Eth1DataCount == nil {
+			// block has been indexed before eth1 data votes were tracked
+			votingPeriod.UnknownVotes++
+			continue
+		}
+
+		var candidate *Eth1VoteCandidate
+		for _, c := range votingPeriod.Candidates {
+			if c.DepositCount == *block.Eth1DataCount && bytes.Equal(c.DepositRoot, block.Eth1DataRoot) && bytes.Equal(c.BlockHash, block.Eth1DataBlockHash) {
+				candidate = c
+				break
+			}
+		}
+		if candidate == nil {
+			candidate = &Eth1VoteCandidate{
+				DepositRoot:  block.Eth1DataRoot,
+				DepositCount: *block.Eth1DataCount,
+				BlockHash:    block.Eth1DataBlockHash,
+				FirstSlot:    block.Slot,
+			}
+			votingPeriod.Candidates = append(votingPeriod.Candidates, candidate)
+		}
+		candidate.Votes++
+		candidate.LastSlot = block.Slot
+		if votingPeriod.Majority == nil && candidate.Votes*2 > periodSlots {
+			majoritySlot := block.Slot
+			candidate.MajoritySlot = &majoritySlot
+			votingPeriod.Majority = candidate
+		}
+	}
+
+	// leading candidate first, ties are won by the earlier vote
+	sort.SliceStable(votingPeriod.Candidates, func(a, b int) bool {
+		candidateA := votingPeriod.Candidates[a]
+		candidateB := votingPeriod.Candidates[b]
+		if candidateA.Votes != candidateB.Votes {
+			return candidateA.Votes > candidateB.Votes
+		}
+		return candidateA.FirstSlot < candidateB.FirstSlot
+	})
+
+	bs.resolveEth1VoteBlocks(votingPeriod.Candidates)
+	return votingPeriod
+}
+
+// resolveEth1VoteBlocks loads the number & time of the voted execution blocks from any ready execution client
+func (bs *ChainService) resolveEth1VoteBlocks(candidates []*Eth1VoteCandidate) {
+	executionClient := bs.indexer.GetReadyElClient(false, nil, nil)
+	if executionClient == nil {
+		return
+	}
+	ethClient := executionClient.GetRpcClient().GetEthClient()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	for idx, candidate := range candidates {
+		if idx >= eth1VoteResolveLimit {
+			break
+		}
+		header, err := ethClient.HeaderByHash(ctx, common.BytesToHash(candidate.BlockHash))
+		if err != nil {
+			logrus.Debugf("error loading eth1 vote block 0x%x from %v: %v", candidate.BlockHash, executionClient.GetName(), err)
+			continue
+		}
+		blockNumber := header.Number.Uint64()
+		blockTime := time.Unix(int64(header.Time), 0)
+		candidate.BlockNumber = &blockNumber
+		candidate.BlockTime = &blockTime
+	}
+}
@@ -254,6 +254,254 @@ func (bs *ChainService) GetVoluntaryExitsByFilter(filter *dbtypes.VoluntaryExitF
 	return resObjs, cachedMatchesLen + dbCount
 }
 
+func (bs *ChainService) GetWithdrawalsByFilter(filter *dbtypes.WithdrawalFilter, pageIdx uint64, pageSize uint32) ([]*dbtypes.Withdrawal, uint64) {
+	idxHeadSlot, finalizedEpoch, persistedEpoch, _ := bs.indexer.GetCacheState()
+	finalizedBlock := uint64(0)
+	if finalizedEpoch > 0 {
+		finalizedBlock = (uint64(finalizedEpoch)+1)*utils.Config.Chain.Config.SlotsPerEpoch - 1
+	}
+
+	// load most recent objects from indexer cache
+	idxMinSlot := (persistedEpoch + 1) * int64(utils.Config.Chain.Config.SlotsPerEpoch)
+	cachedMatches := make([]*dbtypes.Withdrawal, 0)
+	validatorSet := bs.indexer.GetCachedValidatorSet()
+	for slotIdx := int64(idxHeadSlot); slotIdx >= int64(idxMinSlot); slotIdx-- {
+		slot := uint64(slotIdx)
+		blocks := bs.indexer.GetCachedBlocks(slot)
+		if blocks != nil {
+			for bidx := 0; bidx < len(blocks); bidx++ {
+				block := blocks[bidx]
+				if filter.WithOrphaned != 1 {
+					isOrphaned := !block.IsCanonical(bs.indexer, nil)
+					if filter.WithOrphaned == 0 && isOrphaned {
+						continue
+					}
+					if filter.WithOrphaned == 2 && !isOrphaned {
+						continue
+					}
+				}
+				if filter.MinSlot > 0 && slot < filter.MinSlot {
+					continue
+				}
+				if filter.MaxSlot > 0 && slot > filter.MaxSlot {
+					continue
+				}
+
+				withdrawals := indexer.BuildDbWithdrawals(block, validatorSet)
+				for idx, withdrawal := range withdrawals {
+					if filter.MinIndex > 0 && withdrawal.ValidatorIndex < filter.MinIndex {
+						continue
+					}
+					if filter.MaxIndex > 0 && withdrawal.ValidatorIndex > filter.MaxIndex {
+						continue
+					}
+					if filter.ValidatorName != "" {
+						validatorName := bs.validatorNames.GetValidatorName(withdrawal.ValidatorIndex)
+						if !strings.Contains(validatorName, filter.ValidatorName) {
+							continue
+						}
+					}
+
+					if filter.ValidatorIndex != nil && withdrawal.ValidatorIndex != *filter.ValidatorIndex {
+						continue
+					}
+					if len(filter.Address) > 0 && !bytes.Equal(withdrawal.Address, filter.Address) {
+						continue
+					}
+					if filter.MinAmount > 0 && withdrawal.Amount < filter.MinAmount*utils.GWEI.Uint64() {
+						continue
+					}
+					if filter.MaxAmount > 0 && withdrawal.Amount > filter.MaxAmount*utils.GWEI.Uint64() {
+						continue
+					}
+					if filter.WithType > 0 && withdrawal.Type != filter.WithType {
+						continue
+					}
+
+					cachedMatches = append(cachedMatches, withdrawals[idx])
+				}
+			}
+		}
+	}
+
+	cachedMatchesLen := uint64(len(cachedMatches))
+	cachedPages := cachedMatchesLen / uint64(pageSize)
+	resObjs := make([]*dbtypes.Withdrawal, 0)
+	resIdx := 0
+
+	cachedStart := pageIdx * uint64(pageSize)
+	cachedEnd := cachedStart + uint64(pageSize)
+
+	if cachedPages > 0 && pageIdx < cachedPages {
+		resObjs = append(resObjs, cachedMatches[cachedStart:cachedEnd]...)
+		resIdx += int(cachedEnd - cachedStart)
+	} else if pageIdx == cachedPages {
+		resObjs = append(resObjs, cachedMatches[cachedStart:]...)
+		resIdx += len(cachedMatches) - int(cachedStart)
+	}
+
+	// load older objects from db
+	dbPage := pageIdx - cachedPages
+	dbCacheOffset := uint64(pageSize) - (cachedMatchesLen % uint64(pageSize))
+
+	var dbObjects []*dbtypes.Withdrawal
+	var dbCount uint64
+	var err error
+
+	if resIdx > int(pageSize) {
+		// all results from cache, just get result count from db
+		_, dbCount, err = db.GetWithdrawalsFiltered(0, 1, finalizedBlock, filter)
+	} else if dbPage == 0 {
+		// first page, load first `pagesize-cachedResults` items from db
+		dbObjects, dbCount, err = db.GetWithdrawalsFiltered(0, uint32(dbCacheOffset), finalizedBlock, filter)
+	} else {
+		dbObjects, dbCount, err = db.GetWithdrawalsFiltered((dbPage-1)*uint64(pageSize)+dbCacheOffset, pageSize, finalizedBlock, filter)
+	}
+
+	if err != nil {
+		logrus.Warnf("ChainService.GetWithdrawalsByFilter error: %v", err)
+	} else {
+		for idx, dbObject := range dbObjects {
+			if dbObject.SlotNumber > finalizedBlock {
+				blockStatus := bs.CheckBlockOrphanedStatus(dbObject.SlotRoot)
+				dbObjects[idx].Orphaned = blockStatus == dbtypes.Orphaned
+			}
+
+			if filter.WithOrphaned != 1 {
+				if filter.WithOrphaned == 0 && dbObjects[idx].Orphaned {
+					continue
+				}
+				if filter.WithOrphaned == 2 && !dbObjects[idx].Orphaned {
+					continue
+				}
+			}
+
+			resObjs = append(resObjs, dbObjects[idx])
+		}
+	}
+
+	return resObjs, cachedMatchesLen + dbCount
+}
+
+func (bs *ChainService) GetBLSChangesByFilter(filter *dbtypes.BLSChangeFilter, pageIdx uint64, pageSize uint32) ([]*dbtypes.BLSChange, uint64) {
+	idxHeadSlot, finalizedEpoch, persistedEpoch, _ := bs.indexer.GetCacheState()
+	finalizedBlock := uint64(0)
+	if finalizedEpoch > 0 {
+		finalizedBlock = (uint64(finalizedEpoch)+1)*utils.Config.Chain.Config.SlotsPerEpoch - 1
+	}
+
+	// load most recent objects from indexer cache
+	idxMinSlot := (persistedEpoch + 1) * int64(utils.Config.Chain.Config.SlotsPerEpoch)
+	cachedMatches := make([]*dbtypes.BLSChange, 0)
+	for slotIdx := int64(idxHeadSlot); slotIdx >= int64(idxMinSlot); slotIdx-- {
+		slot := uint64(slotIdx)
+		blocks := bs.indexer.GetCachedBlocks(slot)
+		if blocks != nil {
+			for bidx := 0; bidx < len(blocks); bidx++ {
+				block := blocks[bidx]
+				if filter.WithOrphaned != 1 {
+					isOrphaned := !block.IsCanonical(bs.indexer, nil)
+					if filter.WithOrphaned == 0 && isOrphaned {
+						continue
+					}
+					if filter.WithOrphaned == 2 && !isOrphaned {
+						continue
+					}
+				}
+				if filter.MinSlot > 0 && slot < filter.MinSlot {
+					continue
+				}
+				if filter.MaxSlot > 0 && slot > filter.MaxSlot {
+					continue
+				}
+
+				blsChanges := indexer.BuildDbBLSChanges(block)
+				for idx, blsChange := range blsChanges {
+					if filter.MinIndex > 0 && blsChange.ValidatorIndex < filter.MinIndex {
+						continue
+					}
+					if filter.MaxIndex > 0 && blsChange.ValidatorIndex > filter.MaxIndex {
+						continue
+					}
+					if filter.ValidatorName != "" {
+						validatorName := bs.validatorNames.GetValidatorName(blsChange.ValidatorIndex)
+						if !strings.Contains(validatorName, filter.ValidatorName) {
+							continue
+						}
+					}
+
+					if filter.ValidatorIndex != nil && blsChange.ValidatorIndex != *filter.ValidatorIndex {
+						continue
+					}
+					if len(filter.Address) > 0 && !bytes.Equal(blsChange.Address, filter.Address) {
+						continue
+					}
+
+					cachedMatches = append(cachedMatches, blsChanges[idx])
+				}
+			}
+		}
+	}
+
+	cachedMatchesLen := uint64(len(cachedMatches))
+	cachedPages := cachedMatchesLen / uint64(pageSize)
+	resObjs := make([]*dbtypes.BLSChange, 0)
+	resIdx := 0
+
+	cachedStart := pageIdx * uint64(pageSize)
+	cachedEnd := cachedStart + uint64(pageSize)
+
+	if cachedPages > 0 && pageIdx < cachedPages {
+		resObjs = append(resObjs, cachedMatches[cachedStart:cachedEnd]...)
+		resIdx += int(cachedEnd - cachedStart)
+	} else if pageIdx == cachedPages {
+		resObjs = append(resObjs, cachedMatches[cachedStart:]...)
+		resIdx += len(cachedMatches) - int(cachedStart)
+	}
+
+	// load older objects from db
+	dbPage := pageIdx - cachedPages
+	dbCacheOffset := uint64(pageSize) - (cachedMatchesLen % uint64(pageSize))
+
+	var dbObjects []*dbtypes.BLSChange
+	var dbCount uint64
+	var err error
+
+	if resIdx > int(pageSize) {
+		// all results from cache, just get result count from db
+		_, dbCount, err = db.GetBLSChangesFiltered(0, 1, finalizedBlock, filter)
+	} else if dbPage == 0 {
+		// first page, load first `pagesize-cachedResults` items from db
+		dbObjects, dbCount, err = db.GetBLSChangesFiltered(0, uint32(dbCacheOffset), finalizedBlock, filter)
+	} else {
+		dbObjects, dbCount, err = db.GetBLSChangesFiltered((dbPage-1)*uint64(pageSize)+dbCacheOffset, pageSize, finalizedBlock, filter)
+	}
+
+	if err != nil {
+		logrus.Warnf("ChainService.GetBLSChangesByFilter error: %v", err)
+	} else {
+		for idx, dbObject := range dbObjects {
+			if dbObject.SlotNumber > finalizedBlock {
+				blockStatus := bs.CheckBlockOrphanedStatus(dbObject.SlotRoot)
+				dbObjects[idx].Orphaned = blockStatus == dbtypes.Orphaned
+			}
+
+			if filter.WithOrphaned != 1 {
+				if filter.WithOrphaned == 0 && dbObjects[idx].Orphaned {
+					continue
+				}
+				if filter.WithOrphaned == 2 && !dbObjects[idx].Orphaned {
+					continue
+				}
+			}
+
+			resObjs = append(resObjs, dbObjects[idx])
+		}
+	}
+
+	return resObjs, cachedMatchesLen + dbCount
+}
+
 func (bs *ChainService) GetSlashingsByFilter(filter *dbtypes.SlashingFilter, pageIdx uint64, pageSize uint32) ([]*dbtypes.Slashing, uint64) {
 	idxHeadSlot, finalizedEpoch, persistedEpoch, _ := bs.indexer.GetCacheState()
 	finalizedBlock := uint64(0)
@@ -371,3 +619,330 @@ func (bs *ChainService) GetSlashingsByFilter(filter *dbtypes.SlashingFilter, pag
 
 	return resObjs, cachedMatchesLen + dbCount
 }
+
+func (bs *ChainService) GetDepositRequestsByFilter(filter *dbtypes.DepositRequestFilter, pageIdx uint64, pageSize uint32) ([]*dbtypes.DepositRequest, uint64) {
+	idxHeadSlot, finalizedEpoch, persistedEpoch, _ := bs.indexer.GetCacheState()
+	finalizedBlock := uint64(0)
+	if finalizedEpoch > 0 {
+		finalizedBlock = (uint64(finalizedEpoch)+1)*utils.Config.Chain.Config.SlotsPerEpoch - 1
+	}
+
+	// load most recent objects from indexer cache
+	idxMinSlot := (persistedEpoch + 1) * int64(utils.Config.Chain.Config.SlotsPerEpoch)
+	cachedMatches := make([]*dbtypes.DepositRequest, 0)
+	for slotIdx := int64(idxHeadSlot); slotIdx >= int64(idxMinSlot); slotIdx-- {
+		slot := uint64(slotIdx)
+		blocks := bs.indexer.GetCachedBlocks(slot)
+		if blocks != nil {
+			for bidx := 0; bidx < len(blocks); bidx++ {
+				block := blocks[bidx]
+				if filter.WithOrphaned != 1 {
+					isOrphaned := !block.IsCanonical(bs.indexer, nil)
+					if filter.WithOrphaned == 0 && isOrphaned {
+						continue
+					}
+					if filter.WithOrphaned == 2 && !isOrphaned {
+						continue
+					}
+				}
+				if filter.MinSlot > 0 && slot < filter.MinSlot {
+					continue
+				}
+				if filter.MaxSlot > 0 && slot > filter.MaxSlot {
+					continue
+				}
+
+				depositRequests, _, _ := indexer.BuildDbExecutionRequests(block)
+				for idx, depositRequest := range depositRequests {
+					if len(filter.PublicKey) > 0 && !bytes.Equal(depositRequest.PublicKey, filter.PublicKey) {
+						continue
+					}
+					if filter.MinAmount > 0 && depositRequest.Amount < filter.MinAmount*utils.GWEI.Uint64() {
+						continue
+					}
+					if filter.MaxAmount > 0 && depositRequest.Amount > filter.MaxAmount*utils.GWEI.Uint64() {
+						continue
+					}
+
+					cachedMatches = append(cachedMatches, depositRequests[idx])
+				}
+			}
+		}
+	}
+
+	cachedMatchesLen := uint64(len(cachedMatches))
+	cachedPages := cachedMatchesLen / uint64(pageSize)
+	resObjs := make([]*dbtypes.DepositRequest, 0)
+	resIdx := 0
+
+	cachedStart := pageIdx * uint64(pageSize)
+	cachedEnd := cachedStart + uint64(pageSize)
+
+	if cachedPages > 0 && pageIdx < cachedPages {
+		resObjs = append(resObjs, cachedMatches[cachedStart:cachedEnd]...)
+		resIdx += int(cachedEnd - cachedStart)
+	} else if pageIdx == cachedPages {
+		resObjs = append(resObjs, cachedMatches[cachedStart:]...)
+		resIdx += len(cachedMatches) - int(cachedStart)
+	}
+
+	// load older objects from db
+	dbPage := pageIdx - cachedPages
+	dbCacheOffset := uint64(pageSize) - (cachedMatchesLen % uint64(pageSize))
+
+	var dbObjects []*dbtypes.DepositRequest
+	var dbCount uint64
+	var err error
+
+	if resIdx > int(pageSize) {
+		// all results from cache, just get result count from db
+		_, dbCount, err = db.GetDepositRequestsFiltered(0, 1, finalizedBlock, filter)
+	} else if dbPage == 0 {
+		// first page, load first `pagesize-cachedResults` items from db
+		dbObjects, dbCount, err = db.GetDepositRequestsFiltered(0, uint32(dbCacheOffset), finalizedBlock, filter)
+	} else {
+		dbObjects, dbCount, err = db.GetDepositRequestsFiltered((dbPage-1)*uint64(pageSize)+dbCacheOffset, pageSize, finalizedBlock, filter)
+	}
+
+	if err != nil {
+		logrus.Warnf("ChainService.GetDepositRequestsByFilter error: %v", err)
+	} else {
+		for idx, dbObject := range dbObjects {
+			if dbObject.SlotNumber > finalizedBlock {
+				blockStatus := bs.CheckBlockOrphanedStatus(dbObject.SlotRoot)
+				dbObjects[idx].Orphaned = blockStatus == dbtypes.Orphaned
+			}
+
+			if filter.WithOrphaned != 1 {
+				if filter.WithOrphaned == 0 && dbObjects[idx].Orphaned {
+					continue
+				}
+				if filter.WithOrphaned == 2 && !dbObjects[idx].Orphaned {
+					continue
+				}
+			}
+
+			resObjs = append(resObjs, dbObjects[idx])
+		}
+	}
+
+	return resObjs, cachedMatchesLen + dbCount
+}
+
+func (bs *ChainService) GetWithdrawalRequestsByFilter(filter *dbtypes.WithdrawalRequestFilter, pageIdx uint64, pageSize uint32) ([]*dbtypes.WithdrawalRequest, uint64) {
+	idxHeadSlot, finalizedEpoch, persistedEpoch, _ := bs.indexer.GetCacheState()
+	finalizedBlock := uint64(0)
+	if finalizedEpoch > 0 {
+		finalizedBlock = (uint64(finalizedEpoch)+1)*utils.Config.Chain.Config.SlotsPerEpoch - 1
+	}
+
+	// load most recent objects from indexer cache
+	idxMinSlot := (persistedEpoch + 1) * int64(utils.Config.Chain.Config.SlotsPerEpoch)
+	cachedMatches := make([]*dbtypes.WithdrawalRequest, 0)
+	for slotIdx := int64(idxHeadSlot); slotIdx >= int64(idxMinSlot); slotIdx-- {
+		slot := uint64(slotIdx)
+		blocks := bs.indexer.GetCachedBlocks(slot)
+		if blocks != nil {
+			for bidx := 0; bidx < len(blocks); bidx++ {
+				block := blocks[bidx]
+				if filter.WithOrphaned != 1 {
+					isOrphaned := !block.IsCanonical(bs.indexer, nil)
+					if filter.WithOrphaned == 0 && isOrphaned {
+						continue
+					}
+					if filter.WithOrphaned == 2 && !isOrphaned {
+						continue
+					}
+				}
+				if filter.MinSlot > 0 && slot < filter.MinSlot {
+					continue
+				}
+				if filter.MaxSlot > 0 && slot > filter.MaxSlot {
+					continue
+				}
+
+				_, withdrawalRequests, _ := indexer.BuildDbExecutionRequests(block)
+				for idx, withdrawalRequest := range withdrawalRequests {
+					if len(filter.SourceAddress) > 0 && !bytes.Equal(withdrawalRequest.SourceAddress, filter.SourceAddress) {
+						continue
+					}
+					if len(filter.PublicKey) > 0 && !bytes.Equal(withdrawalRequest.ValidatorPubkey, filter.PublicKey) {
+						continue
+					}
+					if filter.MinAmount > 0 && withdrawalRequest.Amount < filter.MinAmount*utils.GWEI.Uint64() {
+						continue
+					}
+					if filter.MaxAmount > 0 && withdrawalRequest.Amount > filter.MaxAmount*utils.GWEI.Uint64() {
+						continue
+					}
+
+					cachedMatches = append(cachedMatches, withdrawalRequests[idx])
+				}
+			}
+		}
+	}
+
+	cachedMatchesLen := uint64(len(cachedMatches))
+	cachedPages := cachedMatchesLen / uint64(pageSize)
+	resObjs := make([]*dbtypes.WithdrawalRequest, 0)
+	resIdx := 0
+
+	cachedStart := pageIdx * uint64(pageSize)
+	cachedEnd := cachedStart + uint64(pageSize)
+
+	if cachedPages > 0 && pageIdx < cachedPages {
+		resObjs = append(resObjs, cachedMatches[cachedStart:cachedEnd]...)
+		resIdx += int(cachedEnd - cachedStart)
+	} else if pageIdx == cachedPages {
+		resObjs = append(resObjs, cachedMatches[cachedStart:]...)
+		resIdx += len(cachedMatches) - int(cachedStart)
+	}
+
+	// load older objects from db
+	dbPage := pageIdx - cachedPages
+	dbCacheOffset := uint64(pageSize) - (cachedMatchesLen % uint64(pageSize))
+
+	var dbObjects []*dbtypes.WithdrawalRequest
+	var dbCount uint64
+	var err error
+
+	if resIdx > int(pageSize) {
+		// all results from cache, just get result count from db
+		_, dbCount, err = db.GetWithdrawalRequestsFiltered(0, 1, finalizedBlock, filter)
+	} else if dbPage == 0 {
+		// first page, load first `pagesize-cachedResults` items from db
+		dbObjects, dbCount, err = db.GetWithdrawalRequestsFiltered(0, uint32(dbCacheOffset), finalizedBlock, filter)
+	} else {
+		dbObjects, dbCount, err = db.GetWithdrawalRequestsFiltered((dbPage-1)*uint64(pageSize)+dbCacheOffset, pageSize, finalizedBlock, filter)
+	}
+
+	if err != nil {
+		logrus.Warnf("ChainService.GetWithdrawalRequestsByFilter error: %v", err)
+	} else {
+		for idx, dbObject := range dbObjects {
+			if dbObject.SlotNumber > finalizedBlock {
+				blockStatus := bs.CheckBlockOrphanedStatus(dbObject.SlotRoot)
+				dbObjects[idx].Orphaned = blockStatus == dbtypes.Orphaned
+			}
+
+			if filter.WithOrphaned != 1 {
+				if filter.WithOrphaned == 0 && dbObjects[idx].Orphaned {
+					continue
+				}
+				if filter.WithOrphaned == 2 && !dbObjects[idx].Orphaned {
+					continue
+				}
+			}
+
+			resObjs = append(resObjs, dbObjects[idx])
+		}
+	}
+
+	return resObjs, cachedMatchesLen + dbCount
+}
+
+func (bs *ChainService) GetConsolidationRequestsByFilter(filter *dbtypes.ConsolidationRequestFilter, pageIdx uint64, pageSize uint32) ([]*dbtypes.ConsolidationRequest, uint64) {
+	idxHeadSlot, finalizedEpoch, persistedEpoch, _ := bs.indexer.GetCacheState()
+	finalizedBlock := uint64(0)
+	if finalizedEpoch > 0 {
+		finalizedBlock = (uint64(finalizedEpoch)+1)*utils.Config.Chain.Config.SlotsPerEpoch - 1
+	}
+
+	// load most recent objects from indexer cache
+	idxMinSlot := (persistedEpoch + 1) * int64(utils.Config.Chain.Config.SlotsPerEpoch)
+	cachedMatches := make([]*dbtypes.ConsolidationRequest, 0)
+	for slotIdx := int64(idxHeadSlot); slotIdx >= int64(idxMinSlot); slotIdx-- {
+		slot := uint64(slotIdx)
+		blocks := bs.indexer.GetCachedBlocks(slot)
+		if blocks != nil {
+			for bidx := 0; bidx < len(blocks); bidx++ {
+				block := blocks[bidx]
+				if filter.WithOrphaned != 1 {
+					isOrphaned := !block.IsCanonical(bs.indexer, nil)
+					if filter.WithOrphaned == 0 && isOrphaned {
+						continue
+					}
+					if filter.WithOrphaned == 2 && !isOrphaned {
+						continue
+					}
+				}
+				if filter.MinSlot > 0 && slot < filter.MinSlot {
+					continue
+				}
+				if filter.MaxSlot > 0 && slot > filter.MaxSlot {
+					continue
+				}
+
+				_, _, consolidationRequests := indexer.BuildDbExecutionRequests(block)
+				for idx, consolidationRequest := range consolidationRequests {
+					if len(filter.SourceAddress) > 0 && !bytes.Equal(consolidationRequest.SourceAddress, filter.SourceAddress) {
+						continue
+					}
+					if len(filter.PublicKey) > 0 && !bytes.Equal(consolidationRequest.SourcePubkey, filter.PublicKey) && !bytes.Equal(consolidationRequest.TargetPubkey, filter.PublicKey) {
+						continue
+					}
+
+					cachedMatches = append(cachedMatches, consolidationRequests[idx])
+				}
+			}
+		}
+	}
+
+	cachedMatchesLen := uint64(len(cachedMatches))
+	cachedPages := cachedMatchesLen / uint64(pageSize)
+	resObjs := make([]*dbtypes.ConsolidationRequest, 0)
+	resIdx := 0
+
+	cachedStart := pageIdx * uint64(pageSize)
+	cachedEnd := cachedStart + uint64(pageSize)
+
+	if cachedPages > 0 && pageIdx < cachedPages {
+		resObjs = append(resObjs, cachedMatches[cachedStart:cachedEnd]...)
+		resIdx += int(cachedEnd - cachedStart)
+	} else if pageIdx == cachedPages {
+		resObjs = append(resObjs, cachedMatches[cachedStart:]...)
+		resIdx += len(cachedMatches) - int(cachedStart)
+	}
+
+	// load older objects from db
+	dbPage := pageIdx - cachedPages
+	dbCacheOffset := uint64(pageSize) - (cachedMatchesLen % uint64(pageSize))
+
+	var dbObjects []*dbtypes.ConsolidationRequest
+	var dbCount uint64
+	var err error
+
+	if resIdx > int(pageSize) {
+		// all results from cache, just get result count from db
+		_, dbCount, err = db.GetConsolidationRequestsFiltered(0, 1, finalizedBlock, filter)
+	} else if dbPage == 0 {
+		// first page, load first `pagesize-cachedResults` items from db
+		dbObjects, dbCount, err = db.GetConsolidationRequestsFiltered(0, uint32(dbCacheOffset), finalizedBlock, filter)
+	} else {
+		dbObjects, dbCount, err = db.GetConsolidationRequestsFiltered((dbPage-1)*uint64(pageSize)+dbCacheOffset, pageSize, finalizedBlock, filter)
+	}
+
+	if err != nil {
+		logrus.Warnf("ChainService.GetConsolidationRequestsByFilter error: %v", err)
+	} else {
+		for idx, dbObject := range dbObjects {
+			if dbObject.SlotNumber > finalizedBlock {
+				blockStatus := bs.CheckBlockOrphanedStatus(dbObject.SlotRoot)
+				dbObjects[idx].Orphaned = blockStatus == dbtypes.Orphaned
+			}
+
+			if filter.WithOrphaned != 1 {
+				if filter.WithOrphaned == 0 && dbObjects[idx].Orphaned {
+					continue
+				}
+				if filter.WithOrphaned == 2 && !dbObjects[idx].Orphaned {
+					continue
+				}
+			}
+
+			resObjs = append(resObjs, dbObjects[idx])
+		}
+	}
+
+	return resObjs, cachedMatchesLen + dbCount
+}
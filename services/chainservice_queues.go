@@ -0,0 +1,202 @@
+package services
+
+import (
+	"sort"
+
+	v1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+
+	"github.com/ethpandaops/dora/utils"
+)
+
+type ValidatorQueueEntry struct {
+	Validator      *v1.Validator
+	Position       uint64
+	EstimatedEpoch uint64
+	Scheduled      bool
+}
+
+// ValidatorQueues holds the activation & exit queue of the cached validator set.
+// Before electra the churn is counted in validators per epoch, since electra it's the amount of gwei per epoch (BalanceChurn).
+type ValidatorQueues struct {
+	Epoch              uint64
+	ActiveCount        uint64
+	TotalActiveBalance uint64
+	BalanceChurn       bool
+	ActivationChurn    uint64
+	ExitChurn          uint64
+	PendingCount       uint64
+	ActivationQueue    []*ValidatorQueueEntry
+	ActivationAmount   uint64
+	ExitQueue          []*ValidatorQueueEntry
+	ExitAmount         uint64
+	NextExitEpoch      uint64
+}
+
+// GetValidatorQueues returns the activation & exit queue with estimated epochs, cached per validator set epoch.
+func (bs *ChainService) GetValidatorQueues() *ValidatorQueues {
+	validatorSetEpoch := bs.indexer.GetCachedValidatorSetEpoch()
+	if validatorSetEpoch < 0 {
+		return nil
+	}
+
+	bs.validatorQueuesMutex.Lock()
+	defer bs.validatorQueuesMutex.Unlock()
+	if bs.validatorQueuesCache != nil && bs.validatorQueuesCache.Epoch == uint64(validatorSetEpoch) {
+		return bs.validatorQueuesCache
+	}
+
+	validatorSet := bs.indexer.GetCachedValidatorSet()
+	if validatorSet == nil {
+		return nil
+	}
+	queues := buildValidatorQueues(uint64(validatorSetEpoch), validatorSet)
+	bs.validatorQueuesCache = queues
+	return queues
+}
+
+// GetValidatorQueueEntry returns the queue entry of a validator and whether it's in the activation queue (nil if not queued).
+func (queues *ValidatorQueues) GetValidatorQueueEntry(index phase0.ValidatorIndex) (*ValidatorQueueEntry, bool) {
+	for _, entry := range queues.ActivationQueue {
+		if entry.Validator.Index == index {
+			return entry, true
+		}
+	}
+	for _, entry := range queues.ExitQueue {
+		if entry.Validator.Index == index {
+			return entry, false
+		}
+	}
+	return nil, false
+}
+
+func buildValidatorQueues(epoch uint64, validatorSet map[phase0.ValidatorIndex]*v1.Validator) *ValidatorQueues {
+	chainConfig := utils.Config.Chain.Config
+	queues := &ValidatorQueues{
+		Epoch:           epoch,
+		BalanceChurn:    utils.IsElectraEpoch(epoch),
+		ActivationQueue: []*ValidatorQueueEntry{},
+		ExitQueue:       []*ValidatorQueueEntry{},
+	}
+
+	for _, validator := range validatorSet {
+		validatorData := validator.Validator
+		if validatorData.ActivationEpoch <= phase0.Epoch(epoch) && phase0.Epoch(epoch) < validatorData.ExitEpoch {
+			queues.ActiveCount++
+			queues.TotalActiveBalance += uint64(validatorData.EffectiveBalance)
+		}
+		if validatorData.ActivationEpoch == utils.FarFutureEpoch {
+			if validatorData.ActivationEligibilityEpoch == utils.FarFutureEpoch {
+				// not eligible yet (effective balance too low or deposit not processed by an epoch transition)
+				queues.PendingCount++
+				continue
+			}
+			queues.ActivationQueue = append(queues.ActivationQueue, &ValidatorQueueEntry{
+				Validator: validator,
+			})
+			queues.ActivationAmount += uint64(validatorData.EffectiveBalance)
+		} else if validatorData.ActivationEpoch > phase0.Epoch(epoch) {
+			queues.ActivationQueue = append(queues.ActivationQueue, &ValidatorQueueEntry{
+				Validator:      validator,
+				EstimatedEpoch: uint64(validatorData.ActivationEpoch),
+				Scheduled:      true,
+			})
+			queues.ActivationAmount += uint64(validatorData.EffectiveBalance)
+		} else if validatorData.ExitEpoch != utils.FarFutureEpoch && validatorData.ExitEpoch > phase0.Epoch(epoch) {
+			queues.ExitQueue = append(queues.ExitQueue, &ValidatorQueueEntry{
+				Validator:      validator,
+				EstimatedEpoch: uint64(validatorData.ExitEpoch),
+				Scheduled:      true,
+			})
+			queues.ExitAmount += uint64(validatorData.EffectiveBalance)
+		}
+	}
+
+	if queues.BalanceChurn {
+		queues.ActivationChurn = utils.GetActivationExitChurnLimit(queues.TotalActiveBalance)
+		queues.ExitChurn = queues.ActivationChurn
+	} else {
+		queues.ActivationChurn = utils.GetActivationChurnLimit(epoch, queues.ActiveCount)
+		queues.ExitChurn = utils.GetValidatorChurnLimit(queues.ActiveCount)
+	}
+
+	// activation queue: scheduled validators first, then the dequeue order of the spec (eligibility epoch, index)
+	sort.Slice(queues.ActivationQueue, func(a, b int) bool {
+		entryA := queues.ActivationQueue[a]
+		entryB := queues.ActivationQueue[b]
+		if entryA.Scheduled != entryB.Scheduled {
+			return entryA.Scheduled
+		}
+		if entryA.Scheduled && entryA.EstimatedEpoch != entryB.EstimatedEpoch {
+			return entryA.EstimatedEpoch < entryB.EstimatedEpoch
+		}
+		if entryA.Validator.Validator.ActivationEligibilityEpoch != entryB.Validator.Validator.ActivationEligibilityEpoch {
+			return entryA.Validator.Validator.ActivationEligibilityEpoch < entryB.Validator.Validator.ActivationEligibilityEpoch
+		}
+		return entryA.Validator.Index < entryB.Validator.Index
+	})
+
+	// the next registry update happens with the transition to epoch+1 and schedules the activation after the seed lookahead.
+	// validators can only be dequeued when their eligibility epoch is finalized (assumed to be 2 epochs behind).
+	unscheduledPos := uint64(0)
+	for idx, entry := range queues.ActivationQueue {
+		entry.Position = uint64(idx) + 1
+		if entry.Scheduled {
+			continue
+		}
+
+		dequeueEpoch := epoch + 1
+		if !queues.BalanceChurn && queues.ActivationChurn > 0 {
+			// pre-electra the number of activations per epoch is limited by the churn.
+			// since electra all eligible validators get activated, the churn applies to pending deposits instead (not covered here).
+			dequeueEpoch += unscheduledPos / queues.ActivationChurn
+		}
+		if eligibleEpoch := uint64(entry.Validator.Validator.ActivationEligibilityEpoch) + 2; dequeueEpoch < eligibleEpoch {
+			dequeueEpoch = eligibleEpoch
+		}
+		entry.EstimatedEpoch = dequeueEpoch + 1 + chainConfig.MaxSeedLookahead
+		unscheduledPos++
+	}
+
+	// exit queue: sorted by exit epoch (the exit epochs are assigned when initiating the exit)
+	sort.Slice(queues.ExitQueue, func(a, b int) bool {
+		entryA := queues.ExitQueue[a]
+		entryB := queues.ExitQueue[b]
+		if entryA.EstimatedEpoch != entryB.EstimatedEpoch {
+			return entryA.EstimatedEpoch < entryB.EstimatedEpoch
+		}
+		return entryA.Validator.Index < entryB.Validator.Index
+	})
+	for idx, entry := range queues.ExitQueue {
+		entry.Position = uint64(idx) + 1
+	}
+
+	// estimate the exit epoch of an exit that gets initiated now
+	nextExitEpoch := epoch + 1 + chainConfig.MaxSeedLookahead
+	lastExitChurn := uint64(0)
+	if exitCount := len(queues.ExitQueue); exitCount > 0 && queues.ExitQueue[exitCount-1].EstimatedEpoch >= nextExitEpoch {
+		nextExitEpoch = queues.ExitQueue[exitCount-1].EstimatedEpoch
+		for idx := exitCount - 1; idx >= 0 && queues.ExitQueue[idx].EstimatedEpoch == nextExitEpoch; idx-- {
+			if queues.BalanceChurn {
+				lastExitChurn += uint64(queues.ExitQueue[idx].Validator.Validator.EffectiveBalance)
+			} else {
+				lastExitChurn++
+			}
+		}
+	}
+	if queues.BalanceChurn {
+		// the exit epoch is assigned by the consumed balance churn, assume a validator with the minimum activation balance
+		exitBalance := chainConfig.MinActivationBalance
+		if exitBalance == 0 {
+			exitBalance = chainConfig.MaxEffectiveBalance
+		}
+		if queues.ExitChurn > 0 && lastExitChurn+exitBalance > queues.ExitChurn {
+			nextExitEpoch += (lastExitChurn + exitBalance - 1) / queues.ExitChurn
+		}
+	} else if lastExitChurn >= queues.ExitChurn {
+		nextExitEpoch++
+	}
+	queues.NextExitEpoch = nextExitEpoch
+
+	return queues
+}
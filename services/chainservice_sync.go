@@ -0,0 +1,123 @@
+package services
+
+import (
+	"github.com/ethpandaops/dora/db"
+	"github.com/ethpandaops/dora/indexer"
+	"github.com/ethpandaops/dora/utils"
+)
+
+type SyncCommitteeMemberStats struct {
+	Position  uint64
+	Validator uint64
+	Signed    uint64
+	Missed    uint64
+}
+
+// GetSyncCommitteeMembers returns the sync committee members for a period (ordered by committee position).
+// Members are loaded from the db, the indexer cache or the beacon node (current / next period only).
+func (bs *ChainService) GetSyncCommitteeMembers(period uint64) []uint64 {
+	if period*utils.Config.Chain.Config.EpochsPerSyncCommitteePeriod < utils.Config.Chain.Config.AltairForkEpoch {
+		return nil
+	}
+
+	members := db.GetSyncAssignmentsForPeriod(period)
+	if len(members) > 0 {
+		return members
+	}
+
+	idxHeadSlot, _, _, _ := bs.indexer.GetCacheState()
+	if idxHeadSlot >= 0 {
+		headEpoch := utils.EpochOfSlot(uint64(idxHeadSlot))
+		if headEpoch/utils.Config.Chain.Config.EpochsPerSyncCommitteePeriod == period {
+			if epochStats := bs.indexer.GetCachedEpochStats(headEpoch); epochStats != nil {
+				if members = epochStats.TryGetSyncAssignments(); len(members) > 0 {
+					return members
+				}
+			}
+		}
+	}
+
+	bs.syncCommitteeCacheMux.Lock()
+	members, found := bs.syncCommitteeCache.Get(period)
+	bs.syncCommitteeCacheMux.Unlock()
+	if found {
+		return members
+	}
+
+	syncCommittee, err := bs.indexer.GetConsensusRpc(false, nil).GetSyncCommitteeDuties("head", period*utils.Config.Chain.Config.EpochsPerSyncCommitteePeriod)
+	if err != nil || syncCommittee == nil {
+		return nil
+	}
+	members = make([]uint64, len(syncCommittee.Validators))
+	for idx, validator := range syncCommittee.Validators {
+		members[idx] = uint64(validator)
+	}
+
+	bs.syncCommitteeCacheMux.Lock()
+	bs.syncCommitteeCache.Add(period, members)
+	bs.syncCommitteeCacheMux.Unlock()
+
+	return members
+}
+
+// GetSyncParticipations returns the sync committee bits of all canonical blocks in the given slot range (by slot number).
+func (bs *ChainService) GetSyncParticipations(minSlot uint64, maxSlot uint64) map[uint64][]byte {
+	idxHeadSlot, _, persistedEpoch, _ := bs.indexer.GetCacheState()
+	participations := make(map[uint64][]byte)
+
+	// load most recent bits from indexer cache
+	idxMinSlot := uint64((persistedEpoch + 1) * int64(utils.Config.Chain.Config.SlotsPerEpoch))
+	if idxMinSlot < minSlot {
+		idxMinSlot = minSlot
+	}
+	for slot := idxMinSlot; int64(slot) <= idxHeadSlot && slot <= maxSlot; slot++ {
+		for _, block := range bs.indexer.GetCachedBlocks(slot) {
+			if !block.IsCanonical(bs.indexer, nil) {
+				continue
+			}
+			if syncParticipation := indexer.BuildDbSyncParticipation(block); syncParticipation != nil {
+				participations[slot] = syncParticipation.Bits
+			}
+		}
+	}
+
+	// load older bits from db
+	if persistedEpoch >= 0 && minSlot < idxMinSlot {
+		dbMaxSlot := idxMinSlot - 1
+		if dbMaxSlot > maxSlot {
+			dbMaxSlot = maxSlot
+		}
+		for _, syncParticipation := range db.GetSyncParticipations(minSlot, dbMaxSlot) {
+			participations[syncParticipation.SlotNumber] = syncParticipation.Bits
+		}
+	}
+
+	return participations
+}
+
+// GetSyncCommitteeMemberStats aggregates the signed / missed sync committee signatures for each committee position in the given slot range.
+// Slots without canonical block are not counted, as there was no block to include the signatures.
+func (bs *ChainService) GetSyncCommitteeMemberStats(members []uint64, minSlot uint64, maxSlot uint64) []*SyncCommitteeMemberStats {
+	stats := make([]*SyncCommitteeMemberStats, len(members))
+	for idx, validator := range members {
+		stats[idx] = &SyncCommitteeMemberStats{
+			Position:  uint64(idx),
+			Validator: validator,
+		}
+	}
+
+	for _, bits := range bs.GetSyncParticipations(minSlot, maxSlot) {
+		for idx := range stats {
+			if idx/8 >= len(bits) {
+				break
+			}
+			if utils.BitAtVector(bits, idx) {
+				stats[idx].Signed++
+			} else {
+				stats[idx].Missed++
+			}
+		}
+	}
+
+	return stats
+}
@@ -0,0 +1,131 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/sirupsen/logrus"
+
+	"github.com/ethpandaops/dora/db"
+	"github.com/ethpandaops/dora/dbtypes"
+	"github.com/ethpandaops/dora/indexer"
+)
+
+// TransactionDetails holds an execution layer transaction with the beacon block that included it.
+// Transactions that are not in the transaction index are loaded from the execution clients.
+type TransactionDetails struct {
+	Transaction *dbtypes.ElTransaction
+	Data        []byte
+	IsIndexed   bool
+	IsPending   bool
+	HasBlock    bool
+	Slot        *uint64
+	SlotRoot    []byte
+	Orphaned    bool
+}
+
+// GetTransactionDetails returns the transaction with the given hash (nil if not found)
+func (bs *ChainService) GetTransactionDetails(txHash []byte) *TransactionDetails {
+	details := &TransactionDetails{}
+
+	if dbTx := db.GetElTransactionByHash(txHash); dbTx != nil {
+		details.Transaction = dbTx
+		details.IsIndexed = true
+		details.HasBlock = true
+	} else if !bs.loadTransactionDetails(txHash, details) {
+		return nil
+	}
+
+	if details.HasBlock {
+		bs.resolveTransactionSlot(details)
+	}
+	return details
+}
+
+func (bs *ChainService) loadTransactionDetails(txHash []byte, details *TransactionDetails) bool {
+	client := bs.indexer.GetReadyElClient(false, nil, nil)
+	if client == nil {
+		return false
+	}
+	rpcClient := client.GetRpcClient()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	tx, isPending, err := rpcClient.GetTransactionByHash(ctx, common.BytesToHash(txHash))
+	if err != nil {
+		if !errors.Is(err, ethereum.NotFound) {
+			logrus.Warnf("error loading transaction 0x%x from execution client %v: %v", txHash, client.GetName(), err)
+		}
+		return false
+	}
+
+	var receipt *ethtypes.Receipt
+	if !isPending {
+		receipt, err = rpcClient.GetTransactionReceipt(ctx, tx.Hash())
+		if err != nil {
+			logrus.Warnf("error loading receipt of transaction 0x%x from execution client %v: %v", txHash, client.GetName(), err)
+		}
+	}
+
+	transaction, err := indexer.BuildElTransaction(tx, receipt)
+	if err != nil {
+		logrus.Warnf("error decoding transaction 0x%x: %v", txHash, err)
+		return false
+	}
+	details.Transaction = transaction
+	details.Data = tx.Data()
+	details.IsPending = isPending
+
+	if receipt != nil {
+		details.HasBlock = true
+		transaction.BlockNumber = receipt.BlockNumber.Uint64()
+		transaction.BlockHash = receipt.BlockHash.Bytes()
+		transaction.TxIndex = uint64(receipt.TransactionIndex)
+
+		header, err := rpcClient.GetEthClient().HeaderByHash(ctx, receipt.BlockHash)
+		if err == nil {
+			transaction.BlockTime = header.Time
+		}
+	}
+
+	return true
+}
+
+// resolveTransactionSlot looks up the beacon block that included the execution block of the transaction (canonical blocks preferred)
+func (bs *ChainService) resolveTransactionSlot(details *TransactionDetails) {
+	blockHash := details.Transaction.BlockHash
+
+	for _, block := range bs.indexer.GetCachedBlocksByExecutionBlockHash(blockHash) {
+		isCanonical := block.IsCanonical(bs.indexer, nil)
+		if details.Slot == nil || isCanonical {
+			slot := block.Slot
+			details.Slot = &slot
+			details.SlotRoot = block.Root
+			details.Orphaned = !isCanonical
+		}
+		if isCanonical {
+			return
+		}
+	}
+	if details.Slot != nil {
+		return
+	}
+
+	for _, dbSlot := range db.GetSlotsByBlockHash(blockHash) {
+		isCanonical := dbSlot.Status == dbtypes.Canonical
+		if details.Slot == nil || isCanonical {
+			slot := dbSlot.Slot
+			details.Slot = &slot
+			details.SlotRoot = dbSlot.Root
+			details.Orphaned = !isCanonical
+		}
+		if isCanonical {
+			return
+		}
+	}
+}
@@ -0,0 +1,231 @@
+package services
+
+import (
+	"encoding/json"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/ethpandaops/dora/indexer"
+	"github.com/ethpandaops/dora/types/apitypes"
+	"github.com/ethpandaops/dora/utils"
+)
+
+const (
+	EventStreamTopicBlock     = "block"
+	EventStreamTopicHead      = "head"
+	EventStreamTopicReorg     = "reorg"
+	EventStreamTopicFinalized = "finalized"
+	EventStreamTopicEpoch     = "epoch"
+)
+
+// EventStreamTopics lists all topics that can be subscribed on the event stream
+var EventStreamTopics = []string{EventStreamTopicBlock, EventStreamTopicHead, EventStreamTopicReorg, EventStreamTopicFinalized, EventStreamTopicEpoch}
+
+const eventStreamHistorySize = 256
+
+// EventStreamService re-broadcasts indexer events to the connected event stream clients (sse)
+type EventStreamService struct {
+	subscription *indexer.IndexerEventSubscription
+	mutex        sync.RWMutex
+	clients      map[*EventStreamClient]bool
+	lastEventId  uint64
+	history      []*EventStreamEvent
+	headEpoch    uint64
+}
+
+// EventStreamEvent is a single serialized event of the event stream
+type EventStreamEvent struct {
+	Id    uint64
+	Topic string
+	Data  []byte
+}
+
+type EventStreamClient struct {
+	topics  map[string]bool
+	channel chan *EventStreamEvent
+}
+
+var GlobalEventStreamService *EventStreamService
+var logger_es = logrus.StandardLogger().WithField("module", "eventstream")
+
+// StartEventStreamService is used to start the global event stream service
+func StartEventStreamService() error {
+	if GlobalEventStreamService != nil {
+		return nil
+	}
+
+	GlobalEventStreamService = &EventStreamService{
+		subscription: GlobalBeaconService.GetIndexer().SubscribeEvents(100),
+		clients:      map[*EventStreamClient]bool{},
+		history:      make([]*EventStreamEvent, 0, eventStreamHistorySize),
+	}
+
+	go GlobalEventStreamService.runEventLoop()
+	return nil
+}
+
+// Subscribe registers a new event stream client for the given topics.
+// If lastEventId is set, all still buffered events after that id are returned for replay.
+func (ess *EventStreamService) Subscribe(topics []string, lastEventId uint64) (*EventStreamClient, []*EventStreamEvent) {
+	client := &EventStreamClient{
+		topics:  map[string]bool{},
+		channel: make(chan *EventStreamEvent, 32),
+	}
+	for _, topic := range topics {
+		client.topics[topic] = true
+	}
+
+	ess.mutex.Lock()
+	defer ess.mutex.Unlock()
+
+	replay := []*EventStreamEvent{}
+	if lastEventId > 0 {
+		for _, event := range ess.history {
+			if event.Id > lastEventId && client.topics[event.Topic] {
+				replay = append(replay, event)
+			}
+		}
+	}
+
+	ess.clients[client] = true
+	return client, replay
+}
+
+func (ess *EventStreamService) Unsubscribe(client *EventStreamClient) {
+	ess.mutex.Lock()
+	defer ess.mutex.Unlock()
+	delete(ess.clients, client)
+}
+
+func (client *EventStreamClient) Channel() <-chan *EventStreamEvent {
+	return client.channel
+}
+
+func (ess *EventStreamService) runEventLoop() {
+	defer utils.HandleSubroutinePanic("EventStreamService.runEventLoop")
+
+	for event := range ess.subscription.Channel() {
+		switch event.Type {
+		case indexer.BlockEventType:
+			ess.processBlockEvent(event)
+		case indexer.HeadEventType:
+			ess.processHeadEvent(event)
+		case indexer.ReorgEventType:
+			ess.broadcast(EventStreamTopicReorg, &apitypes.EventReorg{
+				Slot:        event.Block.Slot,
+				Epoch:       utils.EpochOfSlot(event.Block.Slot),
+				BlockRoot:   event.Block.Root,
+				OldHeadSlot: event.OldHeadSlot,
+				OldHeadRoot: event.OldHeadRoot,
+				Depth:       event.ReorgDepth,
+			})
+		case indexer.FinalizedCheckpointEventType:
+			ess.broadcast(EventStreamTopicFinalized, &apitypes.EventFinalizedCheckpoint{
+				Epoch:          event.Epoch,
+				Root:           event.FinalizedRoot,
+				JustifiedEpoch: event.JustifiedEpoch,
+				JustifiedRoot:  event.JustifiedRoot,
+			})
+		}
+	}
+}
+
+func (ess *EventStreamService) processBlockEvent(event *indexer.IndexerEvent) {
+	blockEvent := &apitypes.EventBlock{
+		Slot:       event.Block.Slot,
+		Epoch:      utils.EpochOfSlot(event.Block.Slot),
+		Time:       utils.SlotToTime(event.Block.Slot),
+		BlockRoot:  event.Block.Root,
+		ParentRoot: event.Block.GetParentRoot(),
+	}
+	if header := event.Block.GetHeader(); header != nil {
+		blockEvent.Proposer = uint64(header.Message.ProposerIndex)
+		blockEvent.ProposerName = GlobalBeaconService.GetValidatorName(blockEvent.Proposer)
+	}
+	ess.broadcast(EventStreamTopicBlock, blockEvent)
+}
+
+func (ess *EventStreamService) processHeadEvent(event *indexer.IndexerEvent) {
+	headEpoch := utils.EpochOfSlot(event.Block.Slot)
+	ess.broadcast(EventStreamTopicHead, &apitypes.EventHead{
+		Slot:      event.Block.Slot,
+		Epoch:     headEpoch,
+		Time:      utils.SlotToTime(event.Block.Slot),
+		BlockRoot: event.Block.Root,
+	})
+
+	if headEpoch <= ess.headEpoch {
+		return
+	}
+	isFirstHead := ess.headEpoch == 0
+	ess.headEpoch = headEpoch
+	if isFirstHead || headEpoch == 0 {
+		return
+	}
+
+	// head moved into a new epoch, send summary of the previous epoch
+	summaryEpoch := headEpoch - 1
+	dbEpoch := GlobalBeaconService.GetIndexer().BuildLiveEpoch(summaryEpoch)
+	if dbEpoch == nil {
+		return
+	}
+
+	epochSummary := &apitypes.EventEpochSummary{
+		Epoch:                 summaryEpoch,
+		Time:                  utils.EpochToTime(summaryEpoch),
+		CanonicalBlockCount:   uint64(dbEpoch.BlockCount),
+		OrphanedBlockCount:    uint64(dbEpoch.OrphanedCount),
+		AttestationCount:      dbEpoch.AttestationCount,
+		DepositCount:          dbEpoch.DepositCount,
+		ExitCount:             dbEpoch.ExitCount,
+		ProposerSlashingCount: dbEpoch.ProposerSlashingCount,
+		AttesterSlashingCount: dbEpoch.AttesterSlashingCount,
+		EligibleEther:         dbEpoch.Eligible,
+		TargetVoted:           dbEpoch.VotedTarget,
+		HeadVoted:             dbEpoch.VotedHead,
+		TotalVoted:            dbEpoch.VotedTotal,
+		EthTransactionCount:   dbEpoch.EthTransactionCount,
+	}
+	if dbEpoch.Eligible > 0 {
+		epochSummary.TargetVoteParticipation = float64(dbEpoch.VotedTarget) * 100.0 / float64(dbEpoch.Eligible)
+		epochSummary.HeadVoteParticipation = float64(dbEpoch.VotedHead) * 100.0 / float64(dbEpoch.Eligible)
+		epochSummary.TotalVoteParticipation = float64(dbEpoch.VotedTotal) * 100.0 / float64(dbEpoch.Eligible)
+	}
+	ess.broadcast(EventStreamTopicEpoch, epochSummary)
+}
+
+func (ess *EventStreamService) broadcast(topic string, data interface{}) {
+	dataBytes, err := json.Marshal(data)
+	if err != nil {
+		logger_es.Errorf("error serializing %v event: %v", topic, err)
+		return
+	}
+
+	ess.mutex.Lock()
+	defer ess.mutex.Unlock()
+
+	ess.lastEventId++
+	event := &EventStreamEvent{
+		Id:    ess.lastEventId,
+		Topic: topic,
+		Data:  dataBytes,
+	}
+
+	if len(ess.history) >= eventStreamHistorySize {
+		ess.history = append(ess.history[:0], ess.history[1:]...)
+	}
+	ess.history = append(ess.history, event)
+
+	for client := range ess.clients {
+		if !client.topics[topic] {
+			continue
+		}
+		select {
+		case client.channel <- event:
+		default:
+			// slow client, it will catch up via replay after reconnecting
+			logger_es.Debugf("event stream client buffer full, dropping %v event", topic)
+		}
+	}
+}
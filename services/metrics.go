@@ -0,0 +1,257 @@
+package services
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/sirupsen/logrus"
+
+	"github.com/ethpandaops/dora/utils"
+)
+
+var logger_metrics = logrus.StandardLogger().WithField("module", "metrics")
+
+var (
+	metricsHttpRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "dora",
+		Name:      "http_request_duration_seconds",
+		Help:      "Duration of http requests handled by the frontend, by route.",
+		Buckets:   []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30},
+	}, []string{"route", "method", "code"})
+
+	metricsMevRelayFetches = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "dora",
+		Name:      "mev_relay_fetches_total",
+		Help:      "Number of mev block fetches from relays, by result.",
+	}, []string{"relay", "result"})
+
+	metricsMevRelayLastSuccess = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "dora",
+		Name:      "mev_relay_last_success_timestamp_seconds",
+		Help:      "Unix timestamp of the last successful mev block fetch from a relay.",
+	}, []string{"relay"})
+)
+
+var metricsRegistered bool
+
+// StartMetricsService registers the dora metrics and starts a dedicated metrics http server if a metrics port is configured.
+// Without a dedicated port, the metrics are served at /metrics by the frontend (see MetricsHandler).
+func StartMetricsService() error {
+	if metricsRegistered || !utils.Config.Metrics.Enabled {
+		return nil
+	}
+
+	err := prometheus.Register(&indexerMetricsCollector{})
+	if err != nil {
+		return err
+	}
+	prometheus.MustRegister(metricsHttpRequestDuration, metricsMevRelayFetches, metricsMevRelayLastSuccess)
+	metricsRegistered = true
+
+	if utils.Config.Metrics.Port != "" {
+		go func() {
+			defer utils.HandleSubroutinePanic("metrics server")
+
+			mux := http.NewServeMux()
+			mux.Handle("/metrics", promhttp.Handler())
+			srv := &http.Server{
+				Addr:         utils.Config.Metrics.Host + ":" + utils.Config.Metrics.Port,
+				Handler:      mux,
+				ReadTimeout:  15 * time.Second,
+				WriteTimeout: 15 * time.Second,
+			}
+			logger_metrics.Infof("metrics server listening on %v", srv.Addr)
+			if err := srv.ListenAndServe(); err != nil {
+				logger_metrics.Errorf("error serving metrics: %v", err)
+			}
+		}()
+	}
+
+	return nil
+}
+
+// MetricsHandler returns the prometheus http handler, or nil if the metrics should not be served by the frontend
+func MetricsHandler() http.Handler {
+	if !metricsRegistered || utils.Config.Metrics.Port != "" {
+		return nil
+	}
+	return promhttp.Handler()
+}
+
+type metricsResponseWriter struct {
+	http.ResponseWriter
+	statusCode int
+}
+
+func (w *metricsResponseWriter) WriteHeader(statusCode int) {
+	w.statusCode = statusCode
+	w.ResponseWriter.WriteHeader(statusCode)
+}
+
+func (w *metricsResponseWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+func (w *metricsResponseWriter) Flush() {
+	if flusher, ok := w.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+// MetricsMiddleware is a mux middleware that records the handler latency per route template
+func MetricsMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !metricsRegistered {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		route := "unknown"
+		if currentRoute := mux.CurrentRoute(r); currentRoute != nil {
+			if tpl, err := currentRoute.GetPathTemplate(); err == nil {
+				route = tpl
+			}
+		}
+
+		start := time.Now()
+		writer := &metricsResponseWriter{ResponseWriter: w, statusCode: http.StatusOK}
+		next.ServeHTTP(writer, r)
+		metricsHttpRequestDuration.WithLabelValues(route, r.Method, strconv.Itoa(writer.statusCode)).Observe(time.Since(start).Seconds())
+	})
+}
+
+func observeMevRelayFetch(relayName string, err error) {
+	if !metricsRegistered {
+		return
+	}
+	if err != nil {
+		metricsMevRelayFetches.WithLabelValues(relayName, "error").Inc()
+		return
+	}
+	metricsMevRelayFetches.WithLabelValues(relayName, "success").Inc()
+	metricsMevRelayLastSuccess.WithLabelValues(relayName).SetToCurrentTime()
+}
+
+var (
+	metricsDescIndexerHighestSlot      = prometheus.NewDesc("dora_indexer_highest_slot", "Highest slot in the indexer cache.", nil, nil)
+	metricsDescIndexerFinalizedEpoch   = prometheus.NewDesc("dora_indexer_finalized_epoch", "Latest finalized epoch seen by the indexer.", nil, nil)
+	metricsDescIndexerPersistedEpoch   = prometheus.NewDesc("dora_indexer_persisted_epoch", "Latest epoch persisted to the db.", nil, nil)
+	metricsDescIndexerProcessedEpoch   = prometheus.NewDesc("dora_indexer_processed_epoch", "Latest epoch processed by the indexer.", nil, nil)
+	metricsDescIndexerCachedBlocks     = prometheus.NewDesc("dora_indexer_cached_blocks", "Number of blocks in the indexer cache.", nil, nil)
+	metricsDescIndexerCachedEpochStats = prometheus.NewDesc("dora_indexer_cached_epoch_stats", "Number of epoch stats in the indexer cache.", nil, nil)
+	metricsDescIndexerHeadForks        = prometheus.NewDesc("dora_indexer_head_forks", "Number of chain heads seen by the ready clients.", nil, nil)
+
+	metricsDescSynchronizerRunning = prometheus.NewDesc("dora_synchronizer_running", "Whether the synchronizer is currently running.", nil, nil)
+	metricsDescSynchronizerEpoch   = prometheus.NewDesc("dora_synchronizer_epoch", "Epoch currently processed by the synchronizer.", nil, nil)
+
+	metricsDescDepositFinalBlock = prometheus.NewDesc("dora_deposit_indexer_final_block", "Last finalized execution block processed by the deposit indexer.", nil, nil)
+	metricsDescDepositHeadBlock  = prometheus.NewDesc("dora_deposit_indexer_head_block", "Last unfinalized execution block processed by the deposit indexer.", nil, nil)
+	metricsDescDepositIndex      = prometheus.NewDesc("dora_deposit_indexer_deposit_index", "Highest deposit index seen by the deposit indexer.", nil, nil)
+
+	metricsDescClientStatus       = prometheus.NewDesc("dora_client_status", "Current status of an endpoint (1 for the active status).", []string{"layer", "client", "status"}, nil)
+	metricsDescClientHead         = prometheus.NewDesc("dora_client_head", "Head slot (consensus) or block number (execution) of an endpoint.", []string{"layer", "client"}, nil)
+	metricsDescClientHeadDistance = prometheus.NewDesc("dora_client_head_distance", "Distance of the endpoint head to the highest known head.", []string{"layer", "client"}, nil)
+	metricsDescClientRetries      = prometheus.NewDesc("dora_client_retry_counter", "Number of consecutive connection retries of an endpoint.", []string{"layer", "client"}, nil)
+	metricsDescClientLastErrorAge = prometheus.NewDesc("dora_client_last_error_age_seconds", "Seconds since the last error of an endpoint.", []string{"layer", "client"}, nil)
+)
+
+var metricsClientStatuses = []string{"ready", "synchronizing", "optimistic", "disconnected"}
+
+// indexerMetricsCollector reads the indexer & client state at scrape time
+type indexerMetricsCollector struct{}
+
+func (c *indexerMetricsCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- metricsDescIndexerHighestSlot
+	ch <- metricsDescIndexerFinalizedEpoch
+	ch <- metricsDescIndexerPersistedEpoch
+	ch <- metricsDescIndexerProcessedEpoch
+	ch <- metricsDescIndexerCachedBlocks
+	ch <- metricsDescIndexerCachedEpochStats
+	ch <- metricsDescIndexerHeadForks
+	ch <- metricsDescSynchronizerRunning
+	ch <- metricsDescSynchronizerEpoch
+	ch <- metricsDescDepositFinalBlock
+	ch <- metricsDescDepositHeadBlock
+	ch <- metricsDescDepositIndex
+	ch <- metricsDescClientStatus
+	ch <- metricsDescClientHead
+	ch <- metricsDescClientHeadDistance
+	ch <- metricsDescClientRetries
+	ch <- metricsDescClientLastErrorAge
+}
+
+func (c *indexerMetricsCollector) Collect(ch chan<- prometheus.Metric) {
+	if GlobalBeaconService == nil {
+		return
+	}
+	indexer := GlobalBeaconService.GetIndexer()
+
+	highestSlot, finalizedEpoch, persistedEpoch, processedEpoch := indexer.GetCacheState()
+	ch <- prometheus.MustNewConstMetric(metricsDescIndexerHighestSlot, prometheus.GaugeValue, float64(highestSlot))
+	ch <- prometheus.MustNewConstMetric(metricsDescIndexerFinalizedEpoch, prometheus.GaugeValue, float64(finalizedEpoch))
+	ch <- prometheus.MustNewConstMetric(metricsDescIndexerPersistedEpoch, prometheus.GaugeValue, float64(persistedEpoch))
+	ch <- prometheus.MustNewConstMetric(metricsDescIndexerProcessedEpoch, prometheus.GaugeValue, float64(processedEpoch))
+
+	blockCount, epochStatsCount := indexer.GetCacheStats()
+	ch <- prometheus.MustNewConstMetric(metricsDescIndexerCachedBlocks, prometheus.GaugeValue, float64(blockCount))
+	ch <- prometheus.MustNewConstMetric(metricsDescIndexerCachedEpochStats, prometheus.GaugeValue, float64(epochStatsCount))
+	ch <- prometheus.MustNewConstMetric(metricsDescIndexerHeadForks, prometheus.GaugeValue, float64(len(indexer.GetHeadForks(true))))
+
+	syncRunning, syncEpoch := indexer.GetSynchronizerState()
+	ch <- prometheus.MustNewConstMetric(metricsDescSynchronizerRunning, prometheus.GaugeValue, metricsBool(syncRunning))
+	ch <- prometheus.MustNewConstMetric(metricsDescSynchronizerEpoch, prometheus.GaugeValue, float64(syncEpoch))
+
+	if depositState := indexer.GetDepositIndexerState(); depositState != nil {
+		ch <- prometheus.MustNewConstMetric(metricsDescDepositFinalBlock, prometheus.GaugeValue, float64(depositState.FinalBlock))
+		ch <- prometheus.MustNewConstMetric(metricsDescDepositHeadBlock, prometheus.GaugeValue, float64(depositState.HeadBlock))
+		ch <- prometheus.MustNewConstMetric(metricsDescDepositIndex, prometheus.GaugeValue, float64(depositState.DepositIndex))
+	}
+
+	for _, client := range indexer.GetConsensusClients() {
+		headSlot, _, _ := client.GetLastHead()
+		headDistance := int64(0)
+		if headSlot >= 0 && highestSlot > headSlot {
+			headDistance = highestSlot - headSlot
+		}
+		c.collectClient(ch, "consensus", client.GetName(), client.GetStatus(), headSlot, headDistance, client.GetRetryCounter(), client.GetLastClientErrorTime())
+	}
+
+	_, canonicalHeadRoot := indexer.GetCanonicalHead()
+	highestBlock := int64(indexer.GetHighestElBlockNumber(canonicalHeadRoot))
+	for _, client := range indexer.GetExecutionClients() {
+		if headBlock, _, _ := client.GetLastHead(); headBlock > highestBlock {
+			highestBlock = headBlock
+		}
+	}
+	for _, client := range indexer.GetExecutionClients() {
+		headBlock, _, _ := client.GetLastHead()
+		headDistance := int64(0)
+		if headBlock >= 0 && highestBlock > headBlock {
+			headDistance = highestBlock - headBlock
+		}
+		c.collectClient(ch, "execution", client.GetName(), client.GetStatus(), headBlock, headDistance, client.GetRetryCounter(), client.GetLastClientErrorTime())
+	}
+}
+
+func (c *indexerMetricsCollector) collectClient(ch chan<- prometheus.Metric, layer string, name string, status string, head int64, headDistance int64, retries uint64, lastErrorTime time.Time) {
+	for _, s := range metricsClientStatuses {
+		ch <- prometheus.MustNewConstMetric(metricsDescClientStatus, prometheus.GaugeValue, metricsBool(s == status), layer, name, s)
+	}
+	ch <- prometheus.MustNewConstMetric(metricsDescClientHead, prometheus.GaugeValue, float64(head), layer, name)
+	ch <- prometheus.MustNewConstMetric(metricsDescClientHeadDistance, prometheus.GaugeValue, float64(headDistance), layer, name)
+	ch <- prometheus.MustNewConstMetric(metricsDescClientRetries, prometheus.GaugeValue, float64(retries), layer, name)
+	if !lastErrorTime.IsZero() {
+		ch <- prometheus.MustNewConstMetric(metricsDescClientLastErrorAge, prometheus.GaugeValue, time.Since(lastErrorTime).Seconds(), layer, name)
+	}
+}
+
+func metricsBool(value bool) float64 {
+	if value {
+		return 1
+	}
+	return 0
+}
@@ -133,6 +133,7 @@ func (mev *MevIndexer) runUpdater(indexer *indexer.Indexer) error {
 			}()
 
 			err := mev.loadMevBlocksFromRelay(indexer, relay)
+			observeMevRelayFetch(relay.Name, err)
 			if err != nil {
 				logger_mev.Errorf("error loading mev blocks from relay %v (%v): %v", idx, relay.Name, err)
 			}
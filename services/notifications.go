@@ -0,0 +1,397 @@
+package services
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	nethttp "net/http"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/sirupsen/logrus"
+
+	"github.com/ethpandaops/dora/db"
+	"github.com/ethpandaops/dora/dbtypes"
+	"github.com/ethpandaops/dora/indexer"
+	"github.com/ethpandaops/dora/types"
+	"github.com/ethpandaops/dora/utils"
+)
+
+const (
+	NotificationEventMissedProposal = "missed_proposal"
+	NotificationEventSlashing       = "slashing"
+	NotificationEventVoluntaryExit  = "voluntary_exit"
+	NotificationEventFork           = "fork"
+)
+
+const notificationStateKey = "notifications.state"
+const notificationStateRetention = 7 * 24 * time.Hour
+
+type NotificationService struct {
+	subscription *indexer.IndexerEventSubscription
+	webhooks     []*notificationWebhook
+	queue        chan *notificationDispatch
+	stateMutex   sync.Mutex
+	state        *notificationState
+	forkCount    int
+}
+
+type notificationWebhook struct {
+	config     *types.NotificationWebhookConfig
+	events     map[string]bool
+	validators map[uint64]bool
+}
+
+type notificationState struct {
+	Sent map[string]int64 `json:"sent"`
+}
+
+type notificationDispatch struct {
+	webhook      *notificationWebhook
+	notification *Notification
+	stateKey     string
+}
+
+// Notification is a single chain event notification as sent to the webhooks (generic json format)
+type Notification struct {
+	Event     string                 `json:"event"`
+	Key       string                 `json:"key"`
+	Title     string                 `json:"title"`
+	Message   string                 `json:"message"`
+	Link      string                 `json:"link,omitempty"`
+	Network   string                 `json:"network"`
+	Timestamp int64                  `json:"timestamp"`
+	Validator *uint64                `json:"validator,omitempty"`
+	Data      map[string]interface{} `json:"data"`
+}
+
+var GlobalNotificationService *NotificationService
+var logger_notify = logrus.StandardLogger().WithField("module", "notifications")
+
+// StartNotificationService is used to start the global notification service
+func StartNotificationService() error {
+	if GlobalNotificationService != nil || !utils.Config.Notifications.Enabled {
+		return nil
+	}
+	if GlobalBeaconService == nil {
+		return fmt.Errorf("chain service not started")
+	}
+
+	if utils.Config.Notifications.RetryCount == 0 {
+		utils.Config.Notifications.RetryCount = 3
+	}
+	if utils.Config.Notifications.RetryDelay == 0 {
+		utils.Config.Notifications.RetryDelay = 10 * time.Second
+	}
+
+	webhooks := []*notificationWebhook{}
+	for idx := range utils.Config.Notifications.Webhooks {
+		config := &utils.Config.Notifications.Webhooks[idx]
+		if config.Url == "" {
+			return fmt.Errorf("notification webhook %v has no url", idx)
+		}
+		switch config.Format {
+		case "":
+			config.Format = "json"
+		case "json", "slack", "discord":
+		default:
+			return fmt.Errorf("notification webhook %v has invalid format: %v", idx, config.Format)
+		}
+		if config.Name == "" {
+			config.Name = fmt.Sprintf("webhook-%v", idx)
+		}
+
+		webhook := &notificationWebhook{
+			config:     config,
+			events:     map[string]bool{},
+			validators: map[uint64]bool{},
+		}
+		for _, event := range config.Events {
+			webhook.events[event] = true
+		}
+		for _, validator := range config.Validators {
+			webhook.validators[validator] = true
+		}
+		webhooks = append(webhooks, webhook)
+	}
+
+	state := &notificationState{}
+	_, err := db.GetExplorerState(notificationStateKey, state)
+	if err != nil || state.Sent == nil {
+		state.Sent = map[string]int64{}
+	}
+
+	GlobalNotificationService = &NotificationService{
+		subscription: GlobalBeaconService.GetIndexer().SubscribeEvents(100),
+		webhooks:     webhooks,
+		queue:        make(chan *notificationDispatch, 1000),
+		state:        state,
+	}
+
+	go GlobalNotificationService.runEventLoop()
+	go GlobalNotificationService.runDispatchLoop()
+
+	logger_notify.Infof("notification service started with %v webhooks", len(webhooks))
+	return nil
+}
+
+func (ns *NotificationService) runEventLoop() {
+	defer utils.HandleSubroutinePanic("NotificationService.runEventLoop")
+
+	for event := range ns.subscription.Channel() {
+		switch event.Type {
+		case indexer.BlockEventType:
+			ns.processBlockEvent(event)
+		case indexer.FinalizedEpochEventType:
+			ns.processFinalizedEpochEvent(event)
+		}
+	}
+}
+
+func (ns *NotificationService) processBlockEvent(event *indexer.IndexerEvent) {
+	forks := GlobalBeaconService.GetHeadForks(true)
+	forkCount := len(forks)
+	if forkCount <= 1 || forkCount <= ns.forkCount {
+		ns.forkCount = forkCount
+		return
+	}
+	ns.forkCount = forkCount
+
+	forkDescriptions := make([]string, len(forks))
+	forkData := make([]map[string]interface{}, len(forks))
+	for idx, fork := range forks {
+		clientNames := make([]string, len(fork.ReadyClients))
+		for cidx, client := range fork.ReadyClients {
+			clientNames[cidx] = client.GetName()
+		}
+		forkDescriptions[idx] = fmt.Sprintf("head %v (slot %v): %v", shortRoot(fork.Root), fork.Slot, strings.Join(clientNames, ", "))
+		forkData[idx] = map[string]interface{}{
+			"slot":    fork.Slot,
+			"root":    fmt.Sprintf("0x%x", fork.Root),
+			"clients": clientNames,
+		}
+	}
+
+	ns.dispatch(&Notification{
+		Event:   NotificationEventFork,
+		Key:     fmt.Sprintf("fork-%v-%v", event.Block.Slot, forkCount),
+		Title:   fmt.Sprintf("Chain fork detected: %v heads", forkCount),
+		Message: strings.Join(forkDescriptions, "\n"),
+		Link:    notificationLink("/forks"),
+		Data: map[string]interface{}{
+			"slot":  event.Block.Slot,
+			"forks": forkData,
+		},
+	})
+}
+
+func (ns *NotificationService) processFinalizedEpochEvent(event *indexer.IndexerEvent) {
+	for slot, proposer := range event.MissedSlots {
+		proposer := proposer
+		ns.dispatch(&Notification{
+			Event:     NotificationEventMissedProposal,
+			Key:       fmt.Sprintf("missed-%v", slot),
+			Title:     fmt.Sprintf("Missed proposal in slot %v", slot),
+			Message:   fmt.Sprintf("Validator %v missed its block proposal in slot %v (epoch %v)", notificationValidatorName(proposer), slot, event.Epoch),
+			Link:      notificationLink(fmt.Sprintf("/slot/%v", slot)),
+			Validator: &proposer,
+			Data: map[string]interface{}{
+				"slot":     slot,
+				"epoch":    event.Epoch,
+				"proposer": proposer,
+			},
+		})
+	}
+
+	for _, slashing := range event.Slashings {
+		validator := slashing.ValidatorIndex
+		reason := "unknown"
+		switch slashing.Reason {
+		case dbtypes.ProposerSlashing:
+			reason = "proposer slashing"
+		case dbtypes.AttesterSlashing:
+			reason = "attester slashing"
+		}
+		ns.dispatch(&Notification{
+			Event:     NotificationEventSlashing,
+			Key:       fmt.Sprintf("slashing-%v", validator),
+			Title:     fmt.Sprintf("Validator %v slashed", validator),
+			Message:   fmt.Sprintf("Validator %v has been slashed (%v) in slot %v by %v", notificationValidatorName(validator), reason, slashing.SlotNumber, notificationValidatorName(slashing.SlasherIndex)),
+			Link:      notificationLink(fmt.Sprintf("/validator/%v", validator)),
+			Validator: &validator,
+			Data: map[string]interface{}{
+				"slot":      slashing.SlotNumber,
+				"validator": validator,
+				"slasher":   slashing.SlasherIndex,
+				"reason":    reason,
+			},
+		})
+	}
+
+	for _, voluntaryExit := range event.VoluntaryExits {
+		validator := voluntaryExit.ValidatorIndex
+		ns.dispatch(&Notification{
+			Event:     NotificationEventVoluntaryExit,
+			Key:       fmt.Sprintf("exit-%v", validator),
+			Title:     fmt.Sprintf("Validator %v exiting", validator),
+			Message:   fmt.Sprintf("Validator %v submitted a voluntary exit in slot %v", notificationValidatorName(validator), voluntaryExit.SlotNumber),
+			Link:      notificationLink(fmt.Sprintf("/validator/%v", validator)),
+			Validator: &validator,
+			Data: map[string]interface{}{
+				"slot":      voluntaryExit.SlotNumber,
+				"validator": validator,
+			},
+		})
+	}
+}
+
+// dispatch queues the notification for all webhooks whose rules match and that have not been notified about it yet
+func (ns *NotificationService) dispatch(notification *Notification) {
+	notification.Network = utils.Config.Chain.Name
+	notification.Timestamp = time.Now().Unix()
+
+	for _, webhook := range ns.webhooks {
+		if len(webhook.events) > 0 && !webhook.events[notification.Event] {
+			continue
+		}
+		if len(webhook.validators) > 0 && notification.Validator != nil && !webhook.validators[*notification.Validator] {
+			continue
+		}
+
+		stateKey := fmt.Sprintf("%v:%v", webhook.config.Name, notification.Key)
+		ns.stateMutex.Lock()
+		_, alreadySent := ns.state.Sent[stateKey]
+		ns.stateMutex.Unlock()
+		if alreadySent {
+			continue
+		}
+
+		select {
+		case ns.queue <- &notificationDispatch{
+			webhook:      webhook,
+			notification: notification,
+			stateKey:     stateKey,
+		}:
+		default:
+			logger_notify.Warnf("notification queue full, dropping %v notification for %v", notification.Event, webhook.config.Name)
+		}
+	}
+}
+
+func (ns *NotificationService) runDispatchLoop() {
+	defer utils.HandleSubroutinePanic("NotificationService.runDispatchLoop")
+
+	for dispatch := range ns.queue {
+		var err error
+		for retry := 0; retry <= utils.Config.Notifications.RetryCount; retry++ {
+			if retry > 0 {
+				time.Sleep(utils.Config.Notifications.RetryDelay)
+			}
+			err = ns.sendNotification(dispatch.webhook, dispatch.notification)
+			if err == nil {
+				break
+			}
+			logger_notify.Warnf("error sending %v notification to %v (try %v): %v", dispatch.notification.Event, dispatch.webhook.config.Name, retry+1, err)
+		}
+		if err != nil {
+			logger_notify.Errorf("failed sending %v notification to %v: %v", dispatch.notification.Event, dispatch.webhook.config.Name, err)
+			continue
+		}
+
+		err = ns.markSent(dispatch.stateKey)
+		if err != nil {
+			logger_notify.Errorf("error saving notification state: %v", err)
+		}
+	}
+}
+
+func (ns *NotificationService) markSent(stateKey string) error {
+	ns.stateMutex.Lock()
+	defer ns.stateMutex.Unlock()
+
+	now := time.Now()
+	ns.state.Sent[stateKey] = now.Unix()
+	minTs := now.Add(-notificationStateRetention).Unix()
+	for key, ts := range ns.state.Sent {
+		if ts < minTs {
+			delete(ns.state.Sent, key)
+		}
+	}
+
+	return db.RunDBTransaction(func(tx *sqlx.Tx) error {
+		return db.SetExplorerState(notificationStateKey, ns.state, tx)
+	})
+}
+
+func (ns *NotificationService) sendNotification(webhook *notificationWebhook, notification *Notification) error {
+	var payload interface{}
+	text := notification.Message
+	if notification.Link != "" {
+		text = fmt.Sprintf("%v\n%v", text, notification.Link)
+	}
+	switch webhook.config.Format {
+	case "slack":
+		payload = map[string]interface{}{
+			"text": fmt.Sprintf("*%v*\n%v", notification.Title, text),
+		}
+	case "discord":
+		payload = map[string]interface{}{
+			"content": fmt.Sprintf("**%v**\n%v", notification.Title, text),
+		}
+	default:
+		payload = notification
+	}
+
+	payloadBytes, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	req, err := nethttp.NewRequest("POST", webhook.config.Url, bytes.NewReader(payloadBytes))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for headerKey, headerVal := range webhook.config.Headers {
+		req.Header.Set(headerKey, headerVal)
+	}
+
+	client := &nethttp.Client{Timeout: time.Second * 10}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("unexpected status code %v: %v", resp.StatusCode, string(body))
+	}
+	return nil
+}
+
+func notificationValidatorName(index uint64) string {
+	name := GlobalBeaconService.GetValidatorName(index)
+	if name == "" {
+		return fmt.Sprintf("%v", index)
+	}
+	return fmt.Sprintf("%v (%v)", index, name)
+}
+
+func notificationLink(path string) string {
+	if utils.Config.Frontend.SiteDomain == "" {
+		return ""
+	}
+	return fmt.Sprintf("https://%v%v", utils.Config.Frontend.SiteDomain, path)
+}
+
+func shortRoot(root []byte) string {
+	if len(root) < 4 {
+		return fmt.Sprintf("0x%x", root)
+	}
+	return fmt.Sprintf("0x%x..%x", root[:2], root[len(root)-2:])
+}
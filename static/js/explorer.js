@@ -10,6 +10,8 @@
   window.explorer = {
     initControls: initControls,
     renderRecentTime: renderRecentTime,
+    subscribeEvents: subscribeEvents,
+    liveReload: liveReload,
     tooltipDict: tooltipDict,
   };
 
@@ -86,6 +88,56 @@
     });
   }
 
+  var eventSource = null;
+  function subscribeEvents(topics, handler) {
+    // live chain events via the server-sent event stream (reconnects & replays missed events automatically)
+    if(!window.EventSource)
+      return false;
+    if(!eventSource)
+      eventSource = new EventSource("/api/v1/events");
+    topics.forEach(function(topic) {
+      eventSource.addEventListener(topic, function(evt) {
+        var data;
+        try {
+          data = JSON.parse(evt.data);
+        } catch(e) {
+          return;
+        }
+        handler(topic, data);
+      });
+    });
+    return true;
+  }
+
+  var liveReloadTimers = {};
+  function liveReload(containerId, checkFn, retry) {
+    // reload the current page in background and swap the container content.
+    // the server side page cache might still return the old page, so retry until checkFn accepts the new html.
+    if(liveReloadTimers[containerId])
+      return;
+    retry = retry || 0;
+    liveReloadTimers[containerId] = setTimeout(async function() {
+      delete liveReloadTimers[containerId];
+      var html;
+      try {
+        html = await $.get(window.location.href);
+      } catch(e) {
+        return;
+      }
+      if(checkFn && !checkFn(html)) {
+        if(retry < 5)
+          liveReload(containerId, checkFn, retry + 1);
+        return;
+      }
+      var newContainer = new DOMParser().parseFromString(html, "text/html").getElementById(containerId);
+      var container = document.getElementById(containerId);
+      if(!newContainer || !container)
+        return;
+      container.innerHTML = newContainer.innerHTML;
+      initControls();
+    }, retry ? 3000 : 1000);
+  }
+
   function renderRecentTime(time) {
     var duration = time - Math.floor(new Date().getTime() / 1000);
     var timeStr= "";
@@ -142,6 +194,18 @@
         maxPendingRequests: requestNum,
       },
     });
+    var bhTransactions = new Bloodhound({
+      datumTokenizer: Bloodhound.tokenizers.whitespace,
+      queryTokenizer: Bloodhound.tokenizers.whitespace,
+      identify: function (obj) {
+        return obj.tx_hash
+      },
+      remote: {
+        url: "/search/transactions?q=",
+        prepare: prepareQueryFn,
+        maxPendingRequests: requestNum,
+      },
+    });
     var bhEpochs = new Bloodhound({
       datumTokenizer: Bloodhound.tokenizers.whitespace,
       queryTokenizer: Bloodhound.tokenizers.whitespace,
@@ -219,6 +283,18 @@
           },
         },
       },
+      {
+        limit: 5,
+        name: "transactions",
+        source: bhTransactions,
+        display: "tx_hash",
+        templates: {
+          header: '<h3 class="h5">Transactions:</h3>',
+          suggestion: function (data) {
+            return `<div class="text-monospace"><div class="search-table"><span class="search-cell">${data.block_number}:</span><span class="search-cell search-truncate">${data.tx_hash}</span></div></div>`;
+          },
+        },
+      },
       {
         limit: 5,
         name: "name",
@@ -276,6 +352,8 @@
         } else {
           window.location = "/slot/" + sug.slot
         }
+      } else if (sug.tx_hash !== undefined) {
+        window.location = "/tx/" + sug.tx_hash
       } else if (sug.epoch !== undefined) {
         window.location = "/epoch/" + sug.epoch
       } else if (sug.graffiti !== undefined) {
@@ -2,12 +2,15 @@
 (function() {
   window.addEventListener('DOMContentLoaded', function() {
     window.setInterval(scheduleLoop, 500);
+    window.explorer.subscribeEvents(["head", "finalized", "epoch"], onChainEvent);
   });
 
   var refreshInterval = 15000;
   var lastRefresh = new Date().getTime();
   var loopTimer = null;
   var isRefreshing = false;
+  var liveSlot = 0;
+  var liveRetries = 0;
   var viewModel = null;
   var baseModel = {
     formatAddCommas: function(x) { return x; },
@@ -49,6 +52,20 @@
     }
   }
 
+  function onChainEvent(topic, data) {
+    if(topic == "head" && data.slot > liveSlot) {
+      liveSlot = data.slot;
+      liveRetries = 0;
+    }
+    scheduleRefresh(1000);
+  }
+
+  function scheduleRefresh(delay) {
+    var refreshAt = new Date().getTime() + delay - refreshInterval;
+    if(refreshAt < lastRefresh)
+      lastRefresh = refreshAt;
+  }
+
   async function refresh() {
     if(isRefreshing)
       return;
@@ -58,6 +75,10 @@
       var pageData = await $.get("/index/data");
       updateModel(pageData);
 
+      // page data is cached server side, refresh again until it caught up with the live head
+      if(pageData.cur_slot < liveSlot && liveRetries++ < 5)
+        scheduleRefresh(2000);
+
       //console.log(pageData)
       window.explorer.initControls()
     } finally {
@@ -0,0 +1,98 @@
+package apitypes
+
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// AddressDetails holds the beacon chain objects related to an execution layer address.
+// The object lists contain the most recent entries only, the counts cover all matching entries.
+type AddressDetails struct {
+	Address               hexutil.Bytes         `json:"address"`
+	TransactionCount      *uint64               `json:"transaction_count,omitempty"`
+	DepositCount          uint64                `json:"deposit_count"`
+	TargetDepositCount    uint64                `json:"target_deposit_count"`
+	ValidatorCount        uint64                `json:"validator_count"`
+	WithdrawalCount       uint64                `json:"withdrawal_count"`
+	MevBlockCount         uint64                `json:"mev_block_count"`
+	Transactions          []*AddressTransaction `json:"transactions,omitempty"`
+	Deposits              []*AddressDeposit     `json:"deposits"`
+	TargetDeposits        []*AddressDeposit     `json:"target_deposits"`
+	Validators            []*AddressValidator   `json:"validators"`
+	Withdrawals           []*AddressWithdrawal  `json:"withdrawals"`
+	FeeRecipientBlocks    []*AddressBlock       `json:"fee_recipient_blocks"`
+	FeeRecipientMevBlocks []*AddressMevBlock    `json:"fee_recipient_mev_blocks"`
+}
+
+// AddressTransaction holds an indexed execution layer transaction sent from or to an address
+type AddressTransaction struct {
+	TxHash          hexutil.Bytes `json:"tx_hash"`
+	BlockNumber     uint64        `json:"block_number"`
+	Time            time.Time     `json:"time"`
+	From            hexutil.Bytes `json:"from"`
+	To              hexutil.Bytes `json:"to,omitempty"`
+	ContractAddress hexutil.Bytes `json:"contract_address,omitempty"`
+	Value           float64       `json:"value"`
+	Method          string        `json:"method"`
+	Success         *bool         `json:"success,omitempty"`
+}
+
+// AddressDeposit holds a deposit transaction sent from or through an address
+type AddressDeposit struct {
+	Index     uint64        `json:"index"`
+	PublicKey hexutil.Bytes `json:"pubkey"`
+	Amount    uint64        `json:"amount"`
+	TxHash    hexutil.Bytes `json:"tx_hash"`
+	Time      time.Time     `json:"time"`
+	Block     uint64        `json:"block"`
+	Orphaned  bool          `json:"orphaned"`
+	Valid     bool          `json:"valid"`
+}
+
+// AddressValidator holds a validator with 0x01 withdrawal credentials pointing to an address
+type AddressValidator struct {
+	Index            uint64        `json:"index"`
+	Name             string        `json:"name"`
+	PublicKey        hexutil.Bytes `json:"pubkey"`
+	Balance          uint64        `json:"balance"`
+	EffectiveBalance uint64        `json:"effective_balance"`
+	State            string        `json:"state"`
+}
+
+// AddressWithdrawal holds a withdrawal paid to an address
+type AddressWithdrawal struct {
+	Index          uint64        `json:"index"`
+	Slot           uint64        `json:"slot"`
+	SlotRoot       hexutil.Bytes `json:"slot_root"`
+	Time           time.Time     `json:"time"`
+	Orphaned       bool          `json:"orphaned"`
+	ValidatorIndex uint64        `json:"validator_index"`
+	ValidatorName  string        `json:"validator_name"`
+	Amount         uint64        `json:"amount"`
+}
+
+// AddressBlock holds a block with an address as execution fee recipient
+type AddressBlock struct {
+	Slot           uint64        `json:"slot"`
+	BlockRoot      hexutil.Bytes `json:"block_root"`
+	Time           time.Time     `json:"time"`
+	Orphaned       bool          `json:"orphaned"`
+	Proposer       uint64        `json:"proposer"`
+	ProposerName   string        `json:"proposer_name"`
+	EthBlockNumber uint64        `json:"eth_block_number"`
+	EthTxCount     uint64        `json:"eth_transaction_count"`
+}
+
+// AddressMevBlock holds a relayed mev block with an address as fee recipient
+type AddressMevBlock struct {
+	Slot         uint64        `json:"slot"`
+	BlockHash    hexutil.Bytes `json:"block_hash"`
+	BlockNumber  uint64        `json:"block_number"`
+	Time         time.Time     `json:"time"`
+	Proposed     string        `json:"proposed"`
+	Proposer     uint64        `json:"proposer"`
+	ProposerName string        `json:"proposer_name"`
+	TxCount      uint64        `json:"tx_count"`
+	BlockValue   uint64        `json:"block_value"`
+}
@@ -0,0 +1,29 @@
+package apitypes
+
+// Response is the envelope returned by all /api/v1 endpoints
+type Response struct {
+	Status     string      `json:"status"`
+	Data       interface{} `json:"data,omitempty"`
+	Pagination *Pagination `json:"pagination,omitempty"`
+	Error      *Error      `json:"error,omitempty"`
+}
+
+// Error is the error object returned by /api/v1 endpoints on failure
+type Error struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// Pagination describes the position of a paged /api/v1 result
+type Pagination struct {
+	Page       uint64 `json:"page"`
+	Limit      uint64 `json:"limit"`
+	TotalPages uint64 `json:"total_pages"`
+	PrevPage   uint64 `json:"prev_page,omitempty"`
+	NextPage   uint64 `json:"next_page,omitempty"`
+}
+
+const (
+	StatusOK    = "OK"
+	StatusError = "ERROR"
+)
@@ -0,0 +1,80 @@
+package apitypes
+
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// Epoch holds the summary of an epoch as returned by the epochs endpoint
+type Epoch struct {
+	Epoch                   uint64    `json:"epoch"`
+	Time                    time.Time `json:"time"`
+	Finalized               bool      `json:"finalized"`
+	Justified               bool      `json:"justified"`
+	Synchronized            bool      `json:"synchronized"`
+	CanonicalBlockCount     uint64    `json:"canonical_block_count"`
+	OrphanedBlockCount      uint64    `json:"orphaned_block_count"`
+	AttestationCount        uint64    `json:"attestation_count"`
+	DepositCount            uint64    `json:"deposit_count"`
+	ExitCount               uint64    `json:"exit_count"`
+	ProposerSlashingCount   uint64    `json:"proposer_slashing_count"`
+	AttesterSlashingCount   uint64    `json:"attester_slashing_count"`
+	EligibleEther           uint64    `json:"eligible_ether"`
+	TargetVoted             uint64    `json:"target_voted"`
+	HeadVoted               uint64    `json:"head_voted"`
+	TotalVoted              uint64    `json:"total_voted"`
+	TargetVoteParticipation float64   `json:"target_vote_participation"`
+	HeadVoteParticipation   float64   `json:"head_vote_participation"`
+	TotalVoteParticipation  float64   `json:"total_vote_participation"`
+	EthTransactionCount     uint64    `json:"eth_transaction_count"`
+}
+
+// EpochDetails holds the details of a single epoch including its slots
+type EpochDetails struct {
+	Epoch                   uint64       `json:"epoch"`
+	Time                    time.Time    `json:"time"`
+	Finalized               bool         `json:"finalized"`
+	Synchronized            bool         `json:"synchronized"`
+	AttestationCount        uint64       `json:"attestation_count"`
+	DepositCount            uint64       `json:"deposit_count"`
+	ExitCount               uint64       `json:"exit_count"`
+	WithdrawalCount         uint64       `json:"withdrawal_count"`
+	WithdrawalAmount        uint64       `json:"withdrawal_amount"`
+	ProposerSlashingCount   uint64       `json:"proposer_slashing_count"`
+	AttesterSlashingCount   uint64       `json:"attester_slashing_count"`
+	EligibleEther           uint64       `json:"eligible_ether"`
+	TargetVoted             uint64       `json:"target_voted"`
+	HeadVoted               uint64       `json:"head_voted"`
+	TotalVoted              uint64       `json:"total_voted"`
+	TargetVoteParticipation float64      `json:"target_vote_participation"`
+	HeadVoteParticipation   float64      `json:"head_vote_participation"`
+	TotalVoteParticipation  float64      `json:"total_vote_participation"`
+	SyncParticipation       float64      `json:"sync_participation"`
+	ValidatorCount          uint64       `json:"validator_count"`
+	AverageValidatorBalance uint64       `json:"average_validator_balance"`
+	CanonicalCount          uint64       `json:"canonical_count"`
+	MissedCount             uint64       `json:"missed_count"`
+	ScheduledCount          uint64       `json:"scheduled_count"`
+	OrphanedCount           uint64       `json:"orphaned_count"`
+	Slots                   []*EpochSlot `json:"slots"`
+}
+
+// EpochSlot holds the summary of a slot in the epoch details
+type EpochSlot struct {
+	Slot                  uint64        `json:"slot"`
+	Time                  time.Time     `json:"time"`
+	Status                string        `json:"status"`
+	Proposer              uint64        `json:"proposer"`
+	ProposerName          string        `json:"proposer_name"`
+	AttestationCount      uint64        `json:"attestation_count"`
+	DepositCount          uint64        `json:"deposit_count"`
+	ExitCount             uint64        `json:"exit_count"`
+	ProposerSlashingCount uint64        `json:"proposer_slashing_count"`
+	AttesterSlashingCount uint64        `json:"attester_slashing_count"`
+	SyncParticipation     float64       `json:"sync_participation"`
+	EthTransactionCount   uint64        `json:"eth_transaction_count"`
+	EthBlockNumber        *uint64       `json:"eth_block_number,omitempty"`
+	Graffiti              hexutil.Bytes `json:"graffiti,omitempty"`
+	BlockRoot             hexutil.Bytes `json:"block_root,omitempty"`
+}
@@ -0,0 +1,65 @@
+package apitypes
+
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// EventBlock is sent on the "block" topic of the event stream for each new block seen by the indexer
+type EventBlock struct {
+	Slot         uint64        `json:"slot"`
+	Epoch        uint64        `json:"epoch"`
+	Time         time.Time     `json:"time"`
+	BlockRoot    hexutil.Bytes `json:"block_root"`
+	ParentRoot   hexutil.Bytes `json:"parent_root"`
+	Proposer     uint64        `json:"proposer"`
+	ProposerName string        `json:"proposer_name"`
+}
+
+// EventHead is sent on the "head" topic of the event stream when the canonical head changes
+type EventHead struct {
+	Slot      uint64        `json:"slot"`
+	Epoch     uint64        `json:"epoch"`
+	Time      time.Time     `json:"time"`
+	BlockRoot hexutil.Bytes `json:"block_root"`
+}
+
+// EventReorg is sent on the "reorg" topic of the event stream when the new canonical head does not descend from the previous head
+type EventReorg struct {
+	Slot        uint64        `json:"slot"`
+	Epoch       uint64        `json:"epoch"`
+	BlockRoot   hexutil.Bytes `json:"block_root"`
+	OldHeadSlot uint64        `json:"old_head_slot"`
+	OldHeadRoot hexutil.Bytes `json:"old_head_root"`
+	Depth       uint64        `json:"depth"`
+}
+
+// EventFinalizedCheckpoint is sent on the "finalized" topic of the event stream for each new finalized checkpoint
+type EventFinalizedCheckpoint struct {
+	Epoch          uint64        `json:"epoch"`
+	Root           hexutil.Bytes `json:"root"`
+	JustifiedEpoch uint64        `json:"justified_epoch"`
+	JustifiedRoot  hexutil.Bytes `json:"justified_root"`
+}
+
+// EventEpochSummary is sent on the "epoch" topic of the event stream when the chain head moves into a new epoch (summary of the previous epoch)
+type EventEpochSummary struct {
+	Epoch                   uint64    `json:"epoch"`
+	Time                    time.Time `json:"time"`
+	CanonicalBlockCount     uint64    `json:"canonical_block_count"`
+	OrphanedBlockCount      uint64    `json:"orphaned_block_count"`
+	AttestationCount        uint64    `json:"attestation_count"`
+	DepositCount            uint64    `json:"deposit_count"`
+	ExitCount               uint64    `json:"exit_count"`
+	ProposerSlashingCount   uint64    `json:"proposer_slashing_count"`
+	AttesterSlashingCount   uint64    `json:"attester_slashing_count"`
+	EligibleEther           uint64    `json:"eligible_ether"`
+	TargetVoted             uint64    `json:"target_voted"`
+	HeadVoted               uint64    `json:"head_voted"`
+	TotalVoted              uint64    `json:"total_voted"`
+	TargetVoteParticipation float64   `json:"target_vote_participation"`
+	HeadVoteParticipation   float64   `json:"head_vote_participation"`
+	TotalVoteParticipation  float64   `json:"total_vote_participation"`
+	EthTransactionCount     uint64    `json:"eth_transaction_count"`
+}
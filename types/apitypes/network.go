@@ -0,0 +1,141 @@
+package apitypes
+
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// Fork holds a head fork seen by the connected consensus clients
+type Fork struct {
+	HeadSlot uint64        `json:"head_slot"`
+	HeadRoot hexutil.Bytes `json:"head_root"`
+	Clients  []*ForkClient `json:"clients"`
+}
+
+// ForkClient holds a consensus client following a head fork
+type ForkClient struct {
+	Index       int       `json:"index"`
+	Name        string    `json:"name"`
+	Version     string    `json:"version"`
+	Status      string    `json:"status"`
+	HeadSlot    uint64    `json:"head_slot"`
+	Distance    uint64    `json:"distance"`
+	LastRefresh time.Time `json:"last_refresh"`
+	LastError   string    `json:"last_error,omitempty"`
+}
+
+// ForkChoiceTree holds the unfinalized block tree since the last finalized checkpoint
+type ForkChoiceTree struct {
+	FinalizedEpoch int64             `json:"finalized_epoch"`
+	FinalizedRoot  hexutil.Bytes     `json:"finalized_root"`
+	JustifiedEpoch int64             `json:"justified_epoch"`
+	JustifiedRoot  hexutil.Bytes     `json:"justified_root"`
+	HeadSlot       uint64            `json:"head_slot"`
+	HeadRoot       hexutil.Bytes     `json:"head_root"`
+	WeightUnit     string            `json:"weight_unit"`
+	TotalWeight    uint64            `json:"total_weight"`
+	Nodes          []*ForkChoiceNode `json:"nodes"`
+}
+
+// ForkChoiceNode holds a block of the fork choice tree
+type ForkChoiceNode struct {
+	Slot          uint64        `json:"slot"`
+	Root          hexutil.Bytes `json:"root"`
+	ParentRoot    hexutil.Bytes `json:"parent_root"`
+	Canonical     bool          `json:"canonical"`
+	Orphaned      bool          `json:"orphaned"`
+	Finalized     bool          `json:"finalized"`
+	Justified     bool          `json:"justified"`
+	Proposer      uint64        `json:"proposer"`
+	ProposerName  string        `json:"proposer_name"`
+	VoteWeight    uint64        `json:"vote_weight"`
+	Weight        uint64        `json:"weight"`
+	WeightPercent float64       `json:"weight_percent"`
+	ChildCount    uint64        `json:"child_count"`
+	HeadClients   []string      `json:"head_clients"`
+}
+
+// Reorg holds a reorg of the canonical chain seen by the indexer
+type Reorg struct {
+	Slot              uint64        `json:"slot"`
+	HeadRoot          hexutil.Bytes `json:"head_root"`
+	Time              time.Time     `json:"time"`
+	OldHeadSlot       uint64        `json:"old_head_slot"`
+	OldHeadRoot       hexutil.Bytes `json:"old_head_root"`
+	AncestorSlot      *uint64       `json:"ancestor_slot"`
+	AncestorRoot      hexutil.Bytes `json:"ancestor_root"`
+	Depth             uint64        `json:"depth"`
+	FirstAffectedSlot uint64        `json:"first_affected_slot"`
+	LastAffectedSlot  uint64        `json:"last_affected_slot"`
+	DetectedTime      time.Time     `json:"detected_time"`
+	OldHeadClients    []string      `json:"old_head_clients"`
+	NewHeadClients    []string      `json:"new_head_clients"`
+}
+
+// Client holds the status of a connected consensus or execution client
+type Client struct {
+	Index       int           `json:"index"`
+	Name        string        `json:"name"`
+	Version     string        `json:"version"`
+	Status      string        `json:"status"`
+	HeadSlot    uint64        `json:"head_slot,omitempty"`
+	HeadNumber  uint64        `json:"head_number,omitempty"`
+	HeadRoot    hexutil.Bytes `json:"head_root"`
+	LastRefresh time.Time     `json:"last_refresh"`
+	LastError   string        `json:"last_error,omitempty"`
+	PeerID      string        `json:"peer_id,omitempty"`
+	PeerCount   uint32        `json:"peer_count"`
+	Peers       []*ClientPeer `json:"peers"`
+}
+
+// ClientPeer holds a peer of a connected client
+type ClientPeer struct {
+	ID        string `json:"id"`
+	Alias     string `json:"alias"`
+	Type      string `json:"type"`
+	State     string `json:"state"`
+	Direction string `json:"direction"`
+}
+
+// ClientDiversity holds the proposer client statistics of an epoch range (clients classified by graffiti & execution extra data)
+type ClientDiversity struct {
+	MinEpoch      uint64                   `json:"min_epoch"`
+	MaxEpoch      uint64                   `json:"max_epoch"`
+	EpochCount    uint64                   `json:"epoch_count"`
+	TotalProposed uint64                   `json:"total_proposed"`
+	TotalMissed   uint64                   `json:"total_missed"`
+	MissedRate    float64                  `json:"missed_rate"`
+	ClClients     []*ClientDiversityClient `json:"cl_clients"`
+	ElClients     []*ClientDiversityClient `json:"el_clients"`
+	Pairs         []*ClientDiversityPair   `json:"pairs"`
+	Epochs        []*ClientDiversityEpoch  `json:"epochs"`
+}
+
+// ClientDiversityClient holds the aggregated proposals of a cl or el client (share & missed rate in percent)
+type ClientDiversityClient struct {
+	Client     string  `json:"client"`
+	Proposed   uint64  `json:"proposed"`
+	Missed     uint64  `json:"missed"`
+	Share      float64 `json:"share"`
+	MissedRate float64 `json:"missed_rate"`
+}
+
+// ClientDiversityPair holds the aggregated proposals of a cl/el client combination (share & missed rate in percent)
+type ClientDiversityPair struct {
+	ClClient   string  `json:"cl_client"`
+	ElClient   string  `json:"el_client"`
+	Proposed   uint64  `json:"proposed"`
+	Missed     uint64  `json:"missed"`
+	Share      float64 `json:"share"`
+	MissedRate float64 `json:"missed_rate"`
+}
+
+// ClientDiversityEpoch holds the proposals of a cl/el client combination in a single epoch
+type ClientDiversityEpoch struct {
+	Epoch    uint64 `json:"epoch"`
+	ClClient string `json:"cl_client"`
+	ElClient string `json:"el_client"`
+	Proposed uint64 `json:"proposed"`
+	Missed   uint64 `json:"missed"`
+}
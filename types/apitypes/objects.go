@@ -0,0 +1,158 @@
+package apitypes
+
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// InitiatedDeposit holds a deposit transaction sent to the deposit contract
+type InitiatedDeposit struct {
+	Index                 uint64        `json:"index"`
+	Address               hexutil.Bytes `json:"address"`
+	PublicKey             hexutil.Bytes `json:"pubkey"`
+	WithdrawalCredentials hexutil.Bytes `json:"withdrawal_credentials"`
+	Amount                uint64        `json:"amount"`
+	TxHash                hexutil.Bytes `json:"tx_hash"`
+	Time                  time.Time     `json:"time"`
+	Block                 uint64        `json:"block"`
+	Orphaned              bool          `json:"orphaned"`
+	Valid                 bool          `json:"valid"`
+	ValidatorStatus       string        `json:"validator_status"`
+}
+
+// IncludedDeposit holds a deposit included in the beacon chain
+type IncludedDeposit struct {
+	Index                 *uint64       `json:"index,omitempty"`
+	PublicKey             hexutil.Bytes `json:"pubkey"`
+	WithdrawalCredentials hexutil.Bytes `json:"withdrawal_credentials"`
+	Amount                uint64        `json:"amount"`
+	Slot                  uint64        `json:"slot"`
+	SlotRoot              hexutil.Bytes `json:"slot_root"`
+	Time                  time.Time     `json:"time"`
+	Orphaned              bool          `json:"orphaned"`
+	ValidatorStatus       string        `json:"validator_status"`
+}
+
+// DepositSnapshot holds an EIP-4881 deposit tree snapshot
+type DepositSnapshot struct {
+	Finalized            []hexutil.Bytes `json:"finalized"`
+	DepositRoot          hexutil.Bytes   `json:"deposit_root"`
+	DepositCount         uint64          `json:"deposit_count"`
+	ExecutionBlockHash   hexutil.Bytes   `json:"execution_block_hash"`
+	ExecutionBlockHeight uint64          `json:"execution_block_height"`
+}
+
+// VoluntaryExit holds a voluntary exit included in the beacon chain
+type VoluntaryExit struct {
+	Slot                  uint64        `json:"slot"`
+	SlotRoot              hexutil.Bytes `json:"slot_root"`
+	Time                  time.Time     `json:"time"`
+	Orphaned              bool          `json:"orphaned"`
+	ValidatorIndex        uint64        `json:"validator_index"`
+	ValidatorName         string        `json:"validator_name"`
+	PublicKey             hexutil.Bytes `json:"pubkey"`
+	WithdrawalCredentials hexutil.Bytes `json:"withdrawal_credentials"`
+	ValidatorStatus       string        `json:"validator_status"`
+}
+
+// Withdrawal holds a withdrawal included in the beacon chain
+type Withdrawal struct {
+	Slot            uint64        `json:"slot"`
+	SlotRoot        hexutil.Bytes `json:"slot_root"`
+	Time            time.Time     `json:"time"`
+	Orphaned        bool          `json:"orphaned"`
+	Index           uint64        `json:"index"`
+	ValidatorIndex  uint64        `json:"validator_index"`
+	ValidatorName   string        `json:"validator_name"`
+	ValidatorStatus string        `json:"validator_status"`
+	Address         hexutil.Bytes `json:"address"`
+	Amount          uint64        `json:"amount"`
+	Type            string        `json:"type"`
+}
+
+// BLSChange holds a bls to execution change included in the beacon chain
+type BLSChange struct {
+	Slot            uint64        `json:"slot"`
+	SlotRoot        hexutil.Bytes `json:"slot_root"`
+	Time            time.Time     `json:"time"`
+	Orphaned        bool          `json:"orphaned"`
+	ValidatorIndex  uint64        `json:"validator_index"`
+	ValidatorName   string        `json:"validator_name"`
+	ValidatorStatus string        `json:"validator_status"`
+	BlsPubkey       hexutil.Bytes `json:"bls_pubkey"`
+	Address         hexutil.Bytes `json:"address"`
+}
+
+// DepositRequest holds an execution layer deposit request included in the beacon chain (EIP-6110)
+type DepositRequest struct {
+	Slot                  uint64        `json:"slot"`
+	SlotRoot              hexutil.Bytes `json:"slot_root"`
+	Time                  time.Time     `json:"time"`
+	Orphaned              bool          `json:"orphaned"`
+	Index                 uint64        `json:"index"`
+	PublicKey             hexutil.Bytes `json:"pubkey"`
+	WithdrawalCredentials hexutil.Bytes `json:"withdrawal_credentials"`
+	Amount                uint64        `json:"amount"`
+	Signature             hexutil.Bytes `json:"signature"`
+}
+
+// WithdrawalRequest holds an execution layer withdrawal request included in the beacon chain (EIP-7002)
+type WithdrawalRequest struct {
+	Slot            uint64        `json:"slot"`
+	SlotRoot        hexutil.Bytes `json:"slot_root"`
+	Time            time.Time     `json:"time"`
+	Orphaned        bool          `json:"orphaned"`
+	SourceAddress   hexutil.Bytes `json:"source_address"`
+	ValidatorPubkey hexutil.Bytes `json:"validator_pubkey"`
+	ValidatorIndex  *uint64       `json:"validator_index,omitempty"`
+	ValidatorName   string        `json:"validator_name,omitempty"`
+	Amount          uint64        `json:"amount"`
+}
+
+// ConsolidationRequest holds an execution layer consolidation request included in the beacon chain (EIP-7251)
+type ConsolidationRequest struct {
+	Slot          uint64        `json:"slot"`
+	SlotRoot      hexutil.Bytes `json:"slot_root"`
+	Time          time.Time     `json:"time"`
+	Orphaned      bool          `json:"orphaned"`
+	SourceAddress hexutil.Bytes `json:"source_address"`
+	SourcePubkey  hexutil.Bytes `json:"source_pubkey"`
+	SourceIndex   *uint64       `json:"source_index,omitempty"`
+	SourceName    string        `json:"source_name,omitempty"`
+	TargetPubkey  hexutil.Bytes `json:"target_pubkey"`
+	TargetIndex   *uint64       `json:"target_index,omitempty"`
+	TargetName    string        `json:"target_name,omitempty"`
+}
+
+// Slashing holds a proposer or attester slashing included in the beacon chain
+type Slashing struct {
+	Slot            uint64        `json:"slot"`
+	SlotRoot        hexutil.Bytes `json:"slot_root"`
+	Time            time.Time     `json:"time"`
+	Orphaned        bool          `json:"orphaned"`
+	ValidatorIndex  uint64        `json:"validator_index"`
+	ValidatorName   string        `json:"validator_name"`
+	ValidatorStatus string        `json:"validator_status"`
+	Balance         uint64        `json:"balance"`
+	Reason          string        `json:"reason"`
+	SlasherIndex    uint64        `json:"slasher_index"`
+	SlasherName     string        `json:"slasher_name"`
+}
+
+// MevBlock holds a block payload delivered by one or more mev relays
+type MevBlock struct {
+	Slot           uint64        `json:"slot"`
+	BlockHash      hexutil.Bytes `json:"block_hash"`
+	BlockNumber    uint64        `json:"block_number"`
+	Time           time.Time     `json:"time"`
+	ValidatorIndex uint64        `json:"validator_index"`
+	ValidatorName  string        `json:"validator_name"`
+	BuilderPubkey  hexutil.Bytes `json:"builder_pubkey"`
+	Proposed       string        `json:"proposed"`
+	Relays         []string      `json:"relays"`
+	FeeRecipient   hexutil.Bytes `json:"fee_recipient"`
+	TxCount        uint64        `json:"tx_count"`
+	GasUsed        uint64        `json:"gas_used"`
+	BlockValue     uint64        `json:"block_value"`
+}
@@ -0,0 +1,27 @@
+package apitypes
+
+import (
+	_ "embed"
+	"encoding/json"
+)
+
+// OpenApiSpec holds the OpenAPI 3 document describing the explorer api
+//
+//go:embed openapi.json
+var OpenApiSpec []byte
+
+// GetOpenApiPaths returns the list of paths documented in the OpenAPI document
+func GetOpenApiPaths() ([]string, error) {
+	spec := struct {
+		Paths map[string]interface{} `json:"paths"`
+	}{}
+	err := json.Unmarshal(OpenApiSpec, &spec)
+	if err != nil {
+		return nil, err
+	}
+	paths := make([]string, 0, len(spec.Paths))
+	for path := range spec.Paths {
+		paths = append(paths, path)
+	}
+	return paths, nil
+}
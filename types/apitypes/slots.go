@@ -0,0 +1,202 @@
+package apitypes
+
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// Slot holds the summary of a slot as returned by the slots endpoints
+type Slot struct {
+	Slot                  uint64        `json:"slot"`
+	Epoch                 uint64        `json:"epoch"`
+	Time                  time.Time     `json:"time"`
+	Finalized             bool          `json:"finalized"`
+	Scheduled             bool          `json:"scheduled"`
+	Status                string        `json:"status"`
+	Proposer              uint64        `json:"proposer"`
+	ProposerName          string        `json:"proposer_name"`
+	AttestationCount      uint64        `json:"attestation_count"`
+	DepositCount          uint64        `json:"deposit_count"`
+	ExitCount             uint64        `json:"exit_count"`
+	ProposerSlashingCount uint64        `json:"proposer_slashing_count"`
+	AttesterSlashingCount uint64        `json:"attester_slashing_count"`
+	SyncParticipation     float64       `json:"sync_participation"`
+	EthTransactionCount   uint64        `json:"eth_transaction_count"`
+	EthBlockNumber        *uint64       `json:"eth_block_number,omitempty"`
+	Graffiti              hexutil.Bytes `json:"graffiti,omitempty"`
+	ElExtraData           hexutil.Bytes `json:"el_extra_data,omitempty"`
+	BlockRoot             hexutil.Bytes `json:"block_root,omitempty"`
+	ParentRoot            hexutil.Bytes `json:"parent_root,omitempty"`
+}
+
+// SlotDetails holds the details of a single slot
+type SlotDetails struct {
+	Slot           uint64     `json:"slot"`
+	Epoch          uint64     `json:"epoch"`
+	EpochFinalized bool       `json:"epoch_finalized"`
+	Time           time.Time  `json:"time"`
+	Status         string     `json:"status"`
+	Proposer       uint64     `json:"proposer"`
+	ProposerName   string     `json:"proposer_name"`
+	Block          *SlotBlock `json:"block,omitempty"`
+}
+
+// SlotBlock holds the beacon block of a slot
+type SlotBlock struct {
+	BlockRoot              hexutil.Bytes `json:"block_root"`
+	ParentRoot             hexutil.Bytes `json:"parent_root"`
+	StateRoot              hexutil.Bytes `json:"state_root"`
+	Signature              hexutil.Bytes `json:"signature"`
+	RandaoReveal           hexutil.Bytes `json:"randao_reveal"`
+	Graffiti               hexutil.Bytes `json:"graffiti"`
+	Eth1DataDepositRoot    hexutil.Bytes `json:"eth1data_deposit_root"`
+	Eth1DataDepositCount   uint64        `json:"eth1data_deposit_count"`
+	Eth1DataBlockHash      hexutil.Bytes `json:"eth1data_block_hash"`
+	SyncAggregateBits      hexutil.Bytes `json:"sync_aggregate_bits,omitempty"`
+	SyncAggregateSignature hexutil.Bytes `json:"sync_aggregate_signature,omitempty"`
+	SyncParticipation      float64       `json:"sync_participation"`
+
+	ExecutionPayload  *SlotExecutionPayload   `json:"execution_payload,omitempty"`
+	Attestations      []*SlotAttestation      `json:"attestations"`
+	Deposits          []*SlotDeposit          `json:"deposits"`
+	VoluntaryExits    []*SlotVoluntaryExit    `json:"voluntary_exits"`
+	ProposerSlashings []*SlotProposerSlashing `json:"proposer_slashings"`
+	AttesterSlashings []*SlotAttesterSlashing `json:"attester_slashings"`
+	BLSChanges        []*SlotBLSChange        `json:"bls_changes"`
+	Withdrawals       []*SlotWithdrawal       `json:"withdrawals"`
+	Blobs             []*SlotBlob             `json:"blobs"`
+	Transactions      []*SlotTransaction      `json:"transactions"`
+
+	DepositRequests       []*SlotDepositRequest       `json:"deposit_requests,omitempty"`
+	WithdrawalRequests    []*SlotWithdrawalRequest    `json:"withdrawal_requests,omitempty"`
+	ConsolidationRequests []*SlotConsolidationRequest `json:"consolidation_requests,omitempty"`
+}
+
+// SlotExecutionPayload holds the execution payload of a block
+type SlotExecutionPayload struct {
+	BlockNumber   uint64        `json:"block_number"`
+	BlockHash     hexutil.Bytes `json:"block_hash"`
+	ParentHash    hexutil.Bytes `json:"parent_hash"`
+	FeeRecipient  hexutil.Bytes `json:"fee_recipient"`
+	StateRoot     hexutil.Bytes `json:"state_root"`
+	ReceiptsRoot  hexutil.Bytes `json:"receipts_root"`
+	LogsBloom     hexutil.Bytes `json:"logs_bloom"`
+	Random        hexutil.Bytes `json:"random"`
+	GasLimit      uint64        `json:"gas_limit"`
+	GasUsed       uint64        `json:"gas_used"`
+	Timestamp     uint64        `json:"timestamp"`
+	ExtraData     hexutil.Bytes `json:"extra_data"`
+	BaseFeePerGas uint64        `json:"base_fee_per_gas"`
+}
+
+// SlotAttestation holds an attestation included in a block
+type SlotAttestation struct {
+	Slot            uint64        `json:"slot"`
+	CommitteeIndex  uint64        `json:"committee_index"`
+	AggregationBits hexutil.Bytes `json:"aggregation_bits"`
+	Validators      []uint64      `json:"validators,omitempty"`
+	Signature       hexutil.Bytes `json:"signature"`
+	BeaconBlockRoot hexutil.Bytes `json:"beacon_block_root"`
+	SourceEpoch     uint64        `json:"source_epoch"`
+	SourceRoot      hexutil.Bytes `json:"source_root"`
+	TargetEpoch     uint64        `json:"target_epoch"`
+	TargetRoot      hexutil.Bytes `json:"target_root"`
+}
+
+// SlotDeposit holds a deposit included in a block
+type SlotDeposit struct {
+	PublicKey             hexutil.Bytes `json:"pubkey"`
+	WithdrawalCredentials hexutil.Bytes `json:"withdrawal_credentials"`
+	Amount                uint64        `json:"amount"`
+	Signature             hexutil.Bytes `json:"signature"`
+}
+
+// SlotVoluntaryExit holds a voluntary exit included in a block
+type SlotVoluntaryExit struct {
+	ValidatorIndex uint64        `json:"validator_index"`
+	ValidatorName  string        `json:"validator_name"`
+	Epoch          uint64        `json:"epoch"`
+	Signature      hexutil.Bytes `json:"signature"`
+}
+
+// SlotProposerSlashing holds a proposer slashing included in a block
+type SlotProposerSlashing struct {
+	ProposerIndex uint64 `json:"proposer_index"`
+	ProposerName  string `json:"proposer_name"`
+	Header1Slot   uint64 `json:"header1_slot"`
+	Header2Slot   uint64 `json:"header2_slot"`
+}
+
+// SlotAttesterSlashing holds an attester slashing included in a block
+type SlotAttesterSlashing struct {
+	Attestation1Indices []uint64 `json:"attestation1_indices"`
+	Attestation2Indices []uint64 `json:"attestation2_indices"`
+	SlashedValidators   []uint64 `json:"slashed_validators"`
+}
+
+// SlotBLSChange holds a bls to execution change included in a block
+type SlotBLSChange struct {
+	ValidatorIndex uint64        `json:"validator_index"`
+	ValidatorName  string        `json:"validator_name"`
+	BlsPubkey      hexutil.Bytes `json:"bls_pubkey"`
+	Address        hexutil.Bytes `json:"address"`
+	Signature      hexutil.Bytes `json:"signature"`
+}
+
+// SlotWithdrawal holds a withdrawal included in a block
+type SlotWithdrawal struct {
+	Index          uint64        `json:"index"`
+	ValidatorIndex uint64        `json:"validator_index"`
+	ValidatorName  string        `json:"validator_name"`
+	Address        hexutil.Bytes `json:"address"`
+	Amount         uint64        `json:"amount"`
+}
+
+// SlotBlob holds a blob commitment included in a block
+type SlotBlob struct {
+	Index         uint64        `json:"index"`
+	KzgCommitment hexutil.Bytes `json:"kzg_commitment"`
+}
+
+// SlotDepositRequest holds an execution layer deposit request included in a block (EIP-6110)
+type SlotDepositRequest struct {
+	Index                 uint64        `json:"index"`
+	PublicKey             hexutil.Bytes `json:"pubkey"`
+	WithdrawalCredentials hexutil.Bytes `json:"withdrawal_credentials"`
+	Amount                uint64        `json:"amount"`
+	Signature             hexutil.Bytes `json:"signature"`
+}
+
+// SlotWithdrawalRequest holds an execution layer withdrawal request included in a block (EIP-7002)
+type SlotWithdrawalRequest struct {
+	SourceAddress   hexutil.Bytes `json:"source_address"`
+	ValidatorPubkey hexutil.Bytes `json:"validator_pubkey"`
+	ValidatorIndex  *uint64       `json:"validator_index,omitempty"`
+	ValidatorName   string        `json:"validator_name,omitempty"`
+	Amount          uint64        `json:"amount"`
+}
+
+// SlotConsolidationRequest holds an execution layer consolidation request included in a block (EIP-7251)
+type SlotConsolidationRequest struct {
+	SourceAddress hexutil.Bytes `json:"source_address"`
+	SourcePubkey  hexutil.Bytes `json:"source_pubkey"`
+	SourceIndex   *uint64       `json:"source_index,omitempty"`
+	SourceName    string        `json:"source_name,omitempty"`
+	TargetPubkey  hexutil.Bytes `json:"target_pubkey"`
+	TargetIndex   *uint64       `json:"target_index,omitempty"`
+	TargetName    string        `json:"target_name,omitempty"`
+}
+
+// SlotTransaction holds an execution transaction included in a block
+type SlotTransaction struct {
+	Index    uint64        `json:"index"`
+	Hash     hexutil.Bytes `json:"hash"`
+	From     string        `json:"from"`
+	To       string        `json:"to"`
+	Value    float64       `json:"value"`
+	Type     uint64        `json:"type"`
+	DataLen  uint64        `json:"data_len"`
+	FuncSig  string        `json:"func_sig,omitempty"`
+	FuncName string        `json:"func_name,omitempty"`
+}
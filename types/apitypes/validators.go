@@ -0,0 +1,66 @@
+package apitypes
+
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// Validator holds the details of a single validator
+type Validator struct {
+	Index                 uint64            `json:"index"`
+	Name                  string            `json:"name"`
+	PublicKey             hexutil.Bytes     `json:"pubkey"`
+	Balance               uint64            `json:"balance"`
+	EffectiveBalance      uint64            `json:"effective_balance"`
+	State                 string            `json:"state"`
+	BeaconState           string            `json:"beacon_state"`
+	EligibilityEpoch      *uint64           `json:"eligibility_epoch,omitempty"`
+	ActivationEpoch       *uint64           `json:"activation_epoch,omitempty"`
+	ExitEpoch             *uint64           `json:"exit_epoch,omitempty"`
+	WithdrawalCredentials hexutil.Bytes     `json:"withdrawal_credentials"`
+	WithdrawalAddress     hexutil.Bytes     `json:"withdrawal_address,omitempty"`
+	UpcheckActivity       uint8             `json:"upcheck_activity"`
+	UpcheckMaximum        uint8             `json:"upcheck_maximum"`
+	RecentBlocks          []*ValidatorBlock `json:"recent_blocks"`
+}
+
+// ValidatorBlock holds a slot proposed by a validator
+type ValidatorBlock struct {
+	Slot           uint64        `json:"slot"`
+	Epoch          uint64        `json:"epoch"`
+	Time           time.Time     `json:"time"`
+	Status         string        `json:"status"`
+	EthBlockNumber *uint64       `json:"eth_block_number,omitempty"`
+	BlockRoot      string        `json:"block_root,omitempty"`
+	Graffiti       hexutil.Bytes `json:"graffiti,omitempty"`
+}
+
+// ValidatorAttestation holds the attestation outcome of a validator for an epoch
+type ValidatorAttestation struct {
+	Epoch             uint64    `json:"epoch"`
+	Time              time.Time `json:"time"`
+	Status            string    `json:"status"`
+	SourceCorrect     bool      `json:"source_correct"`
+	TargetCorrect     bool      `json:"target_correct"`
+	HeadCorrect       bool      `json:"head_correct"`
+	InclusionDistance *uint64   `json:"inclusion_distance,omitempty"`
+}
+
+// ValidatorQueueEstimate holds the position of a validator in the activation or exit queue and the estimated epoch it leaves the queue.
+// Queue is "activation", "exit" or "none". NextExitEpoch is the estimated exit epoch of an active validator that initiates its exit now.
+type ValidatorQueueEstimate struct {
+	Index          uint64        `json:"index"`
+	PublicKey      hexutil.Bytes `json:"pubkey"`
+	Epoch          uint64        `json:"epoch"`
+	Queue          string        `json:"queue"`
+	Position       *uint64       `json:"position,omitempty"`
+	QueueLength    uint64        `json:"queue_length"`
+	Churn          uint64        `json:"churn"`
+	BalanceChurn   bool          `json:"balance_churn"`
+	Scheduled      bool          `json:"scheduled"`
+	EstimatedEpoch *uint64       `json:"estimated_epoch,omitempty"`
+	EstimatedTime  *time.Time    `json:"estimated_time,omitempty"`
+	NextExitEpoch  *uint64       `json:"next_exit_epoch,omitempty"`
+	NextExitTime   *time.Time    `json:"next_exit_time,omitempty"`
+}
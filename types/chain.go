@@ -27,6 +27,11 @@ type ChainConfig struct {
 	DenebForkEpoch                   uint64 `yaml:"DENEB_FORK_EPOCH"`
 	ShardingForkVersion              string `yaml:"SHARDING_FORK_VERSION"`
 	ShardingForkEpoch                uint64 `yaml:"SHARDING_FORK_EPOCH"`
+
+	// electra fork epoch is a pointer, as it is unset for most network configs yet
+	ElectraForkVersion string  `yaml:"ELECTRA_FORK_VERSION"`
+	ElectraForkEpoch   *uint64 `yaml:"ELECTRA_FORK_EPOCH"`
+
 	SecondsPerSlot                   uint64 `yaml:"SECONDS_PER_SLOT"`
 	SecondsPerEth1Block              uint64 `yaml:"SECONDS_PER_ETH1_BLOCK"`
 	MinValidatorWithdrawabilityDelay uint64 `yaml:"MIN_VALIDATOR_WITHDRAWABILITY_DELAY"`
@@ -42,6 +47,13 @@ type ChainConfig struct {
 	DepositNetworkID                 uint64 `yaml:"DEPOSIT_NETWORK_ID"`
 	DepositContractAddress           string `yaml:"DEPOSIT_CONTRACT_ADDRESS"`
 
+	// deneb
+	MaxPerEpochActivationChurnLimit uint64 `yaml:"MAX_PER_EPOCH_ACTIVATION_CHURN_LIMIT"`
+
+	// electra
+	MinPerEpochChurnLimitElectra        uint64 `yaml:"MIN_PER_EPOCH_CHURN_LIMIT_ELECTRA"`
+	MaxPerEpochActivationExitChurnLimit uint64 `yaml:"MAX_PER_EPOCH_ACTIVATION_EXIT_CHURN_LIMIT"`
+
 	// phase0
 	// https://github.com/ethereum/consensus-specs/blob/dev/presets/mainnet/phase0.yaml
 	MaxCommitteesPerSlot           uint64 `yaml:"MAX_COMMITTEES_PER_SLOT"`
@@ -102,4 +114,12 @@ type ChainConfig struct {
 	MaxWithdrawalsPerPayload        uint64 `yaml:"MAX_WITHDRAWALS_PER_PAYLOAD"`
 	MaxValidatorsPerWithdrawalSweep uint64 `yaml:"MAX_VALIDATORS_PER_WITHDRAWALS_SWEEP"`
 	MaxBlsToExecutionChange         uint64 `yaml:"MAX_BLS_TO_EXECUTION_CHANGES"`
+
+	// electra
+	// https://github.com/ethereum/consensus-specs/blob/dev/presets/mainnet/electra.yaml
+	MinActivationBalance               uint64 `yaml:"MIN_ACTIVATION_BALANCE"`
+	MaxEffectiveBalanceElectra         uint64 `yaml:"MAX_EFFECTIVE_BALANCE_ELECTRA"`
+	MaxDepositRequestsPerPayload       uint64 `yaml:"MAX_DEPOSIT_REQUESTS_PER_PAYLOAD"`
+	MaxWithdrawalRequestsPerPayload    uint64 `yaml:"MAX_WITHDRAWAL_REQUESTS_PER_PAYLOAD"`
+	MaxConsolidationRequestsPerPayload uint64 `yaml:"MAX_CONSOLIDATION_REQUESTS_PER_PAYLOAD"`
 }
@@ -53,6 +53,12 @@ type Config struct {
 		AllowDutyLoading bool          `yaml:"allowDutyLoading" envconfig:"FRONTEND_ALLOW_DUTY_LOADING"`
 	} `yaml:"frontend"`
 
+	Metrics struct {
+		Enabled bool   `yaml:"enabled" envconfig:"METRICS_ENABLED"`
+		Host    string `yaml:"host" envconfig:"METRICS_HOST"`
+		Port    string `yaml:"port" envconfig:"METRICS_PORT"`
+	} `yaml:"metrics"`
+
 	RateLimit struct {
 		Enabled    bool `yaml:"enabled" envconfig:"RATELIMIT_ENABLED"`
 		ProxyCount uint `yaml:"proxyCount" envconfig:"RATELIMIT_PROXY_COUNT"`
@@ -88,6 +94,11 @@ type Config struct {
 		DisableSynchronizer             bool   `yaml:"disableSynchronizer" envconfig:"INDEXER_DISABLE_SYNCHRONIZER"`
 		SyncEpochCooldown               uint   `yaml:"syncEpochCooldown" envconfig:"INDEXER_SYNC_EPOCH_COOLDOWN"`
 		MaxParallelValidatorSetRequests uint   `yaml:"maxParallelValidatorSetRequests" envconfig:"INDEXER_MAX_PARALLEL_VALIDATOR_SET_REQUESTS"`
+		DisableAttestationHistory       bool   `yaml:"disableAttestationHistory" envconfig:"INDEXER_DISABLE_ATTESTATION_HISTORY"`
+		BalanceSnapshotInterval         uint64 `yaml:"balanceSnapshotInterval" envconfig:"INDEXER_BALANCE_SNAPSHOT_INTERVAL"`
+		EnableRewardsHistory            bool   `yaml:"enableRewardsHistory" envconfig:"INDEXER_ENABLE_REWARDS_HISTORY"`
+		EnableTransactionIndex          bool   `yaml:"enableTransactionIndex" envconfig:"INDEXER_ENABLE_TRANSACTION_INDEX"`
+		TransactionIndexStartBlock      uint64 `yaml:"transactionIndexStartBlock" envconfig:"INDEXER_TRANSACTION_INDEX_START_BLOCK"`
 	} `yaml:"indexer"`
 
 	BlobStore struct {
@@ -102,7 +113,18 @@ type Config struct {
 			SecretKey string `yaml:"secretKey" envconfig:"BLOBSTORE_AWS_SECRETKEY"`
 			S3Region  string `yaml:"s3Region" envconfig:"BLOBSTORE_AWS_S3REGION"`
 			S3Bucket  string `yaml:"s3Bucket" envconfig:"BLOBSTORE_AWS_S3BUCKET"`
+			Endpoint  string `yaml:"endpoint" envconfig:"BLOBSTORE_AWS_ENDPOINT"`
+			PathStyle bool   `yaml:"pathStyle" envconfig:"BLOBSTORE_AWS_PATH_STYLE"`
 		} `yaml:"aws"`
+		Tiered struct {
+			HotEpochs uint64 `yaml:"hotEpochs" envconfig:"BLOBSTORE_TIERED_HOT_EPOCHS"`
+		} `yaml:"tiered"`
+		Retention struct {
+			Epochs        uint64        `yaml:"epochs" envconfig:"BLOBSTORE_RETENTION_EPOCHS"`
+			MaxSizeMB     uint64        `yaml:"maxSizeMB" envconfig:"BLOBSTORE_RETENTION_MAX_SIZE_MB"`
+			Addresses     []string      `yaml:"addresses" envconfig:"BLOBSTORE_RETENTION_ADDRESSES"`
+			PruneInterval time.Duration `yaml:"pruneInterval" envconfig:"BLOBSTORE_RETENTION_PRUNE_INTERVAL"`
+		} `yaml:"retention"`
 	} `yaml:"blobstore"`
 
 	TxSignature struct {
@@ -119,6 +141,19 @@ type Config struct {
 		RefreshInterval time.Duration    `yaml:"refreshInterval" envconfig:"MEVINDEXER_REFRESH_INTERVAL"`
 	} `yaml:"mevIndexer"`
 
+	ClientDiversity struct {
+		Disabled  bool                  `yaml:"disabled" envconfig:"CLIENTDIVERSITY_DISABLED"`
+		RulesFile string                `yaml:"rulesFile" envconfig:"CLIENTDIVERSITY_RULES_FILE"` // replaces the default rule set
+		Rules     []ClientDiversityRule `yaml:"rules"`                                            // evaluated before the default rule set
+	} `yaml:"clientDiversity"`
+
+	Notifications struct {
+		Enabled    bool                        `yaml:"enabled" envconfig:"NOTIFICATIONS_ENABLED"`
+		RetryCount int                         `yaml:"retryCount" envconfig:"NOTIFICATIONS_RETRY_COUNT"`
+		RetryDelay time.Duration               `yaml:"retryDelay" envconfig:"NOTIFICATIONS_RETRY_DELAY"`
+		Webhooks   []NotificationWebhookConfig `yaml:"webhooks"`
+	} `yaml:"notifications"`
+
 	Database struct {
 		Engine string `yaml:"engine" envconfig:"DATABASE_ENGINE"`
 		Sqlite struct {
@@ -177,6 +212,22 @@ type MevRelayConfig struct {
 	BlockLimit int    `yaml:"blockLimit"`
 }
 
+type ClientDiversityRule struct {
+	Client  string `yaml:"client"`
+	Layer   string `yaml:"layer"`   // cl, el
+	Source  string `yaml:"source"`  // graffiti, extra
+	Pattern string `yaml:"pattern"` // regular expression
+}
+
+type NotificationWebhookConfig struct {
+	Name       string            `yaml:"name"`
+	Url        string            `yaml:"url"`
+	Format     string            `yaml:"format"` // json, slack, discord
+	Headers    map[string]string `yaml:"headers"`
+	Events     []string          `yaml:"events"`     // missed_proposal, slashing, voluntary_exit, fork (empty = all)
+	Validators []uint64          `yaml:"validators"` // empty = all validators
+}
+
 type SqliteDatabaseConfig struct {
 	File         string
 	MaxOpenConns int
@@ -0,0 +1,119 @@
+package models
+
+import (
+	"time"
+)
+
+// AddressPageData is a struct to hold info for the address page
+type AddressPageData struct {
+	Address        []byte `json:"address"`
+	TxIndexEnabled bool   `json:"tx_index_enabled"`
+
+	Transactions      []*AddressPageDataTransaction `json:"transactions"`
+	TransactionCount  uint64                        `json:"transaction_count"`
+	TotalTransactions uint64                        `json:"total_transactions"`
+
+	Deposits            []*AddressPageDataDeposit    `json:"deposits"`
+	DepositCount        uint64                       `json:"deposit_count"`
+	TotalDeposits       uint64                       `json:"total_deposits"`
+	TargetDeposits      []*AddressPageDataDeposit    `json:"target_deposits"`
+	TargetDepositCount  uint64                       `json:"target_deposit_count"`
+	TotalTargetDeposits uint64                       `json:"total_target_deposits"`
+	Withdrawals         []*AddressPageDataWithdrawal `json:"withdrawals"`
+	WithdrawalCount     uint64                       `json:"withdrawal_count"`
+	TotalWithdrawals    uint64                       `json:"total_withdrawals"`
+	Validators          []*AddressPageDataValidator  `json:"validators"`
+	ValidatorCount      uint64                       `json:"validator_count"`
+	TotalValidators     uint64                       `json:"total_validators"`
+	Blocks              []*AddressPageDataBlock      `json:"blocks"`
+	BlockCount          uint64                       `json:"block_count"`
+	HasMoreBlocks       bool                         `json:"has_more_blocks"`
+	MevBlocks           []*AddressPageDataMevBlock   `json:"mev_blocks"`
+	MevBlockCount       uint64                       `json:"mev_block_count"`
+	TotalMevBlocks      uint64                       `json:"total_mev_blocks"`
+
+	IsDefaultPage    bool   `json:"default_page"`
+	TotalPages       uint64 `json:"total_pages"`
+	PageSize         uint64 `json:"page_size"`
+	CurrentPageIndex uint64 `json:"page_index"`
+	PrevPageIndex    uint64 `json:"prev_page_index"`
+	NextPageIndex    uint64 `json:"next_page_index"`
+	LastPageIndex    uint64 `json:"last_page_index"`
+
+	FirstPageLink string `json:"first_page_link"`
+	PrevPageLink  string `json:"prev_page_link"`
+	NextPageLink  string `json:"next_page_link"`
+	LastPageLink  string `json:"last_page_link"`
+}
+
+type AddressPageDataTransaction struct {
+	TxHash          []byte    `json:"tx_hash"`
+	BlockNumber     uint64    `json:"block_number"`
+	Time            time.Time `json:"time"`
+	From            []byte    `json:"from"`
+	To              []byte    `json:"to"`
+	ContractAddress []byte    `json:"contract_address"`
+	IsOutgoing      bool      `json:"is_outgoing"`
+	Value           float64   `json:"value"`
+	FuncSigStatus   uint64    `json:"func_sig_status"`
+	FuncBytes       string    `json:"func_bytes"`
+	FuncName        string    `json:"func_name"`
+	FuncSig         string    `json:"func_sig"`
+	HasReceipt      bool      `json:"has_receipt"`
+	Success         bool      `json:"success"`
+}
+
+type AddressPageDataDeposit struct {
+	Index     uint64    `json:"index"`
+	PublicKey []byte    `json:"pubkey"`
+	Amount    uint64    `json:"amount"`
+	TxHash    []byte    `json:"tx_hash"`
+	Time      time.Time `json:"time"`
+	Block     uint64    `json:"block"`
+	Orphaned  bool      `json:"orphaned"`
+	Valid     bool      `json:"valid"`
+}
+
+type AddressPageDataWithdrawal struct {
+	Index          uint64    `json:"index"`
+	Slot           uint64    `json:"slot"`
+	SlotRoot       []byte    `json:"slot_root"`
+	Time           time.Time `json:"time"`
+	Orphaned       bool      `json:"orphaned"`
+	ValidatorIndex uint64    `json:"vindex"`
+	ValidatorName  string    `json:"vname"`
+	Amount         uint64    `json:"amount"`
+}
+
+type AddressPageDataValidator struct {
+	Index            uint64 `json:"index"`
+	Name             string `json:"name"`
+	PublicKey        []byte `json:"pubkey"`
+	Balance          uint64 `json:"balance"`
+	EffectiveBalance uint64 `json:"eff_balance"`
+	State            string `json:"state"`
+}
+
+type AddressPageDataBlock struct {
+	Slot         uint64    `json:"slot"`
+	BlockRoot    []byte    `json:"block_root"`
+	Time         time.Time `json:"time"`
+	Orphaned     bool      `json:"orphaned"`
+	Proposer     uint64    `json:"proposer"`
+	ProposerName string    `json:"proposer_name"`
+	EthBlock     uint64    `json:"eth_block"`
+	EthTxCount   uint64    `json:"eth_tx_count"`
+	Graffiti     []byte    `json:"graffiti"`
+}
+
+type AddressPageDataMevBlock struct {
+	Slot         uint64    `json:"slot"`
+	BlockHash    []byte    `json:"block_hash"`
+	BlockNumber  uint64    `json:"block_number"`
+	Time         time.Time `json:"time"`
+	Proposed     uint8     `json:"proposed"`
+	Proposer     uint64    `json:"proposer"`
+	ProposerName string    `json:"proposer_name"`
+	TxCount      uint64    `json:"tx_count"`
+	BlockValue   uint64    `json:"block_value"`
+}
@@ -0,0 +1,6 @@
+package models
+
+// ApiDocsPageData is a struct to hold info for the api docs page
+type ApiDocsPageData struct {
+	SpecUrl string `json:"spec_url"`
+}
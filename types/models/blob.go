@@ -0,0 +1,35 @@
+package models
+
+import (
+	"time"
+)
+
+// BlobPageData is a struct to hold info for the blob page
+type BlobPageData struct {
+	Commitment    []byte `json:"commitment"`
+	VersionedHash []byte `json:"versioned_hash"`
+	Proof         []byte `json:"proof"`
+	Size          uint64 `json:"size"`
+
+	Assignments     []*BlobPageDataAssignment `json:"assignments"`
+	AssignmentCount uint64                    `json:"assignment_count"`
+	TxHash          []byte                    `json:"tx_hash"`
+	TxSender        []byte                    `json:"tx_sender"`
+
+	Pruned      bool   `json:"pruned"`
+	HaveData    bool   `json:"have_data"`
+	DataPreview []byte `json:"data_preview"`
+	IsShort     bool   `json:"is_short"`
+	DataLink    string `json:"data_link"`
+
+	KzgVerified bool   `json:"kzg_verified"`
+	KzgValid    bool   `json:"kzg_valid"`
+	KzgError    string `json:"kzg_error"`
+}
+
+type BlobPageDataAssignment struct {
+	Slot      uint64    `json:"slot"`
+	BlockRoot []byte    `json:"block_root"`
+	Time      time.Time `json:"time"`
+	BlobIndex uint64    `json:"blob_index"`
+}
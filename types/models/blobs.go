@@ -0,0 +1,57 @@
+package models
+
+import (
+	"time"
+)
+
+// BlobsPageData is a struct to hold info for the blobs page
+type BlobsPageData struct {
+	FilterMinSlot uint64 `json:"filter_mins"`
+	FilterMaxSlot uint64 `json:"filter_maxs"`
+	FilterSender  string `json:"filter_sender"`
+	FilterHash    string `json:"filter_hash"`
+
+	Blobs     []*BlobsPageDataBlob `json:"blobs"`
+	BlobCount uint64               `json:"blob_count"`
+	TotalRows uint64               `json:"total_rows"`
+
+	Range            string `json:"range"`
+	ChartCount       uint64 `json:"chart_count"`
+	ChartMinEpoch    uint64 `json:"chart_min_epoch"`
+	ChartMaxEpoch    uint64 `json:"chart_max_epoch"`
+	BlobCountChart   string `json:"blob_count_chart"`
+	BlobCountMax     uint64 `json:"blob_count_max"`
+	BlobGasChart     string `json:"blob_gas_chart"`
+	BlobGasMax       uint64 `json:"blob_gas_max"`
+	BlobBaseFeeChart string `json:"blob_base_fee_chart"`
+	BlobBaseFeeMax   uint64 `json:"blob_base_fee_max"`
+	RangeBlobCount   uint64 `json:"range_blob_count"`
+	RangeBlobGas     uint64 `json:"range_blob_gas"`
+
+	IsDefaultPage    bool   `json:"default_page"`
+	TotalPages       uint64 `json:"total_pages"`
+	PageSize         uint64 `json:"page_size"`
+	CurrentPageIndex uint64 `json:"page_index"`
+	PrevPageIndex    uint64 `json:"prev_page_index"`
+	NextPageIndex    uint64 `json:"next_page_index"`
+	LastPageIndex    uint64 `json:"last_page_index"`
+
+	FirstPageLink string `json:"first_page_link"`
+	PrevPageLink  string `json:"prev_page_link"`
+	NextPageLink  string `json:"next_page_link"`
+	LastPageLink  string `json:"last_page_link"`
+	RangeLink     string `json:"range_link"`
+}
+
+type BlobsPageDataBlob struct {
+	Slot          uint64    `json:"slot"`
+	BlockRoot     []byte    `json:"block_root"`
+	Time          time.Time `json:"time"`
+	BlobIndex     uint64    `json:"blob_index"`
+	Commitment    []byte    `json:"commitment"`
+	VersionedHash []byte    `json:"versioned_hash"`
+	TxHash        []byte    `json:"tx_hash"`
+	TxSender      []byte    `json:"tx_sender"`
+	Size          uint64    `json:"size"`
+	Pruned        bool      `json:"pruned"`
+}
@@ -0,0 +1,65 @@
+package models
+
+import (
+	"time"
+)
+
+// ClientDiversityPageData is a struct to hold info for the client diversity page
+type ClientDiversityPageData struct {
+	Range      string    `json:"range"`
+	MinEpoch   uint64    `json:"min_epoch"`
+	MaxEpoch   uint64    `json:"max_epoch"`
+	StartTs    time.Time `json:"start_ts"`
+	EndTs      time.Time `json:"end_ts"`
+	EpochCount uint64    `json:"epoch_count"`
+
+	TotalProposed uint64  `json:"total_proposed"`
+	TotalMissed   uint64  `json:"total_missed"`
+	MissedRate    float64 `json:"missed_rate"`
+
+	ClLayer *ClientDiversityPageDataLayer   `json:"cl_layer"`
+	ElLayer *ClientDiversityPageDataLayer   `json:"el_layer"`
+	Pairs   []*ClientDiversityPageDataPair  `json:"pairs"`
+	Epochs  []*ClientDiversityPageDataEpoch `json:"epochs"`
+}
+
+type ClientDiversityPageDataLayer struct {
+	Title   string                           `json:"title"`
+	Clients []*ClientDiversityPageDataClient `json:"clients"`
+	Chart   []*ClientDiversityPageDataSeries `json:"chart"`
+}
+
+// ClientDiversityPageDataClient holds the aggregated proposals of a single cl or el client.
+// Share is the share of all assigned slots (proposed + missed), which approximates the share of validators running the client.
+type ClientDiversityPageDataClient struct {
+	Name          string  `json:"name"`
+	Color         string  `json:"color"`
+	ProposedCount uint64  `json:"proposed"`
+	MissedCount   uint64  `json:"missed"`
+	Share         float64 `json:"share"`
+	MissedRate    float64 `json:"missed_rate"`
+}
+
+type ClientDiversityPageDataPair struct {
+	ClClient      string  `json:"cl_client"`
+	ElClient      string  `json:"el_client"`
+	ProposedCount uint64  `json:"proposed"`
+	MissedCount   uint64  `json:"missed"`
+	Share         float64 `json:"share"`
+	MissedRate    float64 `json:"missed_rate"`
+}
+
+// ClientDiversityPageDataSeries is the stacked area of a client in the share chart (svg polygon points in a 1000x200 viewbox)
+type ClientDiversityPageDataSeries struct {
+	Name   string `json:"name"`
+	Color  string `json:"color"`
+	Points string `json:"points"`
+}
+
+type ClientDiversityPageDataEpoch struct {
+	Epoch         uint64 `json:"epoch"`
+	ClClient      string `json:"cl_client"`
+	ElClient      string `json:"el_client"`
+	ProposedCount uint64 `json:"proposed"`
+	MissedCount   uint64 `json:"missed"`
+}
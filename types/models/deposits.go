@@ -10,6 +10,23 @@ type DepositsPageData struct {
 	InitiatedDepositCount uint64                              `json:"initiated_deposit_count"`
 	IncludedDeposits      []*DepositsPageDataIncludedDeposit  `json:"included_deposits"`
 	IncludedDepositCount  uint64                              `json:"included_deposit_count"`
+	DepositTree           *DepositsPageDataTree               `json:"deposit_tree"`
+}
+
+// DepositsPageDataTree holds the state of the deposit merkle tree and its verification against the deposit contract & finalized eth1 data
+type DepositsPageDataTree struct {
+	DepositCount    uint64 `json:"deposit_count"`
+	DepositRoot     []byte `json:"deposit_root"`
+	FinalBlock      uint64 `json:"final_block"`
+	ContractChecked bool   `json:"contract_checked"`
+	ContractBlock   uint64 `json:"contract_block"`
+	ContractMatch   bool   `json:"contract_match"`
+	ContractCount   uint64 `json:"contract_count"`
+	ContractRoot    []byte `json:"contract_root"`
+	Eth1DataChecked bool   `json:"eth1data_checked"`
+	Eth1DataMatch   bool   `json:"eth1data_match"`
+	Eth1DataCount   uint64 `json:"eth1data_count"`
+	Eth1DataRoot    []byte `json:"eth1data_root"`
 }
 
 type DepositsPageDataInitiatedDeposit struct {
@@ -35,26 +35,38 @@ type EpochPageData struct {
 	ScheduledCount          uint64               `json:"scheduled_count"`
 	OrphanedCount           uint64               `json:"orphaned_count"`
 	EthTransactionCount     uint64               `json:"eth_transaction_count"`
+	ProposerClRewards       uint64               `json:"proposer_cl_rewards"`
+	ProposerPriorityFees    uint64               `json:"proposer_priority_fees"`
+	RewardsLoaded           bool                 `json:"rewards_loaded"`
+	IdealRewards            uint64               `json:"ideal_rewards"`
+	AttestationRewards      uint64               `json:"attestation_rewards"`
+	AttestationPenalties    uint64               `json:"attestation_penalties"`
+	InactivityPenalties     uint64               `json:"inactivity_penalties"`
+	SyncRewards             uint64               `json:"sync_rewards"`
+	SyncPenalties           uint64               `json:"sync_penalties"`
+	TotalPenalties          uint64               `json:"total_penalties"`
+	RewardEfficiency        float64              `json:"reward_efficiency"`
 	Slots                   []*EpochPageDataSlot `json:"slots"`
 }
 
 type EpochPageDataSlot struct {
-	Slot                  uint64    `json:"slot"`
-	Epoch                 uint64    `json:"epoch"`
-	Ts                    time.Time `json:"ts"`
-	Scheduled             bool      `json:"scheduled"`
-	Status                uint8     `json:"status"`
-	Proposer              uint64    `json:"proposer"`
-	ProposerName          string    `json:"proposer_name"`
-	AttestationCount      uint64    `json:"attestation_count"`
-	DepositCount          uint64    `json:"deposit_count"`
-	ExitCount             uint64    `json:"exit_count"`
-	ProposerSlashingCount uint64    `json:"proposer_slashing_count"`
-	AttesterSlashingCount uint64    `json:"attester_slashing_count"`
-	SyncParticipation     float64   `json:"sync_participation"`
-	EthTransactionCount   uint64    `json:"eth_transaction_count"`
-	EthBlockNumber        uint64    `json:"eth_block_number"`
-	WithEthBlock          bool      `json:"with_eth_block"`
-	Graffiti              []byte    `json:"graffiti"`
-	BlockRoot             []byte    `json:"block_root"`
+	Slot                  uint64      `json:"slot"`
+	Epoch                 uint64      `json:"epoch"`
+	Ts                    time.Time   `json:"ts"`
+	Scheduled             bool        `json:"scheduled"`
+	Status                uint8       `json:"status"`
+	Proposer              uint64      `json:"proposer"`
+	ProposerName          string      `json:"proposer_name"`
+	AttestationCount      uint64      `json:"attestation_count"`
+	DepositCount          uint64      `json:"deposit_count"`
+	ExitCount             uint64      `json:"exit_count"`
+	ProposerSlashingCount uint64      `json:"proposer_slashing_count"`
+	AttesterSlashingCount uint64      `json:"attester_slashing_count"`
+	SyncParticipation     float64     `json:"sync_participation"`
+	Reward                *SlotReward `json:"reward"`
+	EthTransactionCount   uint64      `json:"eth_transaction_count"`
+	EthBlockNumber        uint64      `json:"eth_block_number"`
+	WithEthBlock          bool        `json:"with_eth_block"`
+	Graffiti              []byte      `json:"graffiti"`
+	BlockRoot             []byte      `json:"block_root"`
 }
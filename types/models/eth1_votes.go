@@ -0,0 +1,55 @@
+package models
+
+import (
+	"time"
+)
+
+// Eth1VotesPageData is a struct to hold info for the eth1 votes page
+type Eth1VotesPageData struct {
+	Period        uint64    `json:"period"`
+	CurrentPeriod uint64    `json:"current_period"`
+	IsCurrent     bool      `json:"is_current"`
+	FirstSlot     uint64    `json:"first_slot"`
+	LastSlot      uint64    `json:"last_slot"`
+	FirstEpoch    uint64    `json:"first_epoch"`
+	LastEpoch     uint64    `json:"last_epoch"`
+	StartTime     time.Time `json:"start_time"`
+	EndTime       time.Time `json:"end_time"`
+	PeriodSlots   uint64    `json:"period_slots"`
+	ElapsedSlots  uint64    `json:"elapsed_slots"`
+	BlockCount    uint64    `json:"block_count"`
+	UnknownVotes  uint64    `json:"unknown_votes"`
+	MajorityVotes uint64    `json:"majority_votes"`
+
+	MajorityReached  bool   `json:"majority_reached"`
+	MajoritySlot     uint64 `json:"majority_slot"`
+	MajorityPossible bool   `json:"majority_possible"`
+
+	HasStartEth1Data  bool   `json:"has_start_eth1data"`
+	StartDepositRoot  []byte `json:"start_deposit_root"`
+	StartDepositCount uint64 `json:"start_deposit_count"`
+	StartBlockHash    []byte `json:"start_block_hash"`
+
+	HasContractCount     bool   `json:"has_contract_count"`
+	ContractDepositCount uint64 `json:"contract_deposit_count"`
+
+	Candidates     []*Eth1VotesPageDataCandidate `json:"candidates"`
+	CandidateCount uint64                        `json:"candidate_count"`
+}
+
+type Eth1VotesPageDataCandidate struct {
+	DepositRoot     []byte    `json:"deposit_root"`
+	DepositCount    uint64    `json:"deposit_count"`
+	BlockHash       []byte    `json:"block_hash"`
+	HasBlock        bool      `json:"has_block"`
+	BlockNumber     uint64    `json:"block_number"`
+	BlockTime       time.Time `json:"block_time"`
+	Votes           uint64    `json:"votes"`
+	VotePercent     float64   `json:"vote_percent"`
+	MajorityPercent float64   `json:"majority_percent"`
+	FirstSlot       uint64    `json:"first_slot"`
+	LastSlot        uint64    `json:"last_slot"`
+	IsLeading       bool      `json:"is_leading"`
+	HasMajority     bool      `json:"has_majority"`
+	MajoritySlot    uint64    `json:"majority_slot"`
+}
@@ -0,0 +1,60 @@
+package models
+
+// ForkComparePageData is a struct to hold info for the fork choice comparison page
+type ForkComparePageData struct {
+	Clients        []*ForkComparePageDataClient `json:"clients"`
+	ClientCount    uint64                       `json:"client_count"`
+	LoadedCount    uint64                       `json:"loaded_count"`
+	DivergentCount uint64                       `json:"divergent_count"`
+
+	JustifiedEpoch uint64 `json:"justified_epoch"`
+	JustifiedRoot  []byte `json:"justified_root"`
+	FinalizedEpoch uint64 `json:"finalized_epoch"`
+	FinalizedRoot  []byte `json:"finalized_root"`
+	HeadSlot       uint64 `json:"head_slot"`
+	HeadRoot       []byte `json:"head_root"`
+
+	Nodes              []*ForkComparePageDataNode `json:"nodes"`
+	NodeCount          uint64                     `json:"node_count"`
+	DivergentNodeCount uint64                     `json:"divergent_node_count"`
+	FilterDivergent    bool                       `json:"filter_divergent"`
+}
+
+type ForkComparePageDataClient struct {
+	Index          int    `json:"index"`
+	Name           string `json:"name"`
+	Version        string `json:"version"`
+	Status         string `json:"status"`
+	Loaded         bool   `json:"loaded"`
+	Error          string `json:"error"`
+	JustifiedEpoch uint64 `json:"justified_epoch"`
+	JustifiedRoot  []byte `json:"justified_root"`
+	JustifiedMatch bool   `json:"justified_match"`
+	FinalizedEpoch uint64 `json:"finalized_epoch"`
+	FinalizedRoot  []byte `json:"finalized_root"`
+	FinalizedMatch bool   `json:"finalized_match"`
+	HeadSlot       uint64 `json:"head_slot"`
+	HeadRoot       []byte `json:"head_root"`
+	HeadWeight     uint64 `json:"head_weight"`
+	HeadMatch      bool   `json:"head_match"`
+	NodeCount      uint64 `json:"node_count"`
+	MissingNodes   uint64 `json:"missing_nodes"`
+	Divergent      bool   `json:"divergent"`
+}
+
+type ForkComparePageDataNode struct {
+	Slot      uint64                       `json:"slot"`
+	Root      []byte                       `json:"root"`
+	Divergent bool                         `json:"divergent"`
+	Weights   []*ForkComparePageDataWeight `json:"weights"`
+}
+
+type ForkComparePageDataWeight struct {
+	Loaded    bool    `json:"loaded"`
+	Present   bool    `json:"present"`
+	Pruned    bool    `json:"pruned"`
+	Weight    uint64  `json:"weight"`
+	Deviation float64 `json:"deviation"`
+	Validity  string  `json:"validity"`
+	IsHead    bool    `json:"is_head"`
+}
@@ -0,0 +1,62 @@
+package models
+
+// ForkTreePageData is a struct to hold info for the fork choice tree page
+type ForkTreePageData struct {
+	FinalizedEpoch int64  `json:"finalized_epoch"`
+	FinalizedRoot  []byte `json:"finalized_root"`
+	JustifiedEpoch int64  `json:"justified_epoch"`
+	JustifiedRoot  []byte `json:"justified_root"`
+	HeadSlot       uint64 `json:"head_slot"`
+	HeadRoot       []byte `json:"head_root"`
+	VoteCounts     bool   `json:"vote_counts"`
+	TotalWeight    uint64 `json:"total_weight"`
+
+	Nodes        []*ForkTreePageDataNode  `json:"nodes"`
+	NodeCount    uint64                   `json:"node_count"`
+	OrphanCount  uint64                   `json:"orphan_count"`
+	Edges        []*ForkTreePageDataEdge  `json:"edges"`
+	EpochMarkers []*ForkTreePageDataEpoch `json:"epochs"`
+	BranchCount  uint64                   `json:"branch_count"`
+	GraphWidth   uint64                   `json:"graph_width"`
+	GraphHeight  uint64                   `json:"graph_height"`
+}
+
+type ForkTreePageDataNode struct {
+	Slot          uint64                    `json:"slot"`
+	Root          []byte                    `json:"root"`
+	ParentRoot    []byte                    `json:"parent_root"`
+	HasParent     bool                      `json:"has_parent"`
+	Canonical     bool                      `json:"canonical"`
+	Orphaned      bool                      `json:"orphaned"`
+	IsFinalized   bool                      `json:"is_finalized"`
+	IsJustified   bool                      `json:"is_justified"`
+	Proposer      uint64                    `json:"proposer"`
+	ProposerName  string                    `json:"proposer_name"`
+	VoteWeight    uint64                    `json:"vote_weight"`
+	Weight        uint64                    `json:"weight"`
+	WeightPercent float64                   `json:"weight_percent"`
+	ChildCount    uint64                    `json:"child_count"`
+	Clients       []*ForkTreePageDataClient `json:"clients"`
+	Branch        uint64                    `json:"branch"`
+	PosX          uint64                    `json:"pos_x"`
+	PosY          uint64                    `json:"pos_y"`
+}
+
+type ForkTreePageDataClient struct {
+	Index  int    `json:"index"`
+	Name   string `json:"name"`
+	Status string `json:"status"`
+}
+
+type ForkTreePageDataEdge struct {
+	FromX     uint64 `json:"from_x"`
+	FromY     uint64 `json:"from_y"`
+	ToX       uint64 `json:"to_x"`
+	ToY       uint64 `json:"to_y"`
+	Canonical bool   `json:"canonical"`
+}
+
+type ForkTreePageDataEpoch struct {
+	Epoch uint64 `json:"epoch"`
+	PosX  uint64 `json:"pos_x"`
+}
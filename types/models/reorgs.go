@@ -0,0 +1,47 @@
+package models
+
+import (
+	"time"
+)
+
+// ReorgsPageData is a struct to hold info for the reorgs page
+type ReorgsPageData struct {
+	FilterMinSlot  uint64 `json:"filter_mins"`
+	FilterMaxSlot  uint64 `json:"filter_maxs"`
+	FilterMinDepth uint64 `json:"filter_mind"`
+
+	Reorgs     []*ReorgsPageDataReorg `json:"reorgs"`
+	ReorgCount uint64                 `json:"reorg_count"`
+	FirstSlot  uint64                 `json:"first_slot"`
+	LastSlot   uint64                 `json:"last_slot"`
+
+	IsDefaultPage    bool   `json:"default_page"`
+	TotalPages       uint64 `json:"total_pages"`
+	PageSize         uint64 `json:"page_size"`
+	CurrentPageIndex uint64 `json:"page_index"`
+	PrevPageIndex    uint64 `json:"prev_page_index"`
+	NextPageIndex    uint64 `json:"next_page_index"`
+	LastPageIndex    uint64 `json:"last_page_index"`
+
+	FirstPageLink string `json:"first_page_link"`
+	PrevPageLink  string `json:"prev_page_link"`
+	NextPageLink  string `json:"next_page_link"`
+	LastPageLink  string `json:"last_page_link"`
+}
+
+type ReorgsPageDataReorg struct {
+	SlotNumber        uint64    `json:"slot"`
+	HeadRoot          []byte    `json:"head_root"`
+	Time              time.Time `json:"time"`
+	OldHeadSlot       uint64    `json:"old_head_slot"`
+	OldHeadRoot       []byte    `json:"old_head_root"`
+	HasAncestor       bool      `json:"has_ancestor"`
+	AncestorSlot      uint64    `json:"ancestor_slot"`
+	AncestorRoot      []byte    `json:"ancestor_root"`
+	Depth             uint64    `json:"depth"`
+	FirstAffectedSlot uint64    `json:"first_affected_slot"`
+	LastAffectedSlot  uint64    `json:"last_affected_slot"`
+	DetectedTime      time.Time `json:"detected_time"`
+	OldHeadClients    []string  `json:"old_head_clients"`
+	NewHeadClients    []string  `json:"new_head_clients"`
+}
@@ -35,6 +35,12 @@ type SearchAheadExecBlocksResult struct {
 	Orphaned   bool          `json:"orphaned,omitempty"`
 }
 
+// SearchAheadTransactionsResult is a struct to hold the search ahead transactions results
+type SearchAheadTransactionsResult struct {
+	TxHash      string `json:"tx_hash,omitempty"`
+	BlockNumber uint64 `json:"block_number,omitempty"`
+}
+
 // SearchAheadGraffitiResult is a struct to hold the search ahead blocks results with a given graffiti
 type SearchAheadGraffitiResult struct {
 	Graffiti string `json:"graffiti,omitempty"`
@@ -30,6 +30,18 @@ type SlotPageBlockBadge struct {
 	ClassName   string `json:"class"`
 }
 
+// SlotReward holds the rewards received by the proposer of a block (in gwei)
+type SlotReward struct {
+	Total                uint64 `json:"total"`
+	WithClReward         bool   `json:"with_cl_reward"`
+	ClReward             uint64 `json:"cl_reward"`
+	ClRewardAttestations uint64 `json:"cl_reward_attestations"`
+	ClRewardSync         uint64 `json:"cl_reward_sync"`
+	ClRewardSlashings    uint64 `json:"cl_reward_slashings"`
+	WithPriorityFees     bool   `json:"with_priority_fees"`
+	ElPriorityFees       uint64 `json:"el_priority_fees"`
+}
+
 type SlotStatus uint16
 
 const (
@@ -48,6 +60,9 @@ type SlotPageBlockData struct {
 	Eth1dataDepositroot    []byte                 `json:"eth1data_depositroot"`
 	Eth1dataDepositcount   uint64                 `json:"eth1data_depositcount"`
 	Eth1dataBlockhash      []byte                 `json:"eth1data_blockhash"`
+	Eth1dataRootChecked    bool                   `json:"eth1data_root_checked"`
+	Eth1dataRootValid      bool                   `json:"eth1data_root_valid"`
+	Eth1dataVotingPeriod   uint64                 `json:"eth1data_voting_period"`
 	SyncAggregateBits      []byte                 `json:"syncaggregate_bits"`
 	SyncAggregateSignature []byte                 `json:"syncaggregate_signature"`
 	SyncAggParticipation   float64                `json:"syncaggregate_participation"`
@@ -65,6 +80,12 @@ type SlotPageBlockData struct {
 	DutiesLoaded           bool                   `json:"duties_loaded"`
 	TransactionsCount      uint64                 `json:"transactions_count"`
 
+	DepositRequestsCount       uint64 `json:"deposit_requests_count"`
+	WithdrawalRequestsCount    uint64 `json:"withdrawal_requests_count"`
+	ConsolidationRequestsCount uint64 `json:"consolidation_requests_count"`
+
+	Reward *SlotReward `json:"reward"`
+
 	ExecutionData     *SlotPageExecutionData      `json:"execution_data"`
 	Attestations      []*SlotPageAttestation      `json:"attestations"`       // Attestations included in this block
 	Deposits          []*SlotPageDeposit          `json:"deposits"`           // Deposits included in this block
@@ -75,6 +96,10 @@ type SlotPageBlockData struct {
 	Withdrawals       []*SlotPageWithdrawal       `json:"withdrawals"`        // Withdrawals included in this block
 	Blobs             []*SlotPageBlob             `json:"blobs"`              // Blob sidecars included in this block
 	Transactions      []*SlotPageTransaction      `json:"transactions"`       // Transactions included in this block
+
+	DepositRequests       []*SlotPageDepositRequest       `json:"deposit_requests"`       // Deposit requests included in this block
+	WithdrawalRequests    []*SlotPageWithdrawalRequest    `json:"withdrawal_requests"`    // Withdrawal requests included in this block
+	ConsolidationRequests []*SlotPageConsolidationRequest `json:"consolidation_requests"` // Consolidation requests included in this block
 }
 
 type SlotPageExecutionData struct {
@@ -179,10 +204,41 @@ type SlotPageWithdrawal struct {
 	Amount         uint64 `json:"amount"`
 }
 
+type SlotPageDepositRequest struct {
+	Index                 uint64 `json:"index"`
+	PublicKey             []byte `json:"publickey"`
+	Withdrawalcredentials []byte `json:"withdrawalcredentials"`
+	Amount                uint64 `json:"amount"`
+	Signature             []byte `json:"signature"`
+}
+
+type SlotPageWithdrawalRequest struct {
+	SourceAddress   []byte `json:"source_address"`
+	ValidatorPubkey []byte `json:"validator_pubkey"`
+	ValidatorIndex  uint64 `json:"validator_index"`
+	ValidatorName   string `json:"validator_name"`
+	ValidatorValid  bool   `json:"validator_valid"`
+	Amount          uint64 `json:"amount"`
+}
+
+type SlotPageConsolidationRequest struct {
+	SourceAddress []byte `json:"source_address"`
+	SourcePubkey  []byte `json:"source_pubkey"`
+	SourceIndex   uint64 `json:"source_index"`
+	SourceName    string `json:"source_name"`
+	SourceValid   bool   `json:"source_valid"`
+	TargetPubkey  []byte `json:"target_pubkey"`
+	TargetIndex   uint64 `json:"target_index"`
+	TargetName    string `json:"target_name"`
+	TargetValid   bool   `json:"target_valid"`
+}
+
 type SlotPageBlob struct {
 	Index         uint64 `json:"index"`
 	KzgCommitment []byte `json:"kzg_commitment"`
+	VersionedHash []byte `json:"versioned_hash"`
 	HaveData      bool   `json:"have_data"`
+	Pruned        bool   `json:"pruned"`
 	IsShort       bool   `json:"is_short"`
 	BlobShort     []byte `json:"blob_short"`
 	Blob          []byte `json:"blob"`
@@ -194,6 +250,7 @@ type SlotPageBlobDetails struct {
 	Blob          string `json:"blob"`
 	KzgCommitment string `json:"kzg_commitment"`
 	KzgProof      string `json:"kzg_proof"`
+	Pruned        bool   `json:"pruned"`
 }
 
 type SlotPageTransaction struct {
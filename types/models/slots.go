@@ -40,6 +40,7 @@ type SlotsPageDataSlot struct {
 	ProposerSlashingCount uint64                    `json:"proposer_slashing_count"`
 	AttesterSlashingCount uint64                    `json:"attester_slashing_count"`
 	SyncParticipation     float64                   `json:"sync_participation"`
+	Reward                *SlotReward               `json:"reward"`
 	EthTransactionCount   uint64                    `json:"eth_transaction_count"`
 	WithEthBlock          bool                      `json:"with_eth_block"`
 	EthBlockNumber        uint64                    `json:"eth_block_number"`
@@ -8,6 +8,7 @@ import (
 type SlotsFilteredPageData struct {
 	FilterGraffiti     string `json:"filter_graffiti"`
 	FilterExtraData    string `json:"filter_extra_data"`
+	FilterFeeRecipient string `json:"filter_fee_recipient"`
 	FilterProposer     string `json:"filter_proposer"`
 	FilterProposerName string `json:"filter_pname"`
 	FilterWithOrphaned uint8  `json:"filter_orphaned"`
@@ -0,0 +1,35 @@
+package models
+
+import (
+	"time"
+)
+
+// SyncCommitteesPageData is a struct to hold info for the sync committees page
+type SyncCommitteesPageData struct {
+	Period        uint64    `json:"period"`
+	CurrentPeriod uint64    `json:"current_period"`
+	FirstEpoch    uint64    `json:"first_epoch"`
+	LastEpoch     uint64    `json:"last_epoch"`
+	StartTs       time.Time `json:"start_ts"`
+	EndTs         time.Time `json:"end_ts"`
+	IsCurrent     bool      `json:"is_current"`
+	IsNext        bool      `json:"is_next"`
+	HasPrevPeriod bool      `json:"has_prev_period"`
+	PrevPeriod    uint64    `json:"prev_period"`
+	HasNextPeriod bool      `json:"has_next_period"`
+	NextPeriod    uint64    `json:"next_period"`
+	SlotCount     uint64    `json:"slot_count"`
+	Participation float64   `json:"participation"`
+
+	Members     []*SyncCommitteesPageDataMember `json:"members"`
+	MemberCount uint64                          `json:"member_count"`
+}
+
+type SyncCommitteesPageDataMember struct {
+	Position      uint64  `json:"position"`
+	Index         uint64  `json:"index"`
+	Name          string  `json:"name"`
+	Signed        uint64  `json:"signed"`
+	Missed        uint64  `json:"missed"`
+	Participation float64 `json:"participation"`
+}
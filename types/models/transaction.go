@@ -0,0 +1,39 @@
+package models
+
+import (
+	"time"
+)
+
+// TransactionPageData is a struct to hold info for the transaction page
+type TransactionPageData struct {
+	TxHash      []byte    `json:"tx_hash"`
+	IsIndexed   bool      `json:"is_indexed"`
+	IsPending   bool      `json:"is_pending"`
+	HasBlock    bool      `json:"has_block"`
+	BlockNumber uint64    `json:"block_number"`
+	BlockHash   []byte    `json:"block_hash"`
+	BlockTime   time.Time `json:"block_time"`
+	TxIndex     uint64    `json:"tx_index"`
+	HasSlot     bool      `json:"has_slot"`
+	Slot        uint64    `json:"slot"`
+	SlotRoot    []byte    `json:"slot_root"`
+	Orphaned    bool      `json:"orphaned"`
+
+	From            []byte  `json:"from"`
+	To              []byte  `json:"to"`
+	ContractAddress []byte  `json:"contract_address"`
+	Value           float64 `json:"value"`
+	Type            uint64  `json:"type"`
+	Nonce           uint64  `json:"nonce"`
+	GasLimit        uint64  `json:"gas_limit"`
+	Data            []byte  `json:"data"`
+	DataLen         uint64  `json:"datalen"`
+	FuncSigStatus   uint64  `json:"func_sig_status"`
+	FuncBytes       string  `json:"func_bytes"`
+	FuncName        string  `json:"func_name"`
+	FuncSig         string  `json:"func_sig"`
+
+	HasReceipt bool   `json:"has_receipt"`
+	GasUsed    uint64 `json:"gas_used"`
+	Success    bool   `json:"success"`
+}
@@ -30,18 +30,112 @@ type ValidatorPageData struct {
 	WithdrawCredentials []byte    `json:"withdraw_credentials"`
 	ShowWithdrawAddress bool      `json:"show_withdraw_address"`
 	WithdrawAddress     []byte    `json:"withdraw_address"`
+	ShowBLSChange       bool      `json:"show_bls_change"`
+	BLSChangeSlot       uint64    `json:"bls_change_slot"`
+	BLSChangeTs         time.Time `json:"bls_change_ts"`
+	BLSChangeAddress    []byte    `json:"bls_change_address"`
 
 	RecentBlocks     []*ValidatorPageDataBlocks `json:"recent_blocks"`
 	RecentBlockCount uint64                     `json:"recent_block_count"`
+
+	RecentWithdrawals     []*ValidatorPageDataWithdrawal `json:"recent_withdrawals"`
+	RecentWithdrawalCount uint64                         `json:"recent_withdrawal_count"`
+
+	AttestationHistory       []*ValidatorPageDataAttestation `json:"attestation_history"`
+	RecentAttestations       []*ValidatorPageDataAttestation `json:"recent_attestations"`
+	RecentAttestationCount   uint64                          `json:"recent_attestation_count"`
+	AttestationDutyCount     uint64                          `json:"attestation_duty_count"`
+	AttestationIncludedCount uint64                          `json:"attestation_included_count"`
+	AttestationTargetCount   uint64                          `json:"attestation_target_count"`
+	AttestationHeadCount     uint64                          `json:"attestation_head_count"`
+	AttestationIncludedRate  float64                         `json:"attestation_included_rate"`
+	AttestationTargetRate    float64                         `json:"attestation_target_rate"`
+	AttestationHeadRate      float64                         `json:"attestation_head_rate"`
+
+	ShowBalanceHistory    bool                       `json:"show_balance_history"`
+	BalanceChartStartTs   time.Time                  `json:"balance_chart_start_ts"`
+	BalanceChartEndTs     time.Time                  `json:"balance_chart_end_ts"`
+	BalanceChartMin       uint64                     `json:"balance_chart_min"`
+	BalanceChartMax       uint64                     `json:"balance_chart_max"`
+	BalanceChartPoints    string                     `json:"balance_chart_points"`
+	EffBalanceChartPoints string                     `json:"eff_balance_chart_points"`
+	Income                []*ValidatorPageDataIncome `json:"income"`
+
+	SyncDuties    []*ValidatorPageDataSyncDuty `json:"sync_duties"`
+	SyncDutyCount uint64                       `json:"sync_duty_count"`
+
+	RecentRewards     []*ValidatorPageDataReward `json:"recent_rewards"`
+	RecentRewardCount uint64                     `json:"recent_reward_count"`
 }
 
 type ValidatorPageDataBlocks struct {
-	Epoch        uint64    `json:"epoch"`
-	Slot         uint64    `json:"slot"`
-	WithEthBlock bool      `json:"with_eth_block"`
-	EthBlock     uint64    `json:"eth_block"`
-	Ts           time.Time `json:"ts"`
-	Status       uint64    `json:"status"`
-	BlockRoot    string    `json:"block_root"`
-	Graffiti     []byte    `json:"graffiti"`
+	Epoch        uint64      `json:"epoch"`
+	Slot         uint64      `json:"slot"`
+	WithEthBlock bool        `json:"with_eth_block"`
+	EthBlock     uint64      `json:"eth_block"`
+	Ts           time.Time   `json:"ts"`
+	Status       uint64      `json:"status"`
+	BlockRoot    string      `json:"block_root"`
+	Graffiti     []byte      `json:"graffiti"`
+	Reward       *SlotReward `json:"reward"`
+}
+
+type ValidatorPageDataWithdrawal struct {
+	Epoch    uint64    `json:"epoch"`
+	Slot     uint64    `json:"slot"`
+	SlotRoot []byte    `json:"slot_root"`
+	Ts       time.Time `json:"ts"`
+	Orphaned bool      `json:"orphaned"`
+	Index    uint64    `json:"index"`
+	Address  []byte    `json:"address"`
+	Amount   uint64    `json:"amount"`
+	Type     uint8     `json:"type"`
+}
+
+type ValidatorPageDataAttestation struct {
+	Epoch             uint64    `json:"epoch"`
+	Ts                time.Time `json:"ts"`
+	Status            uint8     `json:"status"` // 0 = no duty, 1 = included, 2 = missed, 3 = pending
+	HeadCorrect       bool      `json:"head_correct"`
+	TargetCorrect     bool      `json:"target_correct"`
+	SourceCorrect     bool      `json:"source_correct"`
+	InclusionDistance uint8     `json:"inclusion_distance"`
+}
+
+type ValidatorPageDataIncome struct {
+	Period       string    `json:"period"`
+	StartEpoch   uint64    `json:"start_epoch"`
+	StartTs      time.Time `json:"start_ts"`
+	StartBalance uint64    `json:"start_balance"`
+	EndBalance   uint64    `json:"end_balance"`
+	Withdrawn    uint64    `json:"withdrawn"`
+	Deposited    uint64    `json:"deposited"`
+	Rewards      uint64    `json:"rewards"`
+	Penalties    uint64    `json:"penalties"`
+	NetIncome    uint64    `json:"net_income"`
+	NetNegative  bool      `json:"net_negative"`
+}
+
+type ValidatorPageDataReward struct {
+	Epoch               uint64    `json:"epoch"`
+	Ts                  time.Time `json:"ts"`
+	AttestationReward   uint64    `json:"attestation_reward"`
+	AttestationNegative bool      `json:"attestation_negative"`
+	SyncReward          uint64    `json:"sync_reward"`
+	SyncNegative        bool      `json:"sync_negative"`
+	TotalReward         uint64    `json:"total_reward"`
+	TotalNegative       bool      `json:"total_negative"`
+}
+
+type ValidatorPageDataSyncDuty struct {
+	Period        uint64    `json:"period"`
+	FirstEpoch    uint64    `json:"first_epoch"`
+	LastEpoch     uint64    `json:"last_epoch"`
+	StartTs       time.Time `json:"start_ts"`
+	Positions     uint64    `json:"positions"`
+	IsCurrent     bool      `json:"is_current"`
+	IsNext        bool      `json:"is_next"`
+	Signed        uint64    `json:"signed"`
+	Missed        uint64    `json:"missed"`
+	Participation float64   `json:"participation"`
 }
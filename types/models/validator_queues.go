@@ -0,0 +1,50 @@
+package models
+
+import (
+	"time"
+)
+
+// ValidatorQueuesPageData is a struct to hold info for the validator queues page
+type ValidatorQueuesPageData struct {
+	Epoch              uint64 `json:"epoch"`
+	ActiveCount        uint64 `json:"active_count"`
+	TotalActiveBalance uint64 `json:"total_active_balance"`
+	BalanceChurn       bool   `json:"balance_churn"`
+	ActivationChurn    uint64 `json:"activation_churn"`
+	ExitChurn          uint64 `json:"exit_churn"`
+	PendingCount       uint64 `json:"pending_count"`
+
+	ActivationCount     uint64    `json:"activation_count"`
+	ActivationAmount    uint64    `json:"activation_amount"`
+	ActivationWaitEpoch uint64    `json:"activation_wait_epoch"`
+	ActivationWaitTime  time.Time `json:"activation_wait_time"`
+	ExitCount           uint64    `json:"exit_count"`
+	ExitAmount          uint64    `json:"exit_amount"`
+	NextExitEpoch       uint64    `json:"next_exit_epoch"`
+	NextExitTime        time.Time `json:"next_exit_time"`
+
+	ActivationQueue []*ValidatorQueuesPageDataValidator `json:"activation_queue"`
+	ExitQueue       []*ValidatorQueuesPageDataValidator `json:"exit_queue"`
+	ListLimit       uint64                              `json:"list_limit"`
+
+	// queue length history (svg polyline points in a 1000x200 viewbox)
+	Range           string `json:"range"`
+	HistoryMinEpoch uint64 `json:"history_min_epoch"`
+	HistoryMaxEpoch uint64 `json:"history_max_epoch"`
+	HistoryCount    uint64 `json:"history_count"`
+	HistoryMaxValue uint64 `json:"history_max_value"`
+	ActivationChart string `json:"activation_chart"`
+	ExitChart       string `json:"exit_chart"`
+}
+
+type ValidatorQueuesPageDataValidator struct {
+	Position         uint64    `json:"position"`
+	Index            uint64    `json:"index"`
+	Name             string    `json:"name"`
+	PublicKey        []byte    `json:"pubkey"`
+	EffectiveBalance uint64    `json:"effective_balance"`
+	EligibilityEpoch uint64    `json:"eligibility_epoch"`
+	Scheduled        bool      `json:"scheduled"`
+	EstimatedEpoch   uint64    `json:"estimated_epoch"`
+	EstimatedTime    time.Time `json:"estimated_time"`
+}
@@ -0,0 +1,54 @@
+package models
+
+import (
+	"time"
+)
+
+// WithdrawalsPageData is a struct to hold info for the withdrawals page
+type WithdrawalsPageData struct {
+	FilterMinSlot       uint64 `json:"filter_mins"`
+	FilterMaxSlot       uint64 `json:"filter_maxs"`
+	FilterMinIndex      uint64 `json:"filter_mini"`
+	FilterMaxIndex      uint64 `json:"filter_maxi"`
+	FilterValidatorName string `json:"filter_vname"`
+	FilterAddress       string `json:"filter_address"`
+	FilterMinAmount     uint64 `json:"filter_mina"`
+	FilterMaxAmount     uint64 `json:"filter_maxa"`
+	FilterWithType      uint8  `json:"filter_type"`
+	FilterWithOrphaned  uint8  `json:"filter_orphaned"`
+
+	Withdrawals     []*WithdrawalsPageDataWithdrawal `json:"withdrawals"`
+	WithdrawalCount uint64                           `json:"withdrawal_count"`
+	FirstIndex      uint64                           `json:"first_index"`
+	LastIndex       uint64                           `json:"last_index"`
+
+	IsDefaultPage    bool   `json:"default_page"`
+	TotalPages       uint64 `json:"total_pages"`
+	PageSize         uint64 `json:"page_size"`
+	CurrentPageIndex uint64 `json:"page_index"`
+	PrevPageIndex    uint64 `json:"prev_page_index"`
+	NextPageIndex    uint64 `json:"next_page_index"`
+	LastPageIndex    uint64 `json:"last_page_index"`
+
+	FirstPageLink string `json:"first_page_link"`
+	PrevPageLink  string `json:"prev_page_link"`
+	NextPageLink  string `json:"next_page_link"`
+	LastPageLink  string `json:"last_page_link"`
+}
+
+type WithdrawalsPageDataWithdrawal struct {
+	SlotNumber      uint64    `json:"slot"`
+	SlotRoot        []byte    `json:"slot_root"`
+	Time            time.Time `json:"time"`
+	Orphaned        bool      `json:"orphaned"`
+	Index           uint64    `json:"index"`
+	ValidatorIndex  uint64    `json:"vindex"`
+	ValidatorName   string    `json:"vname"`
+	Address         []byte    `json:"address"`
+	Amount          uint64    `json:"amount"`
+	Type            uint8     `json:"type"`
+	ValidatorStatus string    `json:"vstatus"`
+	ShowUpcheck     bool      `json:"show_upcheck"`
+	UpcheckActivity uint8     `json:"upcheck_act"`
+	UpcheckMaximum  uint8     `json:"upcheck_max"`
+}
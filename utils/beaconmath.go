@@ -1,6 +1,7 @@
 package utils
 
 import (
+	"math"
 	"math/big"
 	"time"
 
@@ -8,11 +9,19 @@ import (
 	"github.com/shopspring/decimal"
 )
 
+// FarFutureEpoch is the spec FAR_FUTURE_EPOCH, used for unset activation & exit epochs of validators
+const FarFutureEpoch = math.MaxUint64
+
 // EpochOfSlot returns the corresponding epoch of a slot
 func EpochOfSlot(slot uint64) uint64 {
 	return slot / Config.Chain.Config.SlotsPerEpoch
 }
 
+// IsElectraEpoch returns true if the electra fork is scheduled and active at the given epoch
+func IsElectraEpoch(epoch uint64) bool {
+	return Config.Chain.Config.ElectraForkEpoch != nil && epoch >= *Config.Chain.Config.ElectraForkEpoch
+}
+
 // DayOfSlot returns the corresponding day of a slot
 func DayOfSlot(slot uint64) uint64 {
 	return Config.Chain.Config.SecondsPerSlot * slot / (24 * 3600)
@@ -57,3 +57,30 @@ func GetValidatorChurnLimit(validatorCount uint64) uint64 {
 	}
 	return adaptable
 }
+
+// GetActivationChurnLimit returns the number of validators that can be activated per epoch (pre-electra, capped since deneb)
+func GetActivationChurnLimit(epoch uint64, activeValidatorCount uint64) uint64 {
+	churnLimit := GetValidatorChurnLimit(activeValidatorCount)
+	if epoch >= Config.Chain.Config.DenebForkEpoch && Config.Chain.Config.MaxPerEpochActivationChurnLimit > 0 && churnLimit > Config.Chain.Config.MaxPerEpochActivationChurnLimit {
+		churnLimit = Config.Chain.Config.MaxPerEpochActivationChurnLimit
+	}
+	return churnLimit
+}
+
+// GetActivationExitChurnLimit returns the amount of gwei that can enter or leave the active set per epoch (electra)
+func GetActivationExitChurnLimit(totalActiveBalance uint64) uint64 {
+	churnLimit := uint64(0)
+	if Config.Chain.Config.ChurnLimitQuotient > 0 {
+		churnLimit = totalActiveBalance / Config.Chain.Config.ChurnLimitQuotient
+	}
+	if churnLimit < Config.Chain.Config.MinPerEpochChurnLimitElectra {
+		churnLimit = Config.Chain.Config.MinPerEpochChurnLimitElectra
+	}
+	if Config.Chain.Config.EffectiveBalanceIncrement > 0 {
+		churnLimit -= churnLimit % Config.Chain.Config.EffectiveBalanceIncrement
+	}
+	if Config.Chain.Config.MaxPerEpochActivationExitChurnLimit > 0 && churnLimit > Config.Chain.Config.MaxPerEpochActivationExitChurnLimit {
+		churnLimit = Config.Chain.Config.MaxPerEpochActivationExitChurnLimit
+	}
+	return churnLimit
+}
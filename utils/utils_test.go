@@ -0,0 +1,78 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/ethpandaops/dora/types"
+)
+
+func setTestChainConfig() {
+	Config = &types.Config{}
+	Config.Chain.Config = types.ChainConfig{
+		DenebForkEpoch:                      269568,
+		MinPerEpochChurnLimit:               4,
+		ChurnLimitQuotient:                  65536,
+		MaxPerEpochActivationChurnLimit:     8,
+		MinPerEpochChurnLimitElectra:        128000000000,
+		MaxPerEpochActivationExitChurnLimit: 256000000000,
+		EffectiveBalanceIncrement:           1000000000,
+	}
+}
+
+func TestGetValidatorChurnLimit(t *testing.T) {
+	setTestChainConfig()
+	tests := []struct {
+		validatorCount uint64
+		expected       uint64
+	}{
+		{validatorCount: 0, expected: 4},
+		{validatorCount: 100000, expected: 4},
+		{validatorCount: 327680, expected: 5},
+		{validatorCount: 1000000, expected: 15},
+		{validatorCount: 1310720, expected: 20},
+	}
+	for _, test := range tests {
+		if limit := GetValidatorChurnLimit(test.validatorCount); limit != test.expected {
+			t.Errorf("GetValidatorChurnLimit(%v) = %v, expected %v", test.validatorCount, limit, test.expected)
+		}
+	}
+}
+
+func TestGetActivationChurnLimit(t *testing.T) {
+	setTestChainConfig()
+	tests := []struct {
+		epoch          uint64
+		validatorCount uint64
+		expected       uint64
+	}{
+		{epoch: 269567, validatorCount: 100000, expected: 4},
+		{epoch: 269567, validatorCount: 1000000, expected: 15},
+		{epoch: 269568, validatorCount: 100000, expected: 4},
+		{epoch: 269568, validatorCount: 1000000, expected: 8},
+		{epoch: 300000, validatorCount: 524288, expected: 8},
+	}
+	for _, test := range tests {
+		if limit := GetActivationChurnLimit(test.epoch, test.validatorCount); limit != test.expected {
+			t.Errorf("GetActivationChurnLimit(%v, %v) = %v, expected %v", test.epoch, test.validatorCount, limit, test.expected)
+		}
+	}
+}
+
+func TestGetActivationExitChurnLimit(t *testing.T) {
+	setTestChainConfig()
+	tests := []struct {
+		totalActiveBalance uint64
+		expected           uint64
+	}{
+		{totalActiveBalance: 0, expected: 128000000000},
+		{totalActiveBalance: 65536 * 100000000000, expected: 128000000000},
+		{totalActiveBalance: 65536 * 200000000000, expected: 200000000000},
+		{totalActiveBalance: 65536 * 150500000000, expected: 150000000000},
+		{totalActiveBalance: 65536 * 300000000000, expected: 256000000000},
+	}
+	for _, test := range tests {
+		if limit := GetActivationExitChurnLimit(test.totalActiveBalance); limit != test.expected {
+			t.Errorf("GetActivationExitChurnLimit(%v) = %v, expected %v", test.totalActiveBalance, limit, test.expected)
+		}
+	}
+}